@@ -0,0 +1,20 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package otlpcompress provides compressors for the OTLP exporters that are not
+available in the standard library.
+
+The compressors are registered with [google.golang.org/grpc/encoding] when
+their package is imported. They can then be used by the OTLP/gRPC exporters
+with the WithCompressor option and by the OTLP/HTTP exporters with the
+WithCompression option or the OTEL_EXPORTER_OTLP_COMPRESSION environment
+variable.
+
+	import _ "go.opentelemetry.io/otel/exporters/otlp/otlpcompress/zstd"
+
+The following compressors are provided:
+  - [go.opentelemetry.io/otel/exporters/otlp/otlpcompress/zstd] registers the "zstd" compressor.
+  - [go.opentelemetry.io/otel/exporters/otlp/otlpcompress/snappy] registers the "snappy" compressor.
+*/
+package otlpcompress // import "go.opentelemetry.io/otel/exporters/otlp/otlpcompress"
@@ -0,0 +1,17 @@
+module go.opentelemetry.io/otel/exporters/otlp/otlpcompress
+
+go 1.21
+
+require (
+	github.com/golang/snappy v1.0.0
+	github.com/klauspost/compress v1.17.9
+	github.com/stretchr/testify v1.9.0
+	google.golang.org/grpc v1.65.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/sys v0.24.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package snappy registers a snappy compressor with
+// google.golang.org/grpc/encoding when imported.
+//
+// Payloads are encoded using the snappy block format. This is the format the
+// OpenTelemetry Collector expects for the "snappy" HTTP Content-Encoding.
+//
+// The OTLP/gRPC exporters use the compressor when the WithCompressor option
+// is passed [Name]. The OTLP/HTTP exporters use it with the SnappyCompression
+// value or when the OTEL_EXPORTER_OTLP_COMPRESSION environment variable is
+// set to "snappy".
+package snappy // import "go.opentelemetry.io/otel/exporters/otlp/otlpcompress/snappy"
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/golang/snappy"
+	"google.golang.org/grpc/encoding"
+)
+
+// Name is the name registered for the snappy compressor.
+const Name = "snappy"
+
+func init() {
+	encoding.RegisterCompressor(compressor{})
+}
+
+type compressor struct{}
+
+// Name returns the name of the compressor.
+func (compressor) Name() string {
+	return Name
+}
+
+// Compress returns a writer compressing all data written to it into w.
+// The snappy block format requires the whole payload, the returned writer
+// therefore buffers all data and only writes it to w when closed.
+func (compressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return &writer{w: w}, nil
+}
+
+// Decompress returns a reader of the decompressed data read from r.
+func (compressor) Decompress(r io.Reader) (io.Reader, error) {
+	compressed, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	b, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(b), nil
+}
+
+type writer struct {
+	w   io.Writer
+	buf bytes.Buffer
+}
+
+// Write buffers p to be compressed when the writer is closed.
+func (w *writer) Write(p []byte) (int, error) {
+	return w.buf.Write(p)
+}
+
+// Close compresses all buffered data and writes it to the underlying writer.
+func (w *writer) Close() error {
+	_, err := w.w.Write(snappy.Encode(nil, w.buf.Bytes()))
+	return err
+}
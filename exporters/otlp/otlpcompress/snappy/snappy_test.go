@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snappy
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
+)
+
+func TestRegistered(t *testing.T) {
+	c := encoding.GetCompressor(Name)
+	require.NotNil(t, c, "compressor not registered")
+	assert.Equal(t, Name, c.Name())
+}
+
+func TestRoundTrip(t *testing.T) {
+	c := encoding.GetCompressor(Name)
+	require.NotNil(t, c, "compressor not registered")
+
+	data := []byte(strings.Repeat("OpenTelemetry ", 1024))
+	// Run multiple times to ensure pooled state is correctly reset.
+	for i := 0; i < 3; i++ {
+		var buf bytes.Buffer
+		w, err := c.Compress(&buf)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		assert.Less(t, buf.Len(), len(data), "data not compressed")
+
+		r, err := c.Decompress(&buf)
+		require.NoError(t, err)
+		got, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, data, got)
+	}
+}
+
+func TestDecompressInvalid(t *testing.T) {
+	c := encoding.GetCompressor(Name)
+	require.NotNil(t, c, "compressor not registered")
+
+	r, err := c.Decompress(strings.NewReader("not compressed"))
+	if err == nil {
+		_, err = io.ReadAll(r)
+	}
+	assert.Error(t, err)
+}
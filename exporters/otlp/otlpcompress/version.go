@@ -0,0 +1,9 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpcompress // import "go.opentelemetry.io/otel/exporters/otlp/otlpcompress"
+
+// Version is the current release version of the OpenTelemetry OTLP compressors in use.
+func Version() string {
+	return "0.1.0"
+}
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package zstd registers a zstd compressor with
+// google.golang.org/grpc/encoding when imported.
+//
+// The OTLP/gRPC exporters use the compressor when the WithCompressor option
+// is passed [Name]. The OTLP/HTTP exporters use it with the ZstdCompression
+// value or when the OTEL_EXPORTER_OTLP_COMPRESSION environment variable is
+// set to "zstd".
+package zstd // import "go.opentelemetry.io/otel/exporters/otlp/otlpcompress/zstd"
+
+import (
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc/encoding"
+)
+
+// Name is the name registered for the zstd compressor.
+const Name = "zstd"
+
+func init() {
+	encoding.RegisterCompressor(&compressor{})
+}
+
+type compressor struct {
+	encoders sync.Pool
+	decoders sync.Pool
+}
+
+// Name returns the name of the compressor.
+func (c *compressor) Name() string {
+	return Name
+}
+
+// Compress returns a writer compressing all data written to it into w.
+// The returned writer needs to be closed to flush all data to w.
+func (c *compressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	enc, ok := c.encoders.Get().(*zstd.Encoder)
+	if !ok {
+		var err error
+		enc, err = zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		enc.Reset(w)
+	}
+	return &writer{Encoder: enc, pool: &c.encoders}, nil
+}
+
+// Decompress returns a reader decompressing the data read from r.
+func (c *compressor) Decompress(r io.Reader) (io.Reader, error) {
+	dec, ok := c.decoders.Get().(*zstd.Decoder)
+	if !ok {
+		var err error
+		dec, err = zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+	} else if err := dec.Reset(r); err != nil {
+		c.decoders.Put(dec)
+		return nil, err
+	}
+	return &reader{dec: dec, pool: &c.decoders}, nil
+}
+
+type writer struct {
+	*zstd.Encoder
+	pool *sync.Pool
+}
+
+// Close flushes all data to the underlying writer and returns the encoder to
+// the pool. The writer must not be used after it is closed.
+func (w *writer) Close() error {
+	err := w.Encoder.Close()
+	w.pool.Put(w.Encoder)
+	return err
+}
+
+type reader struct {
+	dec  *zstd.Decoder
+	pool *sync.Pool
+}
+
+// Read reads decompressed data into p. The decoder is returned to the pool
+// once all data has been read.
+func (r *reader) Read(p []byte) (n int, err error) {
+	if r.dec == nil {
+		return 0, io.EOF
+	}
+	n, err = r.dec.Read(p)
+	if err == io.EOF {
+		_ = r.dec.Reset(nil)
+		r.pool.Put(r.dec)
+		r.dec = nil
+	}
+	return n, err
+}
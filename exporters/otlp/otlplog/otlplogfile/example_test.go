@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlplogfile_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlplogfile"
+	"go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/sdk/log"
+	collogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+)
+
+func Example() {
+	ctx := context.Background()
+	exp, err := otlplogfile.New(
+		otlplogfile.WithPath("logs.jsonl"),
+		// Rotate the file daily or when it reaches 100 MiB.
+		otlplogfile.WithRotationInterval(24*time.Hour),
+		otlplogfile.WithMaxSize(100<<20),
+		// Keep a week of compressed rotated files.
+		otlplogfile.WithMaxBackups(7),
+		otlplogfile.WithGzip(),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	processor := log.NewBatchProcessor(exp)
+	provider := log.NewLoggerProvider(log.WithProcessor(processor))
+	defer func() {
+		if err := provider.Shutdown(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	global.SetLoggerProvider(provider)
+}
+
+func ExampleReadFile() {
+	// Replay the log records written to logs.jsonl and its rotated files.
+	err := otlplogfile.ReadFile("logs.jsonl", func(req *collogpb.ExportLogsServiceRequest) error {
+		// E.g. send req to an OTLP endpoint.
+		fmt.Println(len(req.ResourceLogs))
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlplogfile // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlplogfile"
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlplogfile/internal/otlpfile"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlplogfile/internal/otlpjson"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlplogfile/internal/transform"
+	"go.opentelemetry.io/otel/sdk/log"
+	collogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+)
+
+// Compile-time check Exporter implements [log.Exporter].
+var _ log.Exporter = (*Exporter)(nil)
+
+// Exporter writes log records as OTLP JSON Lines to a file or an io.Writer.
+// Exporter must be created with [New].
+type Exporter struct {
+	writer  *otlpfile.Writer
+	stopped atomic.Bool
+}
+
+// New returns a new [Exporter] configured with options.
+//
+// If a path is set with [WithPath], the file is opened and an error is
+// returned if it cannot be.
+//
+// It is recommended to use it with a [log.BatchProcessor].
+func New(options ...Option) (*Exporter, error) {
+	cfg := newConfig(options)
+	w, err := otlpfile.NewWriter(cfg.file)
+	if err != nil {
+		return nil, err
+	}
+	return &Exporter{writer: w}, nil
+}
+
+// Export writes records as a single OTLP/JSON encoded export request line.
+func (e *Exporter) Export(ctx context.Context, records []log.Record) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if e.stopped.Load() {
+		return nil
+	}
+	otlp := transform.ResourceLogs(records)
+	if otlp == nil {
+		return nil
+	}
+
+	b, err := otlpjson.Marshal(&collogpb.ExportLogsServiceRequest{ResourceLogs: otlp})
+	if err != nil {
+		return err
+	}
+	return e.writer.WriteLine(b)
+}
+
+// Shutdown closes the file written. Calls to Export or ForceFlush will
+// perform no operation after this is called.
+func (e *Exporter) Shutdown(context.Context) error {
+	if e.stopped.Swap(true) {
+		return nil
+	}
+	return e.writer.Close()
+}
+
+// ForceFlush commits the file written to stable storage.
+func (e *Exporter) ForceFlush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return e.writer.Sync()
+}
+
+// ReadFile calls fn with each export request read from the files written to
+// path by an [Exporter]: the rotated files from the oldest to the newest
+// first, and path itself last. Rotated files compressed with gzip are
+// decompressed. It stops at the first error returned by fn, and returns it.
+//
+// ReadFile can be used to replay the log records written, e.g. by sending
+// the export requests to an OTLP endpoint.
+func ReadFile(path string, fn func(*collogpb.ExportLogsServiceRequest) error) error {
+	return otlpfile.ReadFiles(path, decode(fn))
+}
+
+// Read calls fn with each export request read from r. It stops at the first
+// error returned by fn, and returns it.
+func Read(r io.Reader, fn func(*collogpb.ExportLogsServiceRequest) error) error {
+	return otlpfile.Read(r, decode(fn))
+}
+
+func decode(fn func(*collogpb.ExportLogsServiceRequest) error) func([]byte) error {
+	return func(b []byte) error {
+		req := new(collogpb.ExportLogsServiceRequest)
+		if err := otlpjson.Unmarshal(b, req); err != nil {
+			return err
+		}
+		return fn(req)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlplogfile
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+	collogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+)
+
+var (
+	traceID = trace.TraceID{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+	spanID  = trace.SpanID{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+)
+
+func records(bodies ...string) []sdklog.Record {
+	out := make([]sdklog.Record, len(bodies))
+	for i, body := range bodies {
+		out[i] = logtest.RecordFactory{
+			Timestamp:            time.Unix(1, 0),
+			Severity:             log.SeverityInfo,
+			Body:                 log.StringValue(body),
+			TraceID:              traceID,
+			SpanID:               spanID,
+			Resource:             resource.NewSchemaless(attribute.String("service.name", "test")),
+			InstrumentationScope: &instrumentation.Scope{Name: "test"},
+		}.NewRecord()
+	}
+	return out
+}
+
+func readAll(t *testing.T, read func(func(*collogpb.ExportLogsServiceRequest) error) error) []string {
+	t.Helper()
+	var bodies []string
+	require.NoError(t, read(func(req *collogpb.ExportLogsServiceRequest) error {
+		for _, rl := range req.ResourceLogs {
+			for _, sl := range rl.ScopeLogs {
+				for _, r := range sl.LogRecords {
+					bodies = append(bodies, r.Body.GetStringValue())
+				}
+			}
+		}
+		return nil
+	}))
+	return bodies
+}
+
+func TestExporterWriter(t *testing.T) {
+	var buf bytes.Buffer
+	exp, err := New(WithWriter(&buf))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, exp.Export(ctx, records("a", "b")))
+	require.NoError(t, exp.Export(ctx, nil))
+	require.NoError(t, exp.Export(ctx, records("c")))
+	require.NoError(t, exp.ForceFlush(ctx))
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 2, "one line per export request")
+
+	// The OTLP/JSON encoding uses hex encoded IDs.
+	var v struct {
+		ResourceLogs []struct {
+			ScopeLogs []struct {
+				LogRecords []struct {
+					TraceID string `json:"traceId"`
+					SpanID  string `json:"spanId"`
+				} `json:"logRecords"`
+			} `json:"scopeLogs"`
+		} `json:"resourceLogs"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &v))
+	r := v.ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+	assert.Equal(t, traceID.String(), r.TraceID)
+	assert.Equal(t, spanID.String(), r.SpanID)
+
+	assert.Equal(t, []string{"a", "b", "c"}, readAll(t, func(fn func(*collogpb.ExportLogsServiceRequest) error) error {
+		return Read(&buf, fn)
+	}))
+}
+
+func TestExporterFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs.jsonl")
+	exp, err := New(WithPath(path), WithMaxSize(1), WithMaxBackups(1), WithGzip())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for _, body := range []string{"a", "b", "c"} {
+		require.NoError(t, exp.Export(ctx, records(body)))
+	}
+	require.NoError(t, exp.Shutdown(ctx))
+
+	// The oldest rotated file is removed.
+	assert.Equal(t, []string{"b", "c"}, readAll(t, func(fn func(*collogpb.ExportLogsServiceRequest) error) error {
+		return ReadFile(path, fn)
+	}))
+}
+
+func TestExporterInvalidPath(t *testing.T) {
+	_, err := New(WithPath(filepath.Join(t.TempDir(), "missing", "logs.jsonl")))
+	assert.Error(t, err)
+}
+
+func TestExporterShutdown(t *testing.T) {
+	var buf bytes.Buffer
+	exp, err := New(WithWriter(&buf))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, exp.Shutdown(ctx))
+	assert.NoError(t, exp.Shutdown(ctx), "second Shutdown")
+	assert.NoError(t, exp.Export(ctx, records("a")), "Export after Shutdown")
+	assert.NoError(t, exp.ForceFlush(ctx), "ForceFlush after Shutdown")
+	assert.Empty(t, buf.String())
+}
+
+func TestExporterCanceled(t *testing.T) {
+	var buf bytes.Buffer
+	exp, err := New(WithWriter(&buf))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, exp.Export(ctx, records("a")), context.Canceled)
+	assert.ErrorIs(t, exp.ForceFlush(ctx), context.Canceled)
+	assert.Empty(t, buf.String())
+}
+
+func TestExporterConcurrentSafe(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs.jsonl")
+	exp, err := New(WithPath(path), WithMaxSize(512))
+	require.NoError(t, err)
+
+	const goroutines = 5
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, exp.Export(ctx, records("a")))
+			assert.NoError(t, exp.ForceFlush(ctx))
+		}()
+	}
+	wg.Wait()
+	require.NoError(t, exp.Shutdown(ctx))
+
+	assert.Len(t, readAll(t, func(fn func(*collogpb.ExportLogsServiceRequest) error) error {
+		return ReadFile(path, fn)
+	}), goroutines)
+}
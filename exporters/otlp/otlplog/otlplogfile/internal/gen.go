@@ -0,0 +1,15 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlplogfile/internal"
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpfile/file.go.tmpl "--data={}" --out=otlpfile/file.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpfile/file_test.go.tmpl "--data={}" --out=otlpfile/file_test.go
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpjson/json.go.tmpl "--data={}" --out=otlpjson/json.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpjson/json_test.go.tmpl "--data={}" --out=otlpjson/json_test.go
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/attr_test.go.tmpl "--data={}" --out=transform/attr_test.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/log.go.tmpl "--data={}" --out=transform/log.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/log_attr_test.go.tmpl "--data={}" --out=transform/log_attr_test.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/log_test.go.tmpl "--data={}" --out=transform/log_test.go
@@ -5,7 +5,9 @@ package otlploggrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/o
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
@@ -14,21 +16,30 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc/internal/failover"
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc/internal/tlsreload"
 	collogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
 	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
 )
 
 // The methods of this type are not expected to be called concurrently.
 type client struct {
-	metadata      metadata.MD
-	exportTimeout time.Duration
-	requestFunc   retry.RequestFunc
+	metadata       metadata.MD
+	headerFn       HeaderProvider
+	exportTimeout  time.Duration
+	requestFunc    retry.RequestFunc
+	maxRequestSize int
+	resultHandler  ExportResultHandler
+	// failover is the set of endpoints to fail over between, or nil if the
+	// client only sends to the configured endpoint.
+	failover *failover.Set
 
 	// ourConn keeps track of where conn was created: true if created here in
 	// NewClient, or false if passed with an option. This is important on
@@ -37,6 +48,11 @@ type client struct {
 	ourConn bool
 	conn    *grpc.ClientConn
 	lsc     collogpb.LogsServiceClient
+
+	// failoverConns are the connections to the failover endpoints, and
+	// failoverLSC the clients using them by endpoint.
+	failoverConns []*grpc.ClientConn
+	failoverLSC   map[string]collogpb.LogsServiceClient
 }
 
 // Used for testing.
@@ -45,9 +61,12 @@ var newGRPCClientFn = grpc.NewClient
 // newClient creates a new gRPC log client.
 func newClient(cfg config) (*client, error) {
 	c := &client{
-		exportTimeout: cfg.timeout.Value,
-		requestFunc:   cfg.retryCfg.Value.RequestFunc(retryable),
-		conn:          cfg.gRPCConn.Value,
+		exportTimeout:  cfg.timeout.Value,
+		maxRequestSize: cfg.maxRequestSize.Value,
+		requestFunc:    cfg.retryCfg.Value.RequestFunc(retryable),
+		conn:           cfg.gRPCConn.Value,
+		headerFn:       cfg.headerProvider.Value,
+		resultHandler:  cfg.resultHandler.Value,
 	}
 
 	if len(cfg.headers.Value) > 0 {
@@ -59,6 +78,13 @@ func newClient(cfg config) (*client, error) {
 		// created, create one using the configuration they did provide.
 		dialOpts := newGRPCDialOptions(cfg)
 
+		if c.failover = failover.NewSet(cfg.failover.Value); c.failover != nil {
+			if err := c.connectFailover(dialOpts); err != nil {
+				return nil, err
+			}
+			return c, nil
+		}
+
 		conn, err := newGRPCClientFn(cfg.endpoint.Value, dialOpts...)
 		if err != nil {
 			return nil, err
@@ -74,6 +100,34 @@ func newClient(cfg config) (*client, error) {
 	return c, nil
 }
 
+// connectFailover creates a gRPC connection to every failover endpoint.
+func (c *client) connectFailover(dialOpts []grpc.DialOption) error {
+	c.failoverLSC = make(map[string]collogpb.LogsServiceClient)
+	for _, endpoint := range c.failover.Endpoints() {
+		conn, err := newGRPCClientFn(endpoint, dialOpts...)
+		if err != nil {
+			_ = c.closeConns()
+			return err
+		}
+		c.failoverConns = append(c.failoverConns, conn)
+		c.failoverLSC[endpoint] = collogpb.NewLogsServiceClient(conn)
+	}
+	c.ourConn = true
+	return nil
+}
+
+// closeConns closes the connections created by the client.
+func (c *client) closeConns() error {
+	if c.failover == nil {
+		return c.conn.Close()
+	}
+	var err error
+	for _, conn := range c.failoverConns {
+		err = errors.Join(err, conn.Close())
+	}
+	return err
+}
+
 func newGRPCDialOptions(cfg config) []grpc.DialOption {
 	userAgent := "OTel Go OTLP over gRPC logs exporter/" + Version()
 	dialOpts := []grpc.DialOption{grpc.WithUserAgent(userAgent)}
@@ -87,6 +141,14 @@ func newGRPCDialOptions(cfg config) []grpc.DialOption {
 	// Prioritize GRPCCredentials over Insecure (passing both is an error).
 	if cfg.gRPCCredentials.Value != nil {
 		dialOpts = append(dialOpts, grpc.WithTransportCredentials(cfg.gRPCCredentials.Value))
+	} else if files := cfg.tlsFiles.Value; !files.Empty() {
+		serverName := tlsreload.Host(cfg.endpoint.Value)
+		if len(cfg.failover.Value.Endpoints) > 0 {
+			// Verify the server certificate for the endpoint connected to.
+			serverName = ""
+		}
+		tlsCfg := tlsreload.Config(cfg.tlsCfg.Value, serverName, files)
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)))
 	} else if cfg.insecure.Value {
 		dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	} else {
@@ -96,8 +158,8 @@ func newGRPCDialOptions(cfg config) []grpc.DialOption {
 		))
 	}
 	// Compression
-	if cfg.compression.Value == GzipCompression {
-		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
+	if name := cfg.compressorName(); name != "" {
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.UseCompressor(name)))
 	}
 	// Reconnection period
 	if cfg.reconnectionPeriod.Value != 0 {
@@ -130,25 +192,73 @@ func (c *client) UploadLogs(ctx context.Context, rl []*logpb.ResourceLogs) error
 	ctx, cancel := c.exportContext(ctx)
 	defer cancel()
 
-	return c.requestFunc(ctx, func(ctx context.Context) error {
-		resp, err := c.lsc.Export(ctx, &collogpb.ExportLogsServiceRequest{
-			ResourceLogs: rl,
-		})
-		if resp != nil && resp.PartialSuccess != nil {
-			msg := resp.PartialSuccess.GetErrorMessage()
-			n := resp.PartialSuccess.GetRejectedLogRecords()
-			if n != 0 || msg != "" {
-				err := fmt.Errorf("OTLP partial success: %s (%d log records rejected)", msg, n)
-				otel.Handle(err)
-			}
+	res := new(internal.ExportResult)
+	err := c.upload(ctx, rl, res)
+	if c.resultHandler != nil {
+		res.Err = err
+		c.resultHandler(newExportResult(*res))
+	}
+	return err
+}
+
+// upload sends rl in a single request. If the request exceeds the maximum
+// request size or is rejected by the server as too large, rl is split and
+// sent in multiple requests. The outcome of the requests is recorded in res.
+func (c *client) upload(ctx context.Context, rl []*logpb.ResourceLogs, res *internal.ExportResult) error {
+	req := &collogpb.ExportLogsServiceRequest{ResourceLogs: rl}
+	if c.maxRequestSize > 0 {
+		if size := proto.Size(req); size > c.maxRequestSize {
+			return c.uploadSplit(ctx, rl, res, internal.RequestSizeError(size, c.maxRequestSize))
 		}
-		// nil is converted to OK.
-		if status.Code(err) == codes.OK {
-			// Success.
-			return nil
+	}
+
+	err := c.requestFunc(ctx, func(ctx context.Context) error {
+		ctx, err := c.headerContext(ctx)
+		if err != nil {
+			return err
 		}
-		return err
+		if c.failover == nil {
+			return export(ctx, c.lsc, req, res)
+		}
+		return c.failover.Do(ctx, func(endpoint string) error {
+			return export(ctx, c.failoverLSC[endpoint], req, res)
+		}, shouldFailover)
 	})
+	if tooLarge(err) {
+		return c.uploadSplit(ctx, rl, res, err)
+	}
+	return err
+}
+
+// export sends req using lsc and records the attempt in res.
+func export(ctx context.Context, lsc collogpb.LogsServiceClient, req *collogpb.ExportLogsServiceRequest, res *internal.ExportResult) error {
+	res.Attempt()
+	resp, err := lsc.Export(ctx, req)
+	if resp != nil && resp.PartialSuccess != nil {
+		msg := resp.PartialSuccess.GetErrorMessage()
+		n := resp.PartialSuccess.GetRejectedLogRecords()
+		if n != 0 || msg != "" {
+			res.PartialSuccess(n, msg)
+			err := fmt.Errorf("OTLP partial success: %s (%d log records rejected)", msg, n)
+			otel.Handle(err)
+		}
+	}
+	// nil is converted to OK.
+	if status.Code(err) == codes.OK {
+		// Success.
+		return nil
+	}
+	return err
+}
+
+// uploadSplit splits rl in two and uploads both halves. If rl cannot be
+// split, err is returned.
+func (c *client) uploadSplit(ctx context.Context, rl []*logpb.ResourceLogs, res *internal.ExportResult, err error) error {
+	a, b, ok := internal.SplitResourceLogs(rl)
+	if !ok {
+		return err
+	}
+	return errors.Join(c.upload(ctx, a, res), c.upload(ctx, b, res))
 }
 
 // Shutdown shuts down the client, freeing all resources.
@@ -165,20 +275,47 @@ func (c *client) Shutdown(ctx context.Context) error {
 	c.metadata = nil
 	c.requestFunc = nil
 	c.lsc = nil
+	c.failoverLSC = nil
 
 	// Release the connection if we created it.
 	err := ctx.Err()
 	if c.ourConn {
-		closeErr := c.conn.Close()
+		closeErr := c.closeConns()
 		// A context timeout error takes precedence over this error.
 		if err == nil && closeErr != nil {
 			err = closeErr
 		}
 	}
 	c.conn = nil
+	c.failoverConns = nil
 	return err
 }
 
+// headerContext returns a copy of ctx with the outgoing metadata updated
+// with the headers returned by the configured header provider. If no header
+// provider is configured, ctx is returned.
+func (c *client) headerContext(ctx context.Context) (context.Context, error) {
+	if c.headerFn == nil {
+		return ctx, nil
+	}
+	headers, err := c.headerFn(ctx)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to get headers: %w", err)
+	}
+	if len(headers) == 0 {
+		return ctx, nil
+	}
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		md = metadata.MD{}
+	}
+	for k, v := range headers {
+		md.Set(k, v)
+	}
+	return metadata.NewOutgoingContext(ctx, md), nil
+}
+
 // exportContext returns a copy of parent with an appropriate deadline and
 // cancellation function based on the clients configured export timeout.
 //
@@ -241,6 +378,31 @@ func retryableGRPCStatus(s *status.Status) (bool, time.Duration) {
 	return false, 0
 }
 
+// tooLarge returns if err identifies a request that was rejected because
+// it exceeds the maximum message size of the client or server.
+func tooLarge(err error) bool {
+	s, ok := status.FromError(err)
+	return ok && s.Code() == codes.ResourceExhausted && strings.Contains(s.Message(), "larger than max")
+}
+
+// shouldFailover returns if err identifies a request that should be sent to
+// the next failover endpoint: the endpoint is unavailable or rejected the
+// request with a status that is not retryable.
+func shouldFailover(err error) bool {
+	s := status.Convert(err)
+	switch s.Code() {
+	case codes.Unavailable:
+		return true
+	case codes.ResourceExhausted:
+		if tooLarge(err) {
+			// The request is split instead.
+			return false
+		}
+	}
+	retry, _ := retryableGRPCStatus(s)
+	return !retry
+}
+
 // throttleDelay returns if the status is RetryInfo
 // and the duration to wait for if an explicit throttle time is included.
 func throttleDelay(s *status.Status) (bool, time.Duration) {
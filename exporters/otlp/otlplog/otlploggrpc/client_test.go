@@ -5,8 +5,10 @@ package otlploggrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/o
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -517,6 +519,198 @@ func TestClient(t *testing.T) {
 		}
 	})
 
+	t.Run("HeaderProvider", func(t *testing.T) {
+		rCh := make(chan exportResult, 2)
+		rCh <- exportResult{Err: status.Error(codes.Unavailable, "unavailable")}
+		rCh <- exportResult{}
+		coll, err := newGRPCCollector("", rCh)
+		require.NoError(t, err)
+		t.Cleanup(coll.srv.Stop)
+
+		var calls int
+		cfg := newConfig([]Option{
+			WithEndpoint(coll.listener.Addr().String()),
+			WithInsecure(),
+			WithHeaders(map[string]string{"static": "value"}),
+			WithHeaderProvider(func(context.Context) (map[string]string, error) {
+				calls++
+				return map[string]string{"authorization": fmt.Sprintf("Bearer token-%d", calls)}, nil
+			}),
+			WithRetry(RetryConfig{
+				Enabled:         true,
+				InitialInterval: time.Nanosecond,
+				MaxInterval:     time.Millisecond,
+				MaxElapsedTime:  time.Minute,
+			}),
+		})
+		client, err := newClient(cfg)
+		require.NoError(t, err)
+
+		ctx := context.Background()
+		require.NoError(t, client.UploadLogs(ctx, resourceLogs))
+		require.NoError(t, client.Shutdown(ctx))
+
+		assert.Equal(t, 2, calls, "header provider not called for each attempt")
+		coll.headersMu.Lock()
+		defer coll.headersMu.Unlock()
+		assert.Equal(t, []string{"Bearer token-1", "Bearer token-2"}, coll.headers.Get("authorization"))
+		assert.Equal(t, []string{"value", "value"}, coll.headers.Get("static"))
+	})
+
+	t.Run("HeaderProviderError", func(t *testing.T) {
+		coll, err := newGRPCCollector("", nil)
+		require.NoError(t, err)
+		t.Cleanup(coll.srv.Stop)
+
+		providerErr := errors.New("provider error")
+		cfg := newConfig([]Option{
+			WithEndpoint(coll.listener.Addr().String()),
+			WithInsecure(),
+			WithHeaderProvider(func(context.Context) (map[string]string, error) {
+				return nil, providerErr
+			}),
+		})
+		client, err := newClient(cfg)
+		require.NoError(t, err)
+
+		ctx := context.Background()
+		assert.ErrorIs(t, client.UploadLogs(ctx, resourceLogs), providerErr)
+		require.NoError(t, client.Shutdown(ctx))
+		assert.Empty(t, coll.Collect().Dump())
+	})
+
+	logRecords := func(bodies ...string) []*lpb.ResourceLogs {
+		lr := make([]*lpb.LogRecord, len(bodies))
+		for i, b := range bodies {
+			lr[i] = &lpb.LogRecord{
+				Body: &cpb.AnyValue{Value: &cpb.AnyValue_StringValue{StringValue: b}},
+			}
+		}
+		sl := &lpb.ScopeLogs{LogRecords: lr}
+		return []*lpb.ResourceLogs{{ScopeLogs: []*lpb.ScopeLogs{sl}}}
+	}
+
+	t.Run("SplitRequestTooLarge", func(t *testing.T) {
+		rCh := make(chan exportResult, 3)
+		rCh <- exportResult{
+			Err: status.Error(codes.ResourceExhausted, "grpc: received message larger than max (3 vs. 2)"),
+		}
+		rCh <- exportResult{}
+		rCh <- exportResult{}
+
+		ctx := context.Background()
+		client, coll := clientFactory(t, rCh)
+		require.NoError(t, client.UploadLogs(ctx, logRecords("0", "1", "2")))
+		require.NoError(t, client.Shutdown(ctx))
+
+		got := coll.Collect().Dump()
+		require.Len(t, got, 3, "rejected request and its two halves")
+		assert.Len(t, got[1].ScopeLogs[0].LogRecords, 1)
+		assert.Len(t, got[2].ScopeLogs[0].LogRecords, 2)
+	})
+
+	t.Run("MaxRequestSize", func(t *testing.T) {
+		coll, err := newGRPCCollector("", nil)
+		require.NoError(t, err)
+		t.Cleanup(coll.srv.Stop)
+
+		cfg := newConfig([]Option{
+			WithEndpoint(coll.listener.Addr().String()),
+			WithInsecure(),
+			WithMaxRequestSize(512),
+		})
+		client, err := newClient(cfg)
+		require.NoError(t, err)
+
+		ctx := context.Background()
+		err = client.UploadLogs(ctx, logRecords(strings.Repeat("x", 1024), "1", "2"))
+		assert.ErrorContains(t, err, "exceeds the maximum request size of 512 bytes")
+		require.NoError(t, client.Shutdown(ctx))
+
+		got := coll.Collect().Dump()
+		require.Len(t, got, 1, "log records within the maximum request size not batched")
+		lr := got[0].ScopeLogs[0].LogRecords
+		require.Len(t, lr, 2)
+		assert.Equal(t, "1", lr[0].Body.GetStringValue())
+		assert.Equal(t, "2", lr[1].Body.GetStringValue())
+	})
+
+	t.Run("Failover", func(t *testing.T) {
+		primary, err := newGRPCCollector("", nil)
+		require.NoError(t, err)
+		backup, err := newGRPCCollector("", nil)
+		require.NoError(t, err)
+		t.Cleanup(backup.srv.Stop)
+
+		cfg := newConfig([]Option{
+			WithInsecure(),
+			WithFailover(FailoverConfig{
+				Endpoints: []FailoverEndpoint{
+					{Endpoint: primary.listener.Addr().String()},
+					{Endpoint: backup.listener.Addr().String()},
+				},
+				InitialBackoff: 10 * time.Millisecond,
+				MaxBackoff:     10 * time.Millisecond,
+			}),
+		})
+		client, err := newClient(cfg)
+		require.NoError(t, err)
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, client.Shutdown(ctx)) })
+
+		require.NoError(t, client.UploadLogs(ctx, logRecords("0")))
+		assert.Len(t, primary.Collect().Dump(), 1, "primary endpoint not used")
+		assert.Empty(t, backup.Collect().Dump())
+
+		primary.srv.Stop()
+		require.NoError(t, client.UploadLogs(ctx, logRecords("1")))
+		assert.Len(t, backup.Collect().Dump(), 1, "no failover to backup endpoint")
+
+		// Restart the primary endpoint and wait for the client to return to
+		// it.
+		primary, err = newGRPCCollector(primary.listener.Addr().String(), nil)
+		require.NoError(t, err)
+		t.Cleanup(primary.srv.Stop)
+		assert.Eventually(t, func() bool {
+			require.NoError(t, client.UploadLogs(ctx, logRecords("2")))
+			return len(primary.Collect().Dump()) > 0
+		}, 10*time.Second, 50*time.Millisecond, "no return to recovered primary endpoint")
+	})
+
+	t.Run("FailoverNonRetryable", func(t *testing.T) {
+		rCh := make(chan exportResult, 1)
+		rCh <- exportResult{Err: status.Error(codes.InvalidArgument, "invalid")}
+		primary, err := newGRPCCollector("", rCh)
+		require.NoError(t, err)
+		t.Cleanup(primary.srv.Stop)
+		backup, err := newGRPCCollector("", nil)
+		require.NoError(t, err)
+		t.Cleanup(backup.srv.Stop)
+
+		cfg := newConfig([]Option{
+			WithInsecure(),
+			WithFailover(FailoverConfig{
+				Endpoints: []FailoverEndpoint{
+					{Endpoint: primary.listener.Addr().String()},
+					{Endpoint: backup.listener.Addr().String()},
+				},
+			}),
+		})
+		client, err := newClient(cfg)
+		require.NoError(t, err)
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, client.Shutdown(ctx)) })
+
+		require.NoError(t, client.UploadLogs(ctx, logRecords("0")))
+		assert.Len(t, primary.Collect().Dump(), 1, "rejected request")
+		assert.Len(t, backup.Collect().Dump(), 1, "no failover to backup endpoint")
+
+		// The primary endpoint is unhealthy until its backoff elapses.
+		require.NoError(t, client.UploadLogs(ctx, logRecords("1")))
+		assert.Empty(t, primary.Collect().Dump())
+		assert.Len(t, backup.Collect().Dump(), 1)
+	})
+
 	t.Run("PartialSuccess", func(t *testing.T) {
 		const n, msg = 2, "bad data"
 		rCh := make(chan exportResult, 3)
@@ -560,4 +754,55 @@ func TestClient(t *testing.T) {
 		want := fmt.Sprintf("%s (%d log records rejected)", msg, n)
 		assert.ErrorContains(t, errs[0], want)
 	})
+
+	t.Run("ExportResultHandler", func(t *testing.T) {
+		rCh := make(chan exportResult, 3)
+		rCh <- exportResult{Err: status.Error(codes.Unavailable, "unavailable")}
+		rCh <- exportResult{
+			Response: &collogpb.ExportLogsServiceResponse{
+				PartialSuccess: &collogpb.ExportLogsPartialSuccess{
+					RejectedLogRecords: 2,
+					ErrorMessage:       "bad data",
+				},
+			},
+		}
+		rCh <- exportResult{Err: status.Error(codes.InvalidArgument, "invalid")}
+		coll, err := newGRPCCollector("", rCh)
+		require.NoError(t, err)
+		t.Cleanup(coll.srv.Stop)
+
+		var results []ExportResult
+		cfg := newConfig([]Option{
+			WithEndpoint(coll.listener.Addr().String()),
+			WithInsecure(),
+			WithRetry(RetryConfig{
+				Enabled:         true,
+				InitialInterval: time.Nanosecond,
+				MaxInterval:     time.Millisecond,
+				MaxElapsedTime:  time.Minute,
+			}),
+			WithExportResultHandler(func(r ExportResult) {
+				results = append(results, r)
+			}),
+		})
+		client, err := newClient(cfg)
+		require.NoError(t, err)
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, client.Shutdown(ctx)) })
+
+		require.NoError(t, client.UploadLogs(ctx, resourceLogs))
+		require.Len(t, results, 1)
+		assert.Equal(t, ExportResult{
+			Disposition:      ExportPartiallyAccepted,
+			Attempts:         2,
+			Rejected:         2,
+			RejectedMessages: []string{"bad data"},
+		}, results[0])
+
+		require.Error(t, client.UploadLogs(ctx, resourceLogs))
+		require.Len(t, results, 2)
+		assert.Equal(t, ExportFailed, results[1].Disposition)
+		assert.Equal(t, 1, results[1].Attempts)
+		assert.Equal(t, codes.InvalidArgument, status.Code(results[1].Err))
+	})
 }
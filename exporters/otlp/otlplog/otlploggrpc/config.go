@@ -4,6 +4,7 @@
 package otlploggrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
@@ -16,9 +17,13 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc/internal/failover"
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc/internal/tlsreload"
 	"go.opentelemetry.io/otel/internal/global"
 )
 
@@ -81,13 +86,19 @@ type Option interface {
 }
 
 type config struct {
-	endpoint    setting[string]
-	insecure    setting[bool]
-	tlsCfg      setting[*tls.Config]
-	headers     setting[map[string]string]
-	compression setting[Compression]
-	timeout     setting[time.Duration]
-	retryCfg    setting[retry.Config]
+	endpoint       setting[string]
+	insecure       setting[bool]
+	tlsCfg         setting[*tls.Config]
+	tlsFiles       setting[tlsreload.Files]
+	headers        setting[map[string]string]
+	headerProvider setting[HeaderProvider]
+	compression    setting[Compression]
+	compressor     setting[string]
+	timeout        setting[time.Duration]
+	maxRequestSize setting[int]
+	failover       setting[failover.Config]
+	resultHandler  setting[ExportResultHandler]
+	retryCfg       setting[retry.Config]
 
 	// gRPC configurations
 	gRPCCredentials    setting[credentials.TransportCredentials]
@@ -111,6 +122,9 @@ func newConfig(options []Option) config {
 	c.insecure = c.insecure.Resolve(
 		getEnv[bool](envInsecure, convInsecure),
 	)
+	c.tlsFiles = c.tlsFiles.Resolve(
+		loadEnvTLSFiles[tlsreload.Files](),
+	)
 	c.tlsCfg = c.tlsCfg.Resolve(
 		loadEnvTLS[*tls.Config](),
 	)
@@ -128,6 +142,8 @@ func newConfig(options []Option) config {
 		fallback[retry.Config](defaultRetryCfg),
 	)
 
+	c = c.withRegisteredCompressor()
+
 	return c
 }
 
@@ -138,6 +154,39 @@ func newConfig(options []Option) config {
 // entirely handled by the gRPC ClientConn.
 type RetryConfig retry.Config
 
+// TLSFiles are the paths to the PEM encoded files used to configure TLS.
+//
+// CAFile is the path to the CA bundle used to verify the server certificate.
+// CertFile and KeyFile are the paths to the client certificate and its
+// private key used for mTLS. They are only used if both are set.
+type TLSFiles tlsreload.Files
+
+// FailoverConfig defines the endpoints the Exporter fails over between.
+type FailoverConfig struct {
+	// Endpoints are the endpoints in the order they are preferred.
+	Endpoints []FailoverEndpoint
+	// InitialBackoff is the time an endpoint is not used after it failed.
+	// It is doubled for every consecutive failure of the endpoint up to
+	// MaxBackoff. If zero, 5 seconds is used.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum time an endpoint is not used after it
+	// failed. If zero, 1 minute is used.
+	MaxBackoff time.Duration
+}
+
+// FailoverEndpoint is an endpoint the Exporter fails over to.
+type FailoverEndpoint struct {
+	// Endpoint is the host and port of the endpoint. It should resemble
+	// "example.com:4317".
+	Endpoint string
+	// Weight is the relative share of the batches sent to the endpoint
+	// while it is healthy. Batches are distributed between all the healthy
+	// endpoints with a non-zero weight. Endpoints with a zero weight are
+	// backups that are only used, in the order they are configured, when
+	// no endpoint with a weight is healthy.
+	Weight int
+}
+
 // WithInsecure disables client transport security for the Exporter's gRPC
 // connection, just like grpc.WithInsecure()
 // (https://pkg.go.dev/google.golang.org/grpc#WithInsecure) does.
@@ -232,15 +281,34 @@ const (
 	NoCompression Compression = iota
 	// GzipCompression represents that gzip compression should be used.
 	GzipCompression
+	// ZstdCompression represents that zstd compression should be used.
+	//
+	// A compressor named "zstd" needs to be registered with
+	// google.golang.org/grpc/encoding, e.g. by importing
+	// go.opentelemetry.io/otel/exporters/otlp/otlpcompress/zstd.
+	// Otherwise, payloads are sent uncompressed.
+	ZstdCompression
+	// SnappyCompression represents that snappy compression should be used.
+	//
+	// A compressor named "snappy" needs to be registered with
+	// google.golang.org/grpc/encoding, e.g. by importing
+	// go.opentelemetry.io/otel/exporters/otlp/otlpcompress/snappy.
+	// Otherwise, payloads are sent uncompressed.
+	SnappyCompression
 )
 
 // WithCompressor sets the compressor the gRPC client uses.
-// Supported compressor values: "gzip".
+// The compressor needs to be registered with google.golang.org/grpc/encoding.
+// The "gzip" compressor is registered by default, "zstd" and "snappy"
+// compressors are registered by importing
+// go.opentelemetry.io/otel/exporters/otlp/otlpcompress/zstd and
+// go.opentelemetry.io/otel/exporters/otlp/otlpcompress/snappy respectively.
+// If compressor is not registered, no compressor will be used.
 //
 // If the OTEL_EXPORTER_OTLP_COMPRESSION or
 // OTEL_EXPORTER_OTLP_LOGS_COMPRESSION environment variable is set, and
 // this option is not passed, that variable value will be used. That value can
-// be either "none" or "gzip". If both are set,
+// be "none", "gzip", "zstd", or "snappy". If both are set,
 // OTEL_EXPORTER_OTLP_LOGS_COMPRESSION will take precedence.
 //
 // By default, if an environment variable is not set, and this option is not
@@ -249,7 +317,13 @@ const (
 // This option has no effect if WithGRPCConn is used.
 func WithCompressor(compressor string) Option {
 	return fnOpt(func(c config) config {
+		if _, err := convCompression(compressor); err != nil && encoding.GetCompressor(compressor) != nil {
+			// A custom compressor registered with google.golang.org/grpc/encoding.
+			c.compressor = newSetting(compressor)
+			return c
+		}
 		c.compression = newSetting(compressorToCompression(compressor))
+		c.compressor = setting[string]{}
 		return c
 	})
 }
@@ -272,6 +346,26 @@ func WithHeaders(headers map[string]string) Option {
 	})
 }
 
+// HeaderProvider returns headers to send with a gRPC request. The returned
+// headers are sent in addition to, and take precedence over, the headers set
+// with WithHeaders or the OTEL_EXPORTER_OTLP_HEADERS and
+// OTEL_EXPORTER_OTLP_LOGS_HEADERS environment variables. If an error is
+// returned, the request is not sent and the export fails with the error.
+type HeaderProvider func(context.Context) (map[string]string, error)
+
+// WithHeaderProvider sets a HeaderProvider that is called before every export
+// attempt, including retries, to get the headers sent with the gRPC request.
+// This can be used to send headers whose values change over time, e.g.
+// short-lived bearer tokens.
+//
+// By default, no header provider is used.
+func WithHeaderProvider(provider HeaderProvider) Option {
+	return fnOpt(func(c config) config {
+		c.headerProvider = newSetting(provider)
+		return c
+	})
+}
+
 // WithTLSCredentials sets the gRPC connection to use creds.
 //
 // If the OTEL_EXPORTER_OTLP_CERTIFICATE or
@@ -287,6 +381,31 @@ func WithHeaders(headers map[string]string) Option {
 func WithTLSCredentials(credential credentials.TransportCredentials) Option {
 	return fnOpt(func(c config) config {
 		c.gRPCCredentials = newSetting(credential)
+		c.tlsFiles = newSetting(tlsreload.Files{})
+		return c
+	})
+}
+
+// WithTLSFiles sets the files the CA bundle, client certificate and client
+// key used for TLS are loaded from. The files are loaded when a connection to
+// the collector is established and are reloaded when their modification time
+// or size changes, so rotated certificates are used without restarting the
+// Exporter. If a changed file cannot be loaded, the error is passed to the
+// global ErrorHandler and the previously loaded certificates are used.
+//
+// If the OTEL_EXPORTER_OTLP_CERTIFICATE, OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE
+// and OTEL_EXPORTER_OTLP_CLIENT_KEY environment variables, or their
+// OTEL_EXPORTER_OTLP_LOGS_* counterparts, are set, and this option is not
+// passed, the files they define are used and reloaded when changed.
+//
+// Credentials passed with WithTLSCredentials after this option take precedence
+// over the files.
+//
+// This option has no effect if WithGRPCConn is used.
+func WithTLSFiles(files TLSFiles) Option {
+	return fnOpt(func(c config) config {
+		c.tlsFiles = newSetting(tlsreload.Files(files))
+		c.gRPCCredentials = setting[credentials.TransportCredentials]{}
 		return c
 	})
 }
@@ -352,6 +471,69 @@ func WithTimeout(duration time.Duration) Option {
 	})
 }
 
+// WithMaxRequestSize sets the maximum size, in bytes, of the serialized and
+// uncompressed export request message. A batch of log records that exceeds this
+// size is split into smaller requests before it is sent. If a single log record
+// exceeds this size on its own, it is dropped and an error is returned.
+//
+// Regardless of this option, a batch is split and the smaller requests are sent
+// if the target endpoint rejects the batch with a ResourceExhausted status code
+// because the message is larger than its maximum message size.
+//
+// By default, the size of export requests is not limited.
+func WithMaxRequestSize(n int) Option {
+	return fnOpt(func(c config) config {
+		c.maxRequestSize = newSetting(n)
+		return c
+	})
+}
+
+// WithFailover sets the endpoints the Exporter sends batches of log records to
+// instead of the endpoint set with WithEndpoint, WithEndpointURL, or
+// environment variables. A batch is sent to the first healthy endpoint. If
+// that endpoint cannot be reached, or rejects the batch with a status code
+// that is not retryable, it is marked as unhealthy and the batch is sent to
+// the next endpoint. An unhealthy endpoint is used again once its backoff has
+// elapsed, so the Exporter returns to a preferred endpoint when it recovers.
+//
+// The headers, TLS, and other settings of the Exporter are used for all the
+// endpoints. This option has no effect if WithGRPCConn is used.
+//
+// By default, the Exporter does not fail over.
+func WithFailover(fc FailoverConfig) Option {
+	return fnOpt(func(c config) config {
+		f := failover.Config{
+			Endpoints:      make([]failover.Endpoint, len(fc.Endpoints)),
+			InitialBackoff: fc.InitialBackoff,
+			MaxBackoff:     fc.MaxBackoff,
+		}
+		for i, e := range fc.Endpoints {
+			f.Endpoints[i] = failover.Endpoint(e)
+		}
+		c.failover = newSetting(f)
+		return c
+	})
+}
+
+// WithExportResultHandler sets a handler that is called with the result of the
+// export of every batch of log records. The result reports how many log records
+// the endpoint rejected in partial success responses and why, how many export
+// requests were sent, and whether the batch was dropped. It can be used to
+// measure data loss or to drive a circuit breaker.
+//
+// The handler is called synchronously once the export of a batch, including its
+// retries and split requests, has completed. It needs to return promptly to not
+// block the Exporter. Partial success responses are still reported to the
+// global error handler.
+//
+// By default, no handler is called.
+func WithExportResultHandler(h ExportResultHandler) Option {
+	return fnOpt(func(c config) config {
+		c.resultHandler = newSetting(h)
+		return c
+	})
+}
+
 // WithRetry sets the retry policy for transient retryable errors that are
 // returned by the target endpoint.
 //
@@ -378,6 +560,10 @@ func convCompression(s string) (Compression, error) {
 	switch s {
 	case "gzip":
 		return GzipCompression, nil
+	case "zstd":
+		return ZstdCompression, nil
+	case "snappy":
+		return SnappyCompression, nil
 	case "none", "":
 		return NoCompression, nil
 	}
@@ -494,6 +680,40 @@ func loadEnvTLS[T *tls.Config]() resolver[T] {
 	}
 }
 
+// loadEnvTLSFiles returns a resolver that sets the TLS files defined by the
+// OTLP TLS environment variables. These are the same files loaded by
+// loadEnvTLS, they are reloaded when they change on disk.
+func loadEnvTLSFiles[T tlsreload.Files]() resolver[T] {
+	return func(s setting[T]) setting[T] {
+		if s.Set {
+			// Passed, valid, options have precedence.
+			return s
+		}
+
+		var files tlsreload.Files
+		for _, key := range envTLSCert {
+			if v := os.Getenv(key); v != "" {
+				files.CAFile = v
+				break
+			}
+		}
+		for _, pair := range envTLSClient {
+			cert := os.Getenv(pair.Certificate)
+			key := os.Getenv(pair.Key)
+			if cert != "" && key != "" {
+				files.CertFile, files.KeyFile = cert, key
+				break
+			}
+		}
+
+		if !files.Empty() {
+			s.Set = true
+			s.Value = T(files)
+		}
+		return s
+	}
+}
+
 // readFile is used for testing.
 var readFile = os.ReadFile
 
@@ -529,6 +749,38 @@ func loadCertificates(certPath, keyPath string) ([]tls.Certificate, error) {
 	return []tls.Certificate{crt}, nil
 }
 
+// compressorName returns the name of the compressor registered with
+// google.golang.org/grpc/encoding used to compress payloads. An empty string
+// is returned if payloads are not compressed.
+func (c config) compressorName() string {
+	if c.compressor.Set {
+		return c.compressor.Value
+	}
+	switch c.compression.Value {
+	case GzipCompression:
+		return gzip.Name
+	case ZstdCompression:
+		return "zstd"
+	case SnappyCompression:
+		return "snappy"
+	}
+	return ""
+}
+
+// withRegisteredCompressor returns c with compression disabled if the
+// compressor it uses is not registered with google.golang.org/grpc/encoding.
+// This is reported with otel.Handle.
+func (c config) withRegisteredCompressor() config {
+	name := c.compressorName()
+	if name == "" || encoding.GetCompressor(name) != nil {
+		return c
+	}
+	otel.Handle(fmt.Errorf("compressor not registered: %s, using no compression", name))
+	c.compression = newSetting(NoCompression)
+	c.compressor = setting[string]{}
+	return c
+}
+
 func compressorToCompression(compressor string) Compression {
 	c, err := convCompression(compressor)
 	if err != nil {
@@ -4,10 +4,12 @@
 package otlploggrpc
 
 import (
+	"compress/flate"
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"io"
 	"testing"
 	"time"
 
@@ -15,9 +17,12 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/encoding"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc/internal/failover"
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc/internal/tlsreload"
 )
 
 const (
@@ -43,6 +48,27 @@ kbQ/kxzi9Ego0ZJdiXxkmv/C05QFddCW7Y0ZsJCLHGogQsYnWJBXUZOV
 `
 )
 
+// testCompressorName is the name of the compressor registered to test custom
+// compression.
+const testCompressorName = "otlp-test-deflate"
+
+func init() {
+	encoding.RegisterCompressor(deflateCompressor{})
+}
+
+// deflateCompressor is a custom compressor using the DEFLATE format.
+type deflateCompressor struct{}
+
+func (deflateCompressor) Name() string { return testCompressorName }
+
+func (deflateCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return flate.NewWriter(w, flate.DefaultCompression)
+}
+
+func (deflateCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	return flate.NewReader(r), nil
+}
+
 func newTLSConf(cert, key []byte) (*tls.Config, error) {
 	cp := x509.NewCertPool()
 	if ok := cp.AppendCertsFromPEM(cert); !ok {
@@ -113,14 +139,25 @@ func TestNewConfig(t *testing.T) {
 				WithDialOption(dialOptions...),
 				WithGRPCConn(&grpc.ClientConn{}),
 				WithTimeout(2 * time.Second),
+				WithMaxRequestSize(1024),
+				WithFailover(FailoverConfig{
+					Endpoints:      []FailoverEndpoint{{Endpoint: "backup:4317", Weight: 1}},
+					InitialBackoff: time.Second,
+				}),
 				WithRetry(RetryConfig(rc)),
 			},
 			want: config{
-				endpoint:           newSetting("test:8080"),
-				insecure:           newSetting(true),
-				headers:            newSetting(headers),
-				compression:        newSetting(GzipCompression),
-				timeout:            newSetting(2 * time.Second),
+				endpoint:       newSetting("test:8080"),
+				tlsFiles:       newSetting(tlsreload.Files{}),
+				insecure:       newSetting(true),
+				headers:        newSetting(headers),
+				compression:    newSetting(GzipCompression),
+				timeout:        newSetting(2 * time.Second),
+				maxRequestSize: newSetting(1024),
+				failover: newSetting(failover.Config{
+					Endpoints:      []failover.Endpoint{{Endpoint: "backup:4317", Weight: 1}},
+					InitialBackoff: time.Second,
+				}),
 				retryCfg:           newSetting(rc),
 				gRPCCredentials:    newSetting(credentials.NewTLS(tlsCfg)),
 				serviceConfig:      newSetting("{}"),
@@ -129,6 +166,59 @@ func TestNewConfig(t *testing.T) {
 				dialOptions:        newSetting(dialOptions),
 			},
 		},
+		{
+			name: "WithUnregisteredCompressorZstd",
+			options: []Option{
+				WithCompressor("zstd"),
+			},
+			want: config{
+				endpoint:    newSetting(defaultEndpoint),
+				compression: newSetting(NoCompression),
+				timeout:     newSetting(defaultTimeout),
+				retryCfg:    newSetting(defaultRetryCfg),
+			},
+		},
+		{
+			name: "WithEnvUnregisteredCompression",
+			envars: map[string]string{
+				"OTEL_EXPORTER_OTLP_LOGS_COMPRESSION": "snappy",
+			},
+			want: config{
+				endpoint:    newSetting(defaultEndpoint),
+				compression: newSetting(NoCompression),
+				timeout:     newSetting(defaultTimeout),
+				retryCfg:    newSetting(defaultRetryCfg),
+			},
+		},
+		{
+			name: "WithCustomCompressor",
+			options: []Option{
+				WithCompressor(testCompressorName),
+			},
+			envars: map[string]string{
+				"OTEL_EXPORTER_OTLP_LOGS_COMPRESSION": "gzip",
+			},
+			want: config{
+				endpoint:    newSetting(defaultEndpoint),
+				compression: newSetting(GzipCompression),
+				compressor:  newSetting(testCompressorName),
+				timeout:     newSetting(defaultTimeout),
+				retryCfg:    newSetting(defaultRetryCfg),
+			},
+		},
+		{
+			name: "WithTLSFiles",
+			options: []Option{
+				WithTLSCredentials(credentials.NewTLS(tlsCfg)),
+				WithTLSFiles(TLSFiles{CAFile: "ca.pem"}),
+			},
+			want: config{
+				endpoint: newSetting(defaultEndpoint),
+				tlsFiles: newSetting(tlsreload.Files{CAFile: "ca.pem"}),
+				timeout:  newSetting(defaultTimeout),
+				retryCfg: newSetting(defaultRetryCfg),
+			},
+		},
 		{
 			name: "WithEndpointURL",
 			options: []Option{
@@ -184,6 +274,7 @@ func TestNewConfig(t *testing.T) {
 				endpoint:    newSetting("env.endpoint:8080"),
 				insecure:    newSetting(false),
 				tlsCfg:      newSetting(tlsCfg),
+				tlsFiles:    newSetting(tlsreload.Files{CAFile: "cert_path", CertFile: "cert_path", KeyFile: "key_path"}),
 				headers:     newSetting(headers),
 				compression: newSetting(GzipCompression),
 				timeout:     newSetting(15 * time.Second),
@@ -217,6 +308,7 @@ func TestNewConfig(t *testing.T) {
 				endpoint:    newSetting("env.endpoint:8080"),
 				insecure:    newSetting(true),
 				tlsCfg:      newSetting(tlsCfg),
+				tlsFiles:    newSetting(tlsreload.Files{CAFile: "cert_path", CertFile: "cert_path", KeyFile: "key_path"}),
 				headers:     newSetting(headers),
 				compression: newSetting(NoCompression),
 				timeout:     newSetting(15 * time.Second),
@@ -258,6 +350,7 @@ func TestNewConfig(t *testing.T) {
 				endpoint:    newSetting("env.endpoint:8080"),
 				insecure:    newSetting(false),
 				tlsCfg:      newSetting(tlsCfg),
+				tlsFiles:    newSetting(tlsreload.Files{CAFile: "cert_path", CertFile: "cert_path", KeyFile: "key_path"}),
 				headers:     newSetting(headers),
 				compression: newSetting(GzipCompression),
 				timeout:     newSetting(15 * time.Second),
@@ -297,6 +390,7 @@ func TestNewConfig(t *testing.T) {
 				endpoint:        newSetting("test"),
 				insecure:        newSetting(true),
 				tlsCfg:          newSetting(tlsCfg),
+				tlsFiles:        newSetting(tlsreload.Files{}),
 				headers:         newSetting(headers),
 				compression:     newSetting(GzipCompression),
 				timeout:         newSetting(time.Second),
@@ -317,6 +411,7 @@ func TestNewConfig(t *testing.T) {
 			},
 			want: config{
 				endpoint: newSetting(defaultEndpoint),
+				tlsFiles: newSetting(tlsreload.Files{CAFile: "invalid_cert", CertFile: "invalid_cert", KeyFile: "invalid_key"}),
 				timeout:  newSetting(defaultTimeout),
 				retryCfg: newSetting(defaultRetryCfg),
 			},
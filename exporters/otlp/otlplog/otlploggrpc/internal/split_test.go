@@ -0,0 +1,114 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/otlplog/split_test.go.tmpl
+
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+var (
+	res   = &resourcepb.Resource{DroppedAttributesCount: 1}
+	scope = &commonpb.InstrumentationScope{Name: "scope"}
+)
+
+func records(severities ...string) []*logpb.LogRecord {
+	out := make([]*logpb.LogRecord, len(severities))
+	for i, s := range severities {
+		out[i] = &logpb.LogRecord{SeverityText: s}
+	}
+	return out
+}
+
+func scopeLogs(s []*logpb.LogRecord) *logpb.ScopeLogs {
+	return &logpb.ScopeLogs{Scope: scope, LogRecords: s, SchemaUrl: "s"}
+}
+
+func resourceLogs(ss ...*logpb.ScopeLogs) []*logpb.ResourceLogs {
+	rl := &logpb.ResourceLogs{Resource: res, ScopeLogs: ss, SchemaUrl: "r"}
+	return []*logpb.ResourceLogs{rl}
+}
+
+func TestSplitResourceLogs(t *testing.T) {
+	r0 := &logpb.ResourceLogs{SchemaUrl: "0"}
+	r1 := &logpb.ResourceLogs{SchemaUrl: "1"}
+	r2 := &logpb.ResourceLogs{SchemaUrl: "2"}
+	s0 := scopeLogs(records("a"))
+	s1 := scopeLogs(records("b", "c"))
+	s2 := scopeLogs(records("d", "e", "f"))
+
+	testCases := []struct {
+		name string
+		rl   []*logpb.ResourceLogs
+		a, b []*logpb.ResourceLogs
+		ok   bool
+	}{
+		{
+			name: "Empty",
+		},
+		{
+			name: "SingleLogRecord",
+			rl:   resourceLogs(s0),
+		},
+		{
+			name: "NoScopes",
+			rl:   []*logpb.ResourceLogs{r0},
+		},
+		{
+			name: "Resources",
+			rl:   []*logpb.ResourceLogs{r0, r1, r2},
+			a:    []*logpb.ResourceLogs{r0},
+			b:    []*logpb.ResourceLogs{r1, r2},
+			ok:   true,
+		},
+		{
+			name: "Scopes",
+			rl:   resourceLogs(s0, s1),
+			a:    resourceLogs(s0),
+			b:    resourceLogs(s1),
+			ok:   true,
+		},
+		{
+			name: "LogRecords",
+			rl:   resourceLogs(s2),
+			a:    resourceLogs(scopeLogs(s2.LogRecords[:1])),
+			b:    resourceLogs(scopeLogs(s2.LogRecords[1:])),
+			ok:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, b, ok := SplitResourceLogs(tc.rl)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.a, a)
+			assert.Equal(t, tc.b, b)
+		})
+	}
+}
+
+func TestSplitResourceLogsDoesNotAlias(t *testing.T) {
+	r0 := &logpb.ResourceLogs{SchemaUrl: "0"}
+	r1 := &logpb.ResourceLogs{SchemaUrl: "1"}
+	rl := []*logpb.ResourceLogs{r0, r1}
+	a, _, ok := SplitResourceLogs(rl)
+	assert.True(t, ok)
+
+	// Appending to the first half must not overwrite the second one.
+	_ = append(a, &logpb.ResourceLogs{SchemaUrl: "new"})
+	assert.Same(t, r1, rl[1])
+}
+
+func TestRequestSizeError(t *testing.T) {
+	err := RequestSizeError(10, 5)
+	assert.EqualError(t, err, "request size of 10 bytes exceeds the maximum request size of 5 bytes")
+}
@@ -20,6 +20,7 @@ import (
 	api "go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/log"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
 // ResourceLogs returns an slice of OTLP ResourceLogs generated from records.
@@ -120,10 +121,24 @@ func LogRecord(record log.Record) *lpb.LogRecord {
 		Flags:                uint32(record.TraceFlags()),
 		// TODO: DroppedAttributesCount: /* ... */,
 	}
+	eventName := record.EventName()
 	record.WalkAttributes(func(kv api.KeyValue) bool {
+		if kv.Key == string(semconv.EventNameKey) {
+			// An explicitly set attribute takes precedence.
+			eventName = ""
+		}
 		r.Attributes = append(r.Attributes, LogAttr(kv))
 		return true
 	})
+	if eventName != "" {
+		// The OTLP LogRecord has no event name field in the supported version
+		// of the protocol. The name is transmitted as the "event.name"
+		// attribute defined by the semantic conventions.
+		r.Attributes = append(r.Attributes, &cpb.KeyValue{
+			Key:   string(semconv.EventNameKey),
+			Value: &cpb.AnyValue{Value: &cpb.AnyValue_StringValue{StringValue: eventName}},
+		})
+	}
 	if tID := record.TraceID(); tID.IsValid() {
 		r.TraceId = tID[:]
 	}
@@ -226,6 +226,30 @@ func TestResourceLogs(t *testing.T) {
 	assert.Equal(t, want, ResourceLogs(records))
 }
 
+func TestLogRecordEventName(t *testing.T) {
+	pbEventName := &cpb.KeyValue{Key: "event.name", Value: &cpb.AnyValue{
+		Value: &cpb.AnyValue_StringValue{StringValue: "checkout.completed"},
+	}}
+
+	r := logtest.RecordFactory{
+		EventName:  "checkout.completed",
+		Attributes: []api.KeyValue{alice},
+	}.NewRecord()
+	got := LogRecord(r)
+	assert.Equal(t, []*cpb.KeyValue{pbAlice, pbEventName}, got.Attributes)
+
+	// An explicitly set attribute is not overwritten.
+	r = logtest.RecordFactory{
+		EventName:  "checkout.completed",
+		Attributes: []api.KeyValue{api.String("event.name", "other")},
+	}.NewRecord()
+	pbOther := &cpb.KeyValue{Key: "event.name", Value: &cpb.AnyValue{
+		Value: &cpb.AnyValue_StringValue{StringValue: "other"},
+	}}
+	got = LogRecord(r)
+	assert.Equal(t, []*cpb.KeyValue{pbOther}, got.Attributes)
+}
+
 func TestSeverityNumber(t *testing.T) {
 	for i := 0; i <= int(api.SeverityFatal4); i++ {
 		want := lpb.SeverityNumber(i)
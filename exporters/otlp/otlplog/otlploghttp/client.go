@@ -18,13 +18,23 @@ import (
 	"sync"
 	"time"
 
+	"google.golang.org/grpc/encoding"
 	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel"
 	collogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
 	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
 
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal/failover"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal/otlpjson"
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal/tlsreload"
+)
+
+const (
+	contentTypeProto = "application/x-protobuf"
+	contentTypeJSON  = "application/json"
 )
 
 type client struct {
@@ -49,12 +59,22 @@ func newHTTPClient(cfg config) (*client, error) {
 		Timeout:   cfg.timeout.Value,
 	}
 
-	if cfg.tlsCfg.Value != nil || cfg.proxy.Value != nil {
+	tlsCfg := cfg.tlsCfg.Value
+	if files := cfg.tlsFiles.Value; !files.Empty() {
+		serverName := tlsreload.Host(cfg.endpoint.Value)
+		if len(cfg.failover.Value.Endpoints) > 0 {
+			// Verify the server certificate for the endpoint connected to.
+			serverName = ""
+		}
+		tlsCfg = tlsreload.Config(tlsCfg, serverName, files)
+	}
+
+	if tlsCfg != nil || cfg.proxy.Value != nil {
 		clonedTransport := ourTransport.Clone()
 		hc.Transport = clonedTransport
 
-		if cfg.tlsCfg.Value != nil {
-			clonedTransport.TLSClientConfig = cfg.tlsCfg.Value
+		if tlsCfg != nil {
+			clonedTransport.TLSClientConfig = tlsCfg
 		}
 		if cfg.proxy.Value != nil {
 			clonedTransport.Proxy = cfg.proxy.Value
@@ -83,23 +103,37 @@ func newHTTPClient(cfg config) (*client, error) {
 			req.Header.Set(k, v)
 		}
 	}
-	req.Header.Set("Content-Type", "application/x-protobuf")
+	contentType := contentTypeProto
+	if cfg.encoding.Value == JSONEncoding {
+		contentType = contentTypeJSON
+	}
+	req.Header.Set("Content-Type", contentType)
 
 	c := &httpClient{
-		compression: cfg.compression.Value,
-		req:         req,
-		requestFunc: cfg.retryCfg.Value.RequestFunc(evaluate),
-		client:      hc,
+		compressor:     cfg.compressorName(),
+		encoding:       cfg.encoding.Value,
+		headerFn:       cfg.headerProvider.Value,
+		req:            req,
+		requestFunc:    cfg.retryCfg.Value.RequestFunc(evaluate),
+		client:         hc,
+		maxRequestSize: cfg.maxRequestSize.Value,
+		failover:       failover.NewSet(cfg.failover.Value),
+		resultHandler:  cfg.resultHandler.Value,
 	}
 	return &client{uploadLogs: c.uploadLogs}, nil
 }
 
 type httpClient struct {
 	// req is cloned for every upload the client makes.
-	req         *http.Request
-	compression Compression
-	requestFunc retry.RequestFunc
-	client      *http.Client
+	req            *http.Request
+	compressor     string
+	encoding       Encoding
+	headerFn       HeaderProvider
+	requestFunc    retry.RequestFunc
+	client         *http.Client
+	maxRequestSize int
+	failover       *failover.Set
+	resultHandler  ExportResultHandler
 }
 
 // Keep it in sync with golang's DefaultTransport from net/http! We
@@ -119,21 +153,40 @@ var ourTransport = &http.Transport{
 	ExpectContinueTimeout: 1 * time.Second,
 }
 
+// uploadLogs sends data to the endpoint and reports the result to the
+// configured result handler, if any.
 func (c *httpClient) uploadLogs(ctx context.Context, data []*logpb.ResourceLogs) error {
 	// The Exporter synchronizes access to client methods. This is not called
 	// after the Exporter is shutdown. Only thing to do here is send data.
 
+	res := new(internal.ExportResult)
+	err := c.upload(ctx, data, res)
+	if c.resultHandler != nil {
+		res.Err = err
+		c.resultHandler(newExportResult(*res))
+	}
+	return err
+}
+
+// upload sends data in a single request. If the request exceeds the maximum
+// request size or is rejected by the server as too large, data is split and
+// sent in multiple requests. The outcome of the requests is recorded in res.
+func (c *httpClient) upload(ctx context.Context, data []*logpb.ResourceLogs, res *internal.ExportResult) error {
 	pbRequest := &collogpb.ExportLogsServiceRequest{ResourceLogs: data}
-	body, err := proto.Marshal(pbRequest)
+	body, err := c.marshal(pbRequest)
 	if err != nil {
 		return err
 	}
+	if limit := c.maxRequestSize; limit > 0 && len(body) > limit {
+		return c.uploadSplit(ctx, data, res, internal.RequestSizeError(len(body), limit))
+	}
 	request, err := c.newRequest(ctx, body)
 	if err != nil {
 		return err
 	}
+	header := request.Header
 
-	return c.requestFunc(ctx, func(iCtx context.Context) error {
+	send := func(iCtx context.Context) error {
 		select {
 		case <-iCtx.Done():
 			return iCtx.Err()
@@ -141,6 +194,16 @@ func (c *httpClient) uploadLogs(ctx context.Context, data []*logpb.ResourceLogs)
 		}
 
 		request.reset(iCtx)
+		if c.headerFn != nil {
+			// Do not pass headers provided for a previous attempt.
+			request.Header = header
+			h, err := c.providedHeader(request, header)
+			if err != nil {
+				return err
+			}
+			request.Header = h
+		}
+		res.Attempt()
 		resp, err := c.client.Do(request.Request)
 		var urlErr *url.Error
 		if errors.As(err, &urlErr) && urlErr.Temporary() {
@@ -164,9 +227,16 @@ func (c *httpClient) uploadLogs(ctx context.Context, data []*logpb.ResourceLogs)
 				return nil
 			}
 
-			if resp.Header.Get("Content-Type") == "application/x-protobuf" {
+			var unmarshal func([]byte, proto.Message) error
+			switch resp.Header.Get("Content-Type") {
+			case contentTypeProto:
+				unmarshal = proto.Unmarshal
+			case contentTypeJSON:
+				unmarshal = otlpjson.Unmarshal
+			}
+			if unmarshal != nil {
 				var respProto collogpb.ExportLogsServiceResponse
-				if err := proto.Unmarshal(respData.Bytes(), &respProto); err != nil {
+				if err := unmarshal(respData.Bytes(), &respProto); err != nil {
 					return err
 				}
 
@@ -174,6 +244,7 @@ func (c *httpClient) uploadLogs(ctx context.Context, data []*logpb.ResourceLogs)
 					msg := respProto.PartialSuccess.GetErrorMessage()
 					n := respProto.PartialSuccess.GetRejectedLogRecords()
 					if n != 0 || msg != "" {
+						res.PartialSuccess(n, msg)
 						err := fmt.Errorf("OTLP partial success: %s (%d log records rejected)", msg, n)
 						otel.Handle(err)
 					}
@@ -204,15 +275,43 @@ func (c *httpClient) uploadLogs(ctx context.Context, data []*logpb.ResourceLogs)
 				e := errors.New(respStr)
 				rErr = newResponseError(resp.Header, e)
 			}
+		case sc == http.StatusRequestEntityTooLarge:
+			// The request is split and sent again by upload.
+			rErr = tooLargeError{err: fmt.Errorf("failed to send logs to %s: %s", request.URL, resp.Status)}
 		default:
-			rErr = fmt.Errorf("failed to send logs to %s: %s", request.URL, resp.Status)
+			rErr = statusError{err: fmt.Errorf("failed to send logs to %s: %s", request.URL, resp.Status)}
 		}
 
 		if err := resp.Body.Close(); err != nil {
 			return err
 		}
 		return rErr
+	}
+	err = c.requestFunc(ctx, func(iCtx context.Context) error {
+		if c.failover == nil {
+			return send(iCtx)
+		}
+		return c.failover.Do(iCtx, func(endpoint string) error {
+			u := *request.URL
+			u.Host = endpoint
+			request.URL, request.Host = &u, endpoint
+			return send(iCtx)
+		}, shouldFailover)
 	})
+	if errors.As(err, new(tooLargeError)) {
+		return c.uploadSplit(ctx, data, res, err)
+	}
+	return err
+}
+
+// uploadSplit splits data in two and uploads both halves. If data cannot be
+// split, err is returned.
+func (c *httpClient) uploadSplit(ctx context.Context, data []*logpb.ResourceLogs, res *internal.ExportResult, err error) error {
+	a, b, ok := internal.SplitResourceLogs(data)
+	if !ok {
+		return err
+	}
+	return errors.Join(c.upload(ctx, a, res), c.upload(ctx, b, res))
 }
 
 var gzPool = sync.Pool{
@@ -222,15 +321,38 @@ var gzPool = sync.Pool{
 	},
 }
 
+// providedHeader returns a copy of header updated with the headers returned
+// by the configured header provider for r.
+func (c *httpClient) providedHeader(r request, header http.Header) (http.Header, error) {
+	headers, err := c.headerFn(r.Request, r.body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get headers: %w", err)
+	}
+	h := header.Clone()
+	for k, v := range headers {
+		h.Set(k, v)
+	}
+	return h, nil
+}
+
+// marshal returns the encoding of m with the configured encoding.
+func (c *httpClient) marshal(m proto.Message) ([]byte, error) {
+	if c.encoding == JSONEncoding {
+		return otlpjson.Marshal(m)
+	}
+	return proto.Marshal(m)
+}
+
 func (c *httpClient) newRequest(ctx context.Context, body []byte) (request, error) {
 	r := c.req.Clone(ctx)
 	req := request{Request: r}
 
-	switch c.compression {
-	case NoCompression:
+	switch c.compressor {
+	case "":
 		r.ContentLength = (int64)(len(body))
+		req.body = body
 		req.bodyReader = bodyReader(body)
-	case GzipCompression:
+	case "gzip":
 		// Ensure the content length is not used.
 		r.ContentLength = -1
 		r.Header.Set("Content-Encoding", "gzip")
@@ -249,12 +371,47 @@ func (c *httpClient) newRequest(ctx context.Context, body []byte) (request, erro
 			return req, err
 		}
 
-		req.bodyReader = bodyReader(b.Bytes())
+		req.body = b.Bytes()
+		req.bodyReader = bodyReader(req.body)
+	default:
+		b, err := compress(c.compressor, body)
+		if err != nil {
+			return req, err
+		}
+
+		// Ensure the content length is not used.
+		r.ContentLength = -1
+		r.Header.Set("Content-Encoding", c.compressor)
+		req.body = b
+		req.bodyReader = bodyReader(b)
 	}
 
 	return req, nil
 }
 
+// compress returns body compressed with the compressor registered with
+// google.golang.org/grpc/encoding under name.
+func compress(name string, body []byte) ([]byte, error) {
+	comp := encoding.GetCompressor(name)
+	if comp == nil {
+		return nil, fmt.Errorf("compressor not registered: %s", name)
+	}
+
+	var b bytes.Buffer
+	w, err := comp.Compress(&b)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(body); err != nil {
+		return nil, err
+	}
+	// Close needs to be called to ensure body is fully written.
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
 // bodyReader returns a closure returning a new reader for buf.
 func bodyReader(buf []byte) func() io.ReadCloser {
 	return func() io.ReadCloser {
@@ -266,6 +423,8 @@ func bodyReader(buf []byte) func() io.ReadCloser {
 type request struct {
 	*http.Request
 
+	// body is the content of the request Body.
+	body []byte
 	// bodyReader allows the same body to be used for multiple requests.
 	bodyReader func() io.ReadCloser
 }
@@ -323,6 +482,45 @@ func (e retryableError) As(target interface{}) bool {
 	}
 }
 
+// statusError represents a request rejected by the server with a status
+// code that is not retryable.
+type statusError struct {
+	err error
+}
+
+func (e statusError) Error() string {
+	return e.err.Error()
+}
+
+func (e statusError) Unwrap() error {
+	return e.err
+}
+
+// shouldFailover returns if err identifies a request that should be sent to
+// the next failover endpoint: the endpoint could not be connected to or it
+// rejected the request with a status code that is not retryable.
+func shouldFailover(err error) bool {
+	if errors.As(err, new(statusError)) {
+		return true
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// tooLargeError represents a request rejected by the server because it is
+// too large.
+type tooLargeError struct {
+	err error
+}
+
+func (e tooLargeError) Error() string {
+	return e.err.Error()
+}
+
+func (e tooLargeError) Unwrap() error {
+	return e.err
+}
+
 // evaluate returns if err is retry-able. If it is and it includes an explicit
 // throttling delay, that delay is also returned.
 func evaluate(err error) (bool, time.Duration) {
@@ -5,6 +5,7 @@ package otlploghttp
 
 import (
 	"bytes"
+	"compress/flate"
 	"compress/gzip"
 	"context"
 	"crypto/ecdsa"
@@ -29,6 +30,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
 	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel"
@@ -37,6 +39,8 @@ import (
 	lpb "go.opentelemetry.io/proto/otlp/logs/v1"
 	rpb "go.opentelemetry.io/proto/otlp/resource/v1"
 
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal/otlpjson"
+	api "go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/sdk/log"
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
@@ -182,15 +186,6 @@ func (s *storage) Dump() []*lpb.ResourceLogs {
 	return data
 }
 
-var emptyExportLogsServiceResponse = func() []byte {
-	body := collogpb.ExportLogsServiceResponse{}
-	r, err := proto.Marshal(&body)
-	if err != nil {
-		panic(err)
-	}
-	return r
-}()
-
 type httpResponseError struct {
 	Err    error
 	Status int
@@ -310,12 +305,18 @@ func (c *httpCollector) Headers() map[string][]string {
 }
 
 func (c *httpCollector) handler(w http.ResponseWriter, r *http.Request) {
-	c.respond(w, c.record(r))
+	// Respond with the encoding of the request.
+	c.respond(w, r.Header.Get("Content-Type"), c.record(r))
 }
 
 func (c *httpCollector) record(r *http.Request) exportResult {
-	// Currently only supports protobuf.
-	if v := r.Header.Get("Content-Type"); v != "application/x-protobuf" {
+	var unmarshal func([]byte, proto.Message) error
+	switch v := r.Header.Get("Content-Type"); v {
+	case "application/x-protobuf":
+		unmarshal = proto.Unmarshal
+	case "application/json":
+		unmarshal = otlpjson.Unmarshal
+	default:
 		err := fmt.Errorf("content-type not supported: %s", v)
 		return exportResult{Err: err}
 	}
@@ -325,7 +326,7 @@ func (c *httpCollector) record(r *http.Request) exportResult {
 		return exportResult{Err: err}
 	}
 	pbRequest := &collogpb.ExportLogsServiceRequest{}
-	err = proto.Unmarshal(body, pbRequest)
+	err = unmarshal(body, pbRequest)
 	if err != nil {
 		return exportResult{
 			Err: &httpResponseError{
@@ -352,7 +353,7 @@ func (c *httpCollector) record(r *http.Request) exportResult {
 
 func (c *httpCollector) readBody(r *http.Request) (body []byte, err error) {
 	var reader io.ReadCloser
-	switch r.Header.Get("Content-Encoding") {
+	switch name := r.Header.Get("Content-Encoding"); name {
 	case "gzip":
 		reader, err = gzip.NewReader(r.Body)
 		if err != nil {
@@ -362,8 +363,28 @@ func (c *httpCollector) readBody(r *http.Request) (body []byte, err error) {
 				Status: http.StatusInternalServerError,
 			}
 		}
-	default:
+	case "":
 		reader = r.Body
+	default:
+		comp := encoding.GetCompressor(name)
+		if comp == nil {
+			return nil, &httpResponseError{
+				Err:    fmt.Errorf("unsupported content-encoding: %s", name),
+				Status: http.StatusUnsupportedMediaType,
+			}
+		}
+		var dr io.Reader
+		dr, err = comp.Decompress(r.Body)
+		if err != nil {
+			return nil, &httpResponseError{
+				Err:    err,
+				Status: http.StatusInternalServerError,
+			}
+		}
+		reader = struct {
+			io.Reader
+			io.Closer
+		}{dr, r.Body}
 	}
 
 	defer func() {
@@ -385,7 +406,7 @@ func (c *httpCollector) readBody(r *http.Request) (body []byte, err error) {
 	return body, err
 }
 
-func (c *httpCollector) respond(w http.ResponseWriter, resp exportResult) {
+func (c *httpCollector) respond(w http.ResponseWriter, contentType string, resp exportResult) {
 	if resp.Err != nil {
 		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
 		w.Header().Set("X-Content-Type-Options", "nosniff")
@@ -412,17 +433,22 @@ func (c *httpCollector) respond(w http.ResponseWriter, resp exportResult) {
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/x-protobuf")
+	marshal := proto.Marshal
+	if contentType == "application/json" {
+		marshal = otlpjson.Marshal
+	} else {
+		contentType = "application/x-protobuf"
+	}
+	w.Header().Set("Content-Type", contentType)
 	w.WriteHeader(http.StatusOK)
 	if resp.Response == nil {
-		_, _ = w.Write(emptyExportLogsServiceResponse)
-	} else {
-		r, err := proto.Marshal(resp.Response)
-		if err != nil {
-			panic(err)
-		}
-		_, _ = w.Write(r)
+		resp.Response = &collogpb.ExportLogsServiceResponse{}
+	}
+	r, err := marshal(resp.Response)
+	if err != nil {
+		panic(err)
 	}
+	_, _ = w.Write(r)
 }
 
 // Based on https://golang.org/src/crypto/tls/generate_cert.go,
@@ -471,13 +497,35 @@ func newWeakCertificate() (tls.Certificate, error) {
 	return tls.X509KeyPair(certBuf.Bytes(), privBuf.Bytes())
 }
 
+// testCompressorName is the name of the compressor registered to test custom
+// compression.
+const testCompressorName = "otlp-test-deflate"
+
+func init() {
+	encoding.RegisterCompressor(deflateCompressor{})
+}
+
+// deflateCompressor is a custom compressor using the DEFLATE format.
+type deflateCompressor struct{}
+
+func (deflateCompressor) Name() string { return testCompressorName }
+
+func (deflateCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return flate.NewWriter(w, flate.DefaultCompression)
+}
+
+func (deflateCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	return flate.NewReader(r), nil
+}
+
 func TestClient(t *testing.T) {
-	factory := func(rCh <-chan exportResult) (*client, *httpCollector) {
+	factory := func(rCh <-chan exportResult, o ...Option) (*client, *httpCollector) {
 		coll, err := newHTTPCollector("", rCh)
 		require.NoError(t, err)
 
 		addr := coll.Addr().String()
 		opts := []Option{WithEndpoint(addr), WithInsecure()}
+		opts = append(opts, o...)
 		cfg := newConfig(opts)
 		client, err := newHTTPClient(cfg)
 		require.NoError(t, err)
@@ -519,6 +567,43 @@ func TestClient(t *testing.T) {
 		}
 	})
 
+	t.Run("JSONEncoding", func(t *testing.T) {
+		const n, msg = 2, "bad data"
+		rCh := make(chan exportResult, 1)
+		rCh <- exportResult{
+			Response: &collogpb.ExportLogsServiceResponse{
+				PartialSuccess: &collogpb.ExportLogsPartialSuccess{
+					RejectedLogRecords: n,
+					ErrorMessage:       msg,
+				},
+			},
+		}
+
+		ctx := context.Background()
+		client, coll := factory(rCh, WithEncoding(JSONEncoding))
+
+		defer func(orig otel.ErrorHandler) {
+			otel.SetErrorHandler(orig)
+		}(otel.GetErrorHandler())
+
+		errs := []error{}
+		eh := otel.ErrorHandlerFunc(func(e error) { errs = append(errs, e) })
+		otel.SetErrorHandler(eh)
+
+		require.NoError(t, client.UploadLogs(ctx, resourceLogs))
+		assert.Equal(t, []string{"application/json"}, coll.Headers()["Content-Type"])
+		got := coll.Collect().Dump()
+		require.Len(t, got, 1, "upload of one ResourceLogs")
+		diff := cmp.Diff(got[0], resourceLogs[0], cmp.Comparer(proto.Equal))
+		if diff != "" {
+			t.Fatalf("unexpected ResourceLogs:\n%s", diff)
+		}
+
+		require.Equal(t, 1, len(errs))
+		want := fmt.Sprintf("%s (%d log records rejected)", msg, n)
+		assert.ErrorContains(t, errs[0], want)
+	})
+
 	t.Run("PartialSuccess", func(t *testing.T) {
 		const n, msg = 2, "bad data"
 		rCh := make(chan exportResult, 3)
@@ -562,6 +647,55 @@ func TestClient(t *testing.T) {
 		want := fmt.Sprintf("%s (%d log records rejected)", msg, n)
 		assert.ErrorContains(t, errs[0], want)
 	})
+
+	t.Run("ExportResultHandler", func(t *testing.T) {
+		rCh := make(chan exportResult, 3)
+		rCh <- exportResult{Err: &httpResponseError{
+			Status: http.StatusServiceUnavailable,
+			Err:    errors.New("unavailable"),
+		}}
+		rCh <- exportResult{
+			Response: &collogpb.ExportLogsServiceResponse{
+				PartialSuccess: &collogpb.ExportLogsPartialSuccess{
+					RejectedLogRecords: 2,
+					ErrorMessage:       "bad data",
+				},
+			},
+		}
+		rCh <- exportResult{Err: &httpResponseError{
+			Status: http.StatusBadRequest,
+			Err:    errors.New("invalid"),
+		}}
+
+		var results []ExportResult
+		ctx := context.Background()
+		client, _ := factory(rCh,
+			WithRetry(RetryConfig{
+				Enabled:         true,
+				InitialInterval: time.Nanosecond,
+				MaxInterval:     time.Millisecond,
+				MaxElapsedTime:  time.Minute,
+			}),
+			WithExportResultHandler(func(r ExportResult) {
+				results = append(results, r)
+			}),
+		)
+
+		require.NoError(t, client.UploadLogs(ctx, resourceLogs))
+		require.Len(t, results, 1)
+		assert.Equal(t, ExportResult{
+			Disposition:      ExportPartiallyAccepted,
+			Attempts:         2,
+			Rejected:         2,
+			RejectedMessages: []string{"bad data"},
+		}, results[0])
+
+		require.Error(t, client.UploadLogs(ctx, resourceLogs))
+		require.Len(t, results, 2)
+		assert.Equal(t, ExportFailed, results[1].Disposition)
+		assert.Equal(t, 1, results[1].Attempts)
+		assert.ErrorContains(t, results[1].Err, "400 Bad Request")
+	})
 }
 
 func TestClientWithHTTPCollectorRespondingPlainText(t *testing.T) {
@@ -635,6 +769,59 @@ func TestConfig(t *testing.T) {
 		assert.Equal(t, got[key], []string{headers[key]})
 	})
 
+	t.Run("WithHeaderProvider", func(t *testing.T) {
+		key := http.CanonicalHeaderKey("authorization")
+		rCh := make(chan exportResult, 2)
+		rCh <- exportResult{Err: &httpResponseError{
+			Status: http.StatusServiceUnavailable,
+			Err:    errors.New(""),
+		}}
+		rCh <- exportResult{}
+
+		var calls int
+		provider := func(r *http.Request, body []byte) (map[string]string, error) {
+			calls++
+			assert.Empty(t, r.Header.Get(key), "provided headers passed to provider")
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/v1/logs", r.URL.Path)
+			assert.NotEmpty(t, body, "request body not passed to provider")
+			return map[string]string{key: fmt.Sprintf("Bearer token-%d", calls)}, nil
+		}
+		exp, coll := factoryFunc(
+			"",
+			rCh,
+			WithHeaderProvider(provider),
+			WithRetry(RetryConfig{
+				Enabled:         true,
+				InitialInterval: time.Nanosecond,
+				MaxInterval:     time.Millisecond,
+				MaxElapsedTime:  time.Minute,
+			}),
+		)
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		// Push this after Shutdown so the HTTP server doesn't hang.
+		t.Cleanup(func() { close(rCh) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		require.NoError(t, exp.Export(ctx, make([]log.Record, 1)))
+
+		assert.Equal(t, 2, calls, "header provider not called for each attempt")
+		assert.Equal(t, []string{"Bearer token-1", "Bearer token-2"}, coll.Headers()[key])
+	})
+
+	t.Run("WithHeaderProviderError", func(t *testing.T) {
+		providerErr := errors.New("provider error")
+		provider := func(*http.Request, []byte) (map[string]string, error) {
+			return nil, providerErr
+		}
+		exp, coll := factoryFunc("", nil, WithHeaderProvider(provider))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		assert.ErrorIs(t, exp.Export(ctx, make([]log.Record, 1)), providerErr)
+		assert.Empty(t, coll.Collect().Dump())
+	})
+
 	t.Run("WithTimeout", func(t *testing.T) {
 		// Do not send on rCh so the Collector never responds to the client.
 		rCh := make(chan exportResult)
@@ -662,6 +849,25 @@ func TestConfig(t *testing.T) {
 		assert.Len(t, coll.Collect().Dump(), 1)
 	})
 
+	t.Run("WithCompressor", func(t *testing.T) {
+		exp, coll := factoryFunc("", nil, WithCompressor(testCompressorName))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		assert.NoError(t, exp.Export(ctx, make([]log.Record, 1)))
+		assert.Len(t, coll.Collect().Dump(), 1)
+	})
+
+	t.Run("WithUnregisteredCompressor", func(t *testing.T) {
+		exp, coll := factoryFunc("", nil, WithCompressor("unregistered"))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		// Payloads are sent uncompressed.
+		assert.NoError(t, exp.Export(ctx, make([]log.Record, 1)))
+		assert.Len(t, coll.Collect().Dump(), 1)
+	})
+
 	t.Run("WithRetry", func(t *testing.T) {
 		emptyErr := errors.New("")
 		rCh := make(chan exportResult, 5)
@@ -724,6 +930,122 @@ func TestConfig(t *testing.T) {
 		assert.ErrorIs(t, err, *retryErr)
 	})
 
+	records := func(bodies ...string) []log.Record {
+		out := make([]log.Record, len(bodies))
+		for i, b := range bodies {
+			out[i].SetBody(api.StringValue(b))
+		}
+		return out
+	}
+
+	t.Run("SplitRequestTooLarge", func(t *testing.T) {
+		rCh := make(chan exportResult, 3)
+		rCh <- exportResult{Err: &httpResponseError{
+			Status: http.StatusRequestEntityTooLarge,
+			Err:    errors.New("request too large"),
+		}}
+		rCh <- exportResult{}
+		rCh <- exportResult{}
+		exp, coll := factoryFunc("", rCh)
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		require.NoError(t, exp.Export(ctx, records("0", "1", "2")))
+
+		got := coll.Collect().Dump()
+		require.Len(t, got, 3, "rejected request and its two halves")
+		assert.Len(t, got[1].ScopeLogs[0].LogRecords, 1)
+		assert.Len(t, got[2].ScopeLogs[0].LogRecords, 2)
+	})
+
+	t.Run("WithMaxRequestSize", func(t *testing.T) {
+		exp, coll := factoryFunc("", nil, WithMaxRequestSize(512))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+		err := exp.Export(ctx, records(strings.Repeat("x", 1024), "1", "2"))
+		assert.ErrorContains(t, err, "exceeds the maximum request size of 512 bytes")
+
+		got := coll.Collect().Dump()
+		require.Len(t, got, 1, "log records within the maximum request size not batched")
+		lr := got[0].ScopeLogs[0].LogRecords
+		require.Len(t, lr, 2)
+		assert.Equal(t, "1", lr[0].Body.GetStringValue())
+		assert.Equal(t, "2", lr[1].Body.GetStringValue())
+	})
+
+	t.Run("WithFailover", func(t *testing.T) {
+		ctx := context.Background()
+		primary, err := newHTTPCollector("", nil)
+		require.NoError(t, err)
+		backup, err := newHTTPCollector("", nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, backup.Shutdown(ctx)) })
+
+		exp, err := New(ctx, WithInsecure(), WithFailover(FailoverConfig{
+			Endpoints: []FailoverEndpoint{
+				{Endpoint: primary.Addr().String()},
+				{Endpoint: backup.Addr().String()},
+			},
+			InitialBackoff: 10 * time.Millisecond,
+			MaxBackoff:     10 * time.Millisecond,
+		}))
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+		require.NoError(t, exp.Export(ctx, make([]log.Record, 1)))
+		assert.Len(t, primary.Collect().Dump(), 1, "primary endpoint not used")
+		assert.Empty(t, backup.Collect().Dump())
+
+		require.NoError(t, primary.Shutdown(ctx))
+		require.NoError(t, exp.Export(ctx, make([]log.Record, 1)))
+		assert.Len(t, backup.Collect().Dump(), 1, "no failover to backup endpoint")
+
+		// Restart the primary endpoint and wait for the exporter to return
+		// to it.
+		primary, err = newHTTPCollector("http://"+primary.Addr().String(), nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, primary.Shutdown(ctx)) })
+		assert.Eventually(t, func() bool {
+			require.NoError(t, exp.Export(ctx, make([]log.Record, 1)))
+			return len(primary.Collect().Dump()) > 0
+		}, 10*time.Second, 50*time.Millisecond, "no return to recovered primary endpoint")
+	})
+
+	t.Run("WithFailoverNonRetryable", func(t *testing.T) {
+		ctx := context.Background()
+		rCh := make(chan exportResult, 1)
+		rCh <- exportResult{Err: &httpResponseError{
+			Status: http.StatusBadRequest,
+			Err:    errors.New("invalid"),
+		}}
+		primary, err := newHTTPCollector("", rCh)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, primary.Shutdown(ctx)) })
+		backup, err := newHTTPCollector("", nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, backup.Shutdown(ctx)) })
+
+		exp, err := New(ctx, WithInsecure(), WithFailover(FailoverConfig{
+			Endpoints: []FailoverEndpoint{
+				{Endpoint: primary.Addr().String()},
+				{Endpoint: backup.Addr().String()},
+			},
+		}))
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+		require.NoError(t, exp.Export(ctx, make([]log.Record, 1)))
+		assert.Len(t, primary.Collect().Dump(), 1, "rejected request")
+		assert.Len(t, backup.Collect().Dump(), 1, "no failover to backup endpoint")
+
+		// The primary endpoint is unhealthy until its backoff elapses.
+		require.NoError(t, exp.Export(ctx, make([]log.Record, 1)))
+		assert.Empty(t, primary.Collect().Dump())
+		assert.Len(t, backup.Collect().Dump(), 1)
+	})
+
 	t.Run("WithURLPath", func(t *testing.T) {
 		path := "/prefix/v2/logs"
 		ePt := fmt.Sprintf("http://localhost:0%s", path)
@@ -15,8 +15,12 @@ import (
 	"strings"
 	"time"
 
+	"google.golang.org/grpc/encoding"
+
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal/failover"
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal/tlsreload"
 	"go.opentelemetry.io/otel/internal/global"
 )
 
@@ -51,6 +55,11 @@ var (
 		"OTEL_EXPORTER_OTLP_COMPRESSION",
 	}
 
+	envProtocol = []string{
+		"OTEL_EXPORTER_OTLP_LOGS_PROTOCOL",
+		"OTEL_EXPORTER_OTLP_PROTOCOL",
+	}
+
 	envTimeout = []string{
 		"OTEL_EXPORTER_OTLP_LOGS_TIMEOUT",
 		"OTEL_EXPORTER_OTLP_TIMEOUT",
@@ -85,15 +94,22 @@ type fnOpt func(config) config
 func (f fnOpt) applyHTTPOption(c config) config { return f(c) }
 
 type config struct {
-	endpoint    setting[string]
-	path        setting[string]
-	insecure    setting[bool]
-	tlsCfg      setting[*tls.Config]
-	headers     setting[map[string]string]
-	compression setting[Compression]
-	timeout     setting[time.Duration]
-	proxy       setting[HTTPTransportProxyFunc]
-	retryCfg    setting[retry.Config]
+	endpoint       setting[string]
+	path           setting[string]
+	insecure       setting[bool]
+	tlsCfg         setting[*tls.Config]
+	tlsFiles       setting[tlsreload.Files]
+	headers        setting[map[string]string]
+	headerProvider setting[HeaderProvider]
+	compression    setting[Compression]
+	compressor     setting[string]
+	encoding       setting[Encoding]
+	timeout        setting[time.Duration]
+	maxRequestSize setting[int]
+	failover       setting[failover.Config]
+	resultHandler  setting[ExportResultHandler]
+	proxy          setting[HTTPTransportProxyFunc]
+	retryCfg       setting[retry.Config]
 }
 
 func newConfig(options []Option) config {
@@ -114,6 +130,9 @@ func newConfig(options []Option) config {
 	c.insecure = c.insecure.Resolve(
 		getenv[bool](envInsecure, convInsecure),
 	)
+	c.tlsFiles = c.tlsFiles.Resolve(
+		loadEnvTLSFiles[tlsreload.Files](),
+	)
 	c.tlsCfg = c.tlsCfg.Resolve(
 		loadEnvTLS[*tls.Config](),
 	)
@@ -123,6 +142,9 @@ func newConfig(options []Option) config {
 	c.compression = c.compression.Resolve(
 		getenv[Compression](envCompression, convCompression),
 	)
+	c.encoding = c.encoding.Resolve(
+		getenv[Encoding](envProtocol, convEncoding),
+	)
 	c.timeout = c.timeout.Resolve(
 		getenv[time.Duration](envTimeout, convDuration),
 		fallback[time.Duration](defaultTimeout),
@@ -134,6 +156,8 @@ func newConfig(options []Option) config {
 		fallback[retry.Config](defaultRetryCfg),
 	)
 
+	c = c.withRegisteredCompressor()
+
 	return c
 }
 
@@ -200,6 +224,20 @@ const (
 	NoCompression Compression = iota
 	// GzipCompression represents that gzip compression should be used.
 	GzipCompression
+	// ZstdCompression represents that zstd compression should be used.
+	//
+	// A compressor named "zstd" needs to be registered with
+	// google.golang.org/grpc/encoding, e.g. by importing
+	// go.opentelemetry.io/otel/exporters/otlp/otlpcompress/zstd.
+	// Otherwise, payloads are sent uncompressed.
+	ZstdCompression
+	// SnappyCompression represents that snappy compression should be used.
+	//
+	// A compressor named "snappy" needs to be registered with
+	// google.golang.org/grpc/encoding, e.g. by importing
+	// go.opentelemetry.io/otel/exporters/otlp/otlpcompress/snappy.
+	// Otherwise, payloads are sent uncompressed.
+	SnappyCompression
 )
 
 // WithCompression sets the compression strategy the Exporter will use to
@@ -208,7 +246,7 @@ const (
 // If the OTEL_EXPORTER_OTLP_COMPRESSION or
 // OTEL_EXPORTER_OTLP_LOGS_COMPRESSION environment variable is set, and
 // this option is not passed, that variable value will be used. That value can
-// be either "none" or "gzip". If both are set,
+// be "none", "gzip", "zstd", or "snappy". If both are set,
 // OTEL_EXPORTER_OTLP_LOGS_COMPRESSION will take precedence.
 //
 // By default, if an environment variable is not set, and this option is not
@@ -216,6 +254,57 @@ const (
 func WithCompression(compression Compression) Option {
 	return fnOpt(func(c config) config {
 		c.compression = newSetting(compression)
+		c.compressor = setting[string]{}
+		return c
+	})
+}
+
+// WithCompressor sets the compressor registered with
+// google.golang.org/grpc/encoding under name as the compressor the Exporter
+// will use to compress the HTTP body. The name is used as the value of the
+// Content-Encoding header. This can be used to send payloads compressed with
+// a custom encoder.
+//
+// If no compressor is registered under name, payloads are sent uncompressed
+// and this is reported to the global error handler (see otel.Handle).
+//
+// This option overrides the compression set by the WithCompression option
+// and the OTEL_EXPORTER_OTLP_COMPRESSION or
+// OTEL_EXPORTER_OTLP_LOGS_COMPRESSION environment variables. Passing
+// WithCompression after this option overrides it in turn.
+func WithCompressor(name string) Option {
+	return fnOpt(func(c config) config {
+		c.compressor = newSetting(name)
+		return c
+	})
+}
+
+// Encoding describes the encoding used for exported payloads.
+type Encoding int
+
+const (
+	// ProtobufEncoding represents that the protobuf binary format
+	// ("application/x-protobuf") should be used.
+	ProtobufEncoding Encoding = iota
+	// JSONEncoding represents that the OTLP/JSON format ("application/json")
+	// should be used.
+	JSONEncoding
+)
+
+// WithEncoding sets the encoding the Exporter will use to encode the HTTP
+// body.
+//
+// If the OTEL_EXPORTER_OTLP_PROTOCOL or OTEL_EXPORTER_OTLP_LOGS_PROTOCOL
+// environment variable is set, and this option is not passed, that variable
+// value will be used. That value can be either "http/protobuf" or
+// "http/json". If both are set, OTEL_EXPORTER_OTLP_LOGS_PROTOCOL will take
+// precedence.
+//
+// By default, if an environment variable is not set, and this option is not
+// passed, ProtobufEncoding will be used.
+func WithEncoding(encoding Encoding) Option {
+	return fnOpt(func(c config) config {
+		c.encoding = newSetting(encoding)
 		return c
 	})
 }
@@ -250,6 +339,28 @@ func WithURLPath(urlPath string) Option {
 func WithTLSClientConfig(tlsCfg *tls.Config) Option {
 	return fnOpt(func(c config) config {
 		c.tlsCfg = newSetting(tlsCfg.Clone())
+		c.tlsFiles = newSetting(tlsreload.Files{})
+		return c
+	})
+}
+
+// WithTLSFiles sets the files the CA bundle, client certificate and client
+// key used for TLS are loaded from. The files are loaded when a connection to
+// the collector is established and are reloaded when their modification time
+// or size changes, so rotated certificates are used without restarting the
+// Exporter. If a changed file cannot be loaded, the error is passed to the
+// global ErrorHandler and the previously loaded certificates are used.
+//
+// If the OTEL_EXPORTER_OTLP_CERTIFICATE, OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE
+// and OTEL_EXPORTER_OTLP_CLIENT_KEY environment variables, or their
+// OTEL_EXPORTER_OTLP_LOGS_* counterparts, are set, and this option is not
+// passed, the files they define are used and reloaded when changed.
+//
+// The other settings of a tls.Config passed with WithTLSClientConfig are kept.
+// If WithTLSClientConfig is passed after this option, the files are not used.
+func WithTLSFiles(files TLSFiles) Option {
+	return fnOpt(func(c config) config {
+		c.tlsFiles = newSetting(tlsreload.Files(files))
 		return c
 	})
 }
@@ -290,6 +401,32 @@ func WithHeaders(headers map[string]string) Option {
 	})
 }
 
+// HeaderProvider returns headers to send with an HTTP request. req is the
+// request about to be sent, with the headers set by the exporter and with
+// WithHeaders or the OTEL_EXPORTER_OTLP_HEADERS and
+// OTEL_EXPORTER_OTLP_LOGS_HEADERS environment variables, and body is the
+// content of its Body as sent, i.e. encoded and compressed. req and body must
+// not be modified, and the Body of req must not be read.
+//
+// The returned headers are sent in addition to, and take precedence over, the
+// headers of req. If an error is returned, the request is not sent and the
+// export fails with the error.
+type HeaderProvider func(req *http.Request, body []byte) (map[string]string, error)
+
+// WithHeaderProvider sets a HeaderProvider that is called before every export
+// attempt, including retries, to get the headers sent with the HTTP request.
+// This can be used to send headers whose values change over time, e.g.
+// short-lived bearer tokens, or that are computed from the request, e.g.
+// request signatures.
+//
+// By default, no header provider is used.
+func WithHeaderProvider(provider HeaderProvider) Option {
+	return fnOpt(func(c config) config {
+		c.headerProvider = newSetting(provider)
+		return c
+	})
+}
+
 // WithTimeout sets the max amount of time an Exporter will attempt an export.
 //
 // This takes precedence over any retry settings defined by WithRetry. Once
@@ -311,10 +448,106 @@ func WithTimeout(duration time.Duration) Option {
 	})
 }
 
+// WithMaxRequestSize sets the maximum size, in bytes, of the serialized and
+// uncompressed export request body. A batch of log records that exceeds this
+// size is split into smaller requests before it is sent. If a single log record
+// exceeds this size on its own, it is dropped and an error is returned.
+//
+// Regardless of this option, a batch is split and the smaller requests are sent
+// if the target endpoint rejects the batch with a 413 (Payload Too Large)
+// status code.
+//
+// By default, the size of export requests is not limited.
+func WithMaxRequestSize(n int) Option {
+	return fnOpt(func(c config) config {
+		c.maxRequestSize = newSetting(n)
+		return c
+	})
+}
+
 // RetryConfig defines configuration for retrying the export of log data that
 // failed.
 type RetryConfig retry.Config
 
+// TLSFiles are the paths to the PEM encoded files used to configure TLS.
+//
+// CAFile is the path to the CA bundle used to verify the server certificate.
+// CertFile and KeyFile are the paths to the client certificate and its
+// private key used for mTLS. They are only used if both are set.
+type TLSFiles tlsreload.Files
+
+// FailoverConfig defines the endpoints the Exporter fails over between.
+type FailoverConfig struct {
+	// Endpoints are the endpoints in the order they are preferred.
+	Endpoints []FailoverEndpoint
+	// InitialBackoff is the time an endpoint is not used after it failed.
+	// It is doubled for every consecutive failure of the endpoint up to
+	// MaxBackoff. If zero, 5 seconds is used.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum time an endpoint is not used after it
+	// failed. If zero, 1 minute is used.
+	MaxBackoff time.Duration
+}
+
+// FailoverEndpoint is an endpoint the Exporter fails over to.
+type FailoverEndpoint struct {
+	// Endpoint is the host and port of the endpoint. It should resemble
+	// "example.com:4318".
+	Endpoint string
+	// Weight is the relative share of the batches sent to the endpoint
+	// while it is healthy. Batches are distributed between all the healthy
+	// endpoints with a non-zero weight. Endpoints with a zero weight are
+	// backups that are only used, in the order they are configured, when
+	// no endpoint with a weight is healthy.
+	Weight int
+}
+
+// WithFailover sets the endpoints the Exporter sends batches of log records to
+// instead of the endpoint set with WithEndpoint, WithEndpointURL, or
+// environment variables. A batch is sent to the first healthy endpoint. If
+// that endpoint cannot be reached, or rejects the batch with a status code
+// that is not retryable, it is marked as unhealthy and the batch is sent to
+// the next endpoint. An unhealthy endpoint is used again once its backoff has
+// elapsed, so the Exporter returns to a preferred endpoint when it recovers.
+//
+// The scheme, URL path, headers, TLS, and other settings of the Exporter are
+// used for all the endpoints.
+//
+// By default, the Exporter does not fail over.
+func WithFailover(fc FailoverConfig) Option {
+	return fnOpt(func(c config) config {
+		f := failover.Config{
+			Endpoints:      make([]failover.Endpoint, len(fc.Endpoints)),
+			InitialBackoff: fc.InitialBackoff,
+			MaxBackoff:     fc.MaxBackoff,
+		}
+		for i, e := range fc.Endpoints {
+			f.Endpoints[i] = failover.Endpoint(e)
+		}
+		c.failover = newSetting(f)
+		return c
+	})
+}
+
+// WithExportResultHandler sets a handler that is called with the result of the
+// export of every batch of log records. The result reports how many log records
+// the endpoint rejected in partial success responses and why, how many export
+// requests were sent, and whether the batch was dropped. It can be used to
+// measure data loss or to drive a circuit breaker.
+//
+// The handler is called synchronously once the export of a batch, including its
+// retries and split requests, has completed. It needs to return promptly to not
+// block the Exporter. Partial success responses are still reported to the
+// global error handler.
+//
+// By default, no handler is called.
+func WithExportResultHandler(h ExportResultHandler) Option {
+	return fnOpt(func(c config) config {
+		c.resultHandler = newSetting(h)
+		return c
+	})
+}
+
 // WithRetry sets the retry policy for transient retryable errors that are
 // returned by the target endpoint.
 //
@@ -423,6 +656,40 @@ func loadEnvTLS[T *tls.Config]() resolver[T] {
 	}
 }
 
+// loadEnvTLSFiles returns a resolver that sets the TLS files defined by the
+// OTLP TLS environment variables. These are the same files loaded by
+// loadEnvTLS, they are reloaded when they change on disk.
+func loadEnvTLSFiles[T tlsreload.Files]() resolver[T] {
+	return func(s setting[T]) setting[T] {
+		if s.Set {
+			// Passed, valid, options have precedence.
+			return s
+		}
+
+		var files tlsreload.Files
+		for _, key := range envTLSCert {
+			if v := os.Getenv(key); v != "" {
+				files.CAFile = v
+				break
+			}
+		}
+		for _, pair := range envTLSClient {
+			cert := os.Getenv(pair.Certificate)
+			key := os.Getenv(pair.Key)
+			if cert != "" && key != "" {
+				files.CertFile, files.KeyFile = cert, key
+				break
+			}
+		}
+
+		if !files.Empty() {
+			s.Set = true
+			s.Value = T(files)
+		}
+		return s
+	}
+}
+
 // readFile is used for testing.
 var readFile = os.ReadFile
 
@@ -573,12 +840,61 @@ func convCompression(s string) (Compression, error) {
 	switch s {
 	case "gzip":
 		return GzipCompression, nil
+	case "zstd":
+		return ZstdCompression, nil
+	case "snappy":
+		return SnappyCompression, nil
 	case "none", "":
 		return NoCompression, nil
 	}
 	return NoCompression, fmt.Errorf("unknown compression: %s", s)
 }
 
+// compressorName returns the name of the compressor registered with
+// google.golang.org/grpc/encoding used to compress payloads. An empty string
+// is returned if payloads are not compressed.
+func (c config) compressorName() string {
+	if c.compressor.Set {
+		return c.compressor.Value
+	}
+	switch c.compression.Value {
+	case GzipCompression:
+		return "gzip"
+	case ZstdCompression:
+		return "zstd"
+	case SnappyCompression:
+		return "snappy"
+	}
+	return ""
+}
+
+// withRegisteredCompressor returns c with compression disabled if the
+// compressor it uses is not registered with google.golang.org/grpc/encoding.
+// This is reported with otel.Handle. Gzip is supported without a registered
+// compressor.
+func (c config) withRegisteredCompressor() config {
+	name := c.compressorName()
+	if name == "" || name == "gzip" || encoding.GetCompressor(name) != nil {
+		return c
+	}
+	otel.Handle(fmt.Errorf("compressor not registered: %s, using no compression", name))
+	c.compression = newSetting(NoCompression)
+	c.compressor = setting[string]{}
+	return c
+}
+
+// convEncoding returns the encoding of the transport protocol encoded in s.
+// ProtobufEncoding and an error are returned if s is unknown.
+func convEncoding(s string) (Encoding, error) {
+	switch s {
+	case "http/protobuf":
+		return ProtobufEncoding, nil
+	case "http/json":
+		return JSONEncoding, nil
+	}
+	return ProtobufEncoding, fmt.Errorf("unknown protocol: %s", s)
+}
+
 // convDuration converts s into a duration of milliseconds. If s does not
 // contain an integer, 0 and an error are returned.
 func convDuration(s string) (time.Duration, error) {
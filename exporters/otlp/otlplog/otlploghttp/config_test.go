@@ -17,7 +17,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal/failover"
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal/tlsreload"
 )
 
 const (
@@ -106,20 +108,109 @@ func TestNewConfig(t *testing.T) {
 				WithInsecure(),
 				WithTLSClientConfig(tlsCfg),
 				WithCompression(GzipCompression),
+				WithEncoding(JSONEncoding),
 				WithHeaders(headers),
 				WithTimeout(time.Second),
+				WithMaxRequestSize(1024),
+				WithFailover(FailoverConfig{
+					Endpoints:      []FailoverEndpoint{{Endpoint: "backup:4317", Weight: 1}},
+					InitialBackoff: time.Second,
+				}),
 				WithRetry(RetryConfig(rc)),
 				// Do not test WithProxy. Requires func comparison.
 			},
 			want: config{
-				endpoint:    newSetting("test"),
-				path:        newSetting("/path"),
-				insecure:    newSetting(true),
-				tlsCfg:      newSetting(tlsCfg),
-				headers:     newSetting(headers),
+				endpoint:       newSetting("test"),
+				path:           newSetting("/path"),
+				insecure:       newSetting(true),
+				tlsCfg:         newSetting(tlsCfg),
+				tlsFiles:       newSetting(tlsreload.Files{}),
+				headers:        newSetting(headers),
+				compression:    newSetting(GzipCompression),
+				encoding:       newSetting(JSONEncoding),
+				timeout:        newSetting(time.Second),
+				maxRequestSize: newSetting(1024),
+				failover: newSetting(failover.Config{
+					Endpoints:      []failover.Endpoint{{Endpoint: "backup:4317", Weight: 1}},
+					InitialBackoff: time.Second,
+				}),
+				retryCfg: newSetting(rc),
+			},
+		},
+		{
+			name: "WithCompressor",
+			options: []Option{
+				WithCompressor(testCompressorName),
+			},
+			envars: map[string]string{
+				"OTEL_EXPORTER_OTLP_LOGS_COMPRESSION": "gzip",
+			},
+			want: config{
+				endpoint:    newSetting(defaultEndpoint),
+				path:        newSetting(defaultPath),
 				compression: newSetting(GzipCompression),
-				timeout:     newSetting(time.Second),
-				retryCfg:    newSetting(rc),
+				compressor:  newSetting(testCompressorName),
+				timeout:     newSetting(defaultTimeout),
+				retryCfg:    newSetting(defaultRetryCfg),
+			},
+		},
+		{
+			name: "WithCompressionAfterCompressor",
+			options: []Option{
+				WithCompressor(testCompressorName),
+				WithCompression(GzipCompression),
+			},
+			want: config{
+				endpoint:    newSetting(defaultEndpoint),
+				path:        newSetting(defaultPath),
+				compression: newSetting(GzipCompression),
+				timeout:     newSetting(defaultTimeout),
+				retryCfg:    newSetting(defaultRetryCfg),
+			},
+		},
+		{
+			name: "WithUnregisteredCompressor",
+			options: []Option{
+				WithCompressor("unregistered"),
+			},
+			want: config{
+				endpoint:    newSetting(defaultEndpoint),
+				path:        newSetting(defaultPath),
+				compression: newSetting(NoCompression),
+				timeout:     newSetting(defaultTimeout),
+				retryCfg:    newSetting(defaultRetryCfg),
+			},
+		},
+		{
+			name: "WithEnvUnregisteredCompression",
+			envars: map[string]string{
+				"OTEL_EXPORTER_OTLP_LOGS_COMPRESSION": "zstd",
+			},
+			want: config{
+				endpoint:    newSetting(defaultEndpoint),
+				path:        newSetting(defaultPath),
+				compression: newSetting(NoCompression),
+				timeout:     newSetting(defaultTimeout),
+				retryCfg:    newSetting(defaultRetryCfg),
+			},
+		},
+		{
+			name: "WithTLSFiles",
+			options: []Option{
+				WithTLSClientConfig(tlsCfg),
+				WithTLSFiles(TLSFiles{CAFile: "ca.pem"}),
+			},
+			envars: map[string]string{
+				"OTEL_EXPORTER_OTLP_LOGS_CLIENT_CERTIFICATE": "cert_path",
+				"OTEL_EXPORTER_OTLP_LOGS_CLIENT_KEY":         "key_path",
+			},
+			want: config{
+				endpoint: newSetting(defaultEndpoint),
+				path:     newSetting(defaultPath),
+				tlsCfg:   newSetting(tlsCfg),
+				tlsFiles: newSetting(tlsreload.Files{CAFile: "ca.pem"}),
+				timeout:  newSetting(defaultTimeout),
+				retryCfg: newSetting(defaultRetryCfg),
 			},
 		},
 		{
@@ -173,6 +264,7 @@ func TestNewConfig(t *testing.T) {
 				"OTEL_EXPORTER_OTLP_LOGS_ENDPOINT":           "https://env.endpoint:8080/prefix",
 				"OTEL_EXPORTER_OTLP_LOGS_HEADERS":            "a=A",
 				"OTEL_EXPORTER_OTLP_LOGS_COMPRESSION":        "gzip",
+				"OTEL_EXPORTER_OTLP_LOGS_PROTOCOL":           "http/json",
 				"OTEL_EXPORTER_OTLP_LOGS_TIMEOUT":            "15000",
 				"OTEL_EXPORTER_OTLP_LOGS_CERTIFICATE":        "cert_path",
 				"OTEL_EXPORTER_OTLP_LOGS_CLIENT_CERTIFICATE": "cert_path",
@@ -183,8 +275,10 @@ func TestNewConfig(t *testing.T) {
 				path:        newSetting("/prefix"),
 				insecure:    newSetting(false),
 				tlsCfg:      newSetting(tlsCfg),
+				tlsFiles:    newSetting(tlsreload.Files{CAFile: "cert_path", CertFile: "cert_path", KeyFile: "key_path"}),
 				headers:     newSetting(headers),
 				compression: newSetting(GzipCompression),
+				encoding:    newSetting(JSONEncoding),
 				timeout:     newSetting(15 * time.Second),
 				retryCfg:    newSetting(defaultRetryCfg),
 			},
@@ -208,6 +302,7 @@ func TestNewConfig(t *testing.T) {
 				"OTEL_EXPORTER_OTLP_ENDPOINT":           "http://env.endpoint:8080/prefix",
 				"OTEL_EXPORTER_OTLP_HEADERS":            "a=A",
 				"OTEL_EXPORTER_OTLP_COMPRESSION":        "none",
+				"OTEL_EXPORTER_OTLP_PROTOCOL":           "http/json",
 				"OTEL_EXPORTER_OTLP_TIMEOUT":            "15000",
 				"OTEL_EXPORTER_OTLP_CERTIFICATE":        "cert_path",
 				"OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE": "cert_path",
@@ -218,8 +313,10 @@ func TestNewConfig(t *testing.T) {
 				path:        newSetting("/prefix/v1/logs"),
 				insecure:    newSetting(true),
 				tlsCfg:      newSetting(tlsCfg),
+				tlsFiles:    newSetting(tlsreload.Files{CAFile: "cert_path", CertFile: "cert_path", KeyFile: "key_path"}),
 				headers:     newSetting(headers),
 				compression: newSetting(NoCompression),
+				encoding:    newSetting(JSONEncoding),
 				timeout:     newSetting(15 * time.Second),
 				retryCfg:    newSetting(defaultRetryCfg),
 			},
@@ -243,6 +340,7 @@ func TestNewConfig(t *testing.T) {
 				"OTEL_EXPORTER_OTLP_ENDPOINT":           "http://ignored:9090/alt",
 				"OTEL_EXPORTER_OTLP_HEADERS":            "b=B",
 				"OTEL_EXPORTER_OTLP_COMPRESSION":        "none",
+				"OTEL_EXPORTER_OTLP_PROTOCOL":           "http/json",
 				"OTEL_EXPORTER_OTLP_TIMEOUT":            "30000",
 				"OTEL_EXPORTER_OTLP_CERTIFICATE":        "invalid_cert",
 				"OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE": "invalid_cert",
@@ -251,6 +349,7 @@ func TestNewConfig(t *testing.T) {
 				"OTEL_EXPORTER_OTLP_LOGS_ENDPOINT":           "https://env.endpoint:8080/path",
 				"OTEL_EXPORTER_OTLP_LOGS_HEADERS":            "a=A",
 				"OTEL_EXPORTER_OTLP_LOGS_COMPRESSION":        "gzip",
+				"OTEL_EXPORTER_OTLP_LOGS_PROTOCOL":           "http/protobuf",
 				"OTEL_EXPORTER_OTLP_LOGS_TIMEOUT":            "15000",
 				"OTEL_EXPORTER_OTLP_LOGS_CERTIFICATE":        "cert_path",
 				"OTEL_EXPORTER_OTLP_LOGS_CLIENT_CERTIFICATE": "cert_path",
@@ -261,8 +360,10 @@ func TestNewConfig(t *testing.T) {
 				path:        newSetting("/path"),
 				insecure:    newSetting(false),
 				tlsCfg:      newSetting(tlsCfg),
+				tlsFiles:    newSetting(tlsreload.Files{CAFile: "cert_path", CertFile: "cert_path", KeyFile: "key_path"}),
 				headers:     newSetting(headers),
 				compression: newSetting(GzipCompression),
+				encoding:    newSetting(ProtobufEncoding),
 				timeout:     newSetting(15 * time.Second),
 				retryCfg:    newSetting(defaultRetryCfg),
 			},
@@ -281,6 +382,7 @@ func TestNewConfig(t *testing.T) {
 				"OTEL_EXPORTER_OTLP_LOGS_ENDPOINT":           "https://env.endpoint:8080/prefix",
 				"OTEL_EXPORTER_OTLP_LOGS_HEADERS":            "a=A",
 				"OTEL_EXPORTER_OTLP_LOGS_COMPRESSION":        "gzip",
+				"OTEL_EXPORTER_OTLP_LOGS_PROTOCOL":           "http/json",
 				"OTEL_EXPORTER_OTLP_LOGS_TIMEOUT":            "15000",
 				"OTEL_EXPORTER_OTLP_LOGS_CERTIFICATE":        "cert_path",
 				"OTEL_EXPORTER_OTLP_LOGS_CLIENT_CERTIFICATE": "cert_path",
@@ -293,6 +395,7 @@ func TestNewConfig(t *testing.T) {
 				WithInsecure(),
 				WithTLSClientConfig(tlsCfg),
 				WithCompression(GzipCompression),
+				WithEncoding(ProtobufEncoding),
 				WithHeaders(headers),
 				WithTimeout(time.Second),
 				WithRetry(RetryConfig(rc)),
@@ -302,8 +405,10 @@ func TestNewConfig(t *testing.T) {
 				path:        newSetting("/path"),
 				insecure:    newSetting(true),
 				tlsCfg:      newSetting(tlsCfg),
+				tlsFiles:    newSetting(tlsreload.Files{}),
 				headers:     newSetting(headers),
 				compression: newSetting(GzipCompression),
+				encoding:    newSetting(ProtobufEncoding),
 				timeout:     newSetting(time.Second),
 				retryCfg:    newSetting(rc),
 			},
@@ -314,6 +419,7 @@ func TestNewConfig(t *testing.T) {
 				"OTEL_EXPORTER_OTLP_LOGS_ENDPOINT":           "%invalid",
 				"OTEL_EXPORTER_OTLP_LOGS_HEADERS":            "a,%ZZ=valid,key=%ZZ",
 				"OTEL_EXPORTER_OTLP_LOGS_COMPRESSION":        "xz",
+				"OTEL_EXPORTER_OTLP_LOGS_PROTOCOL":           "grpc",
 				"OTEL_EXPORTER_OTLP_LOGS_TIMEOUT":            "100 seconds",
 				"OTEL_EXPORTER_OTLP_LOGS_CERTIFICATE":        "invalid_cert",
 				"OTEL_EXPORTER_OTLP_LOGS_CLIENT_CERTIFICATE": "invalid_cert",
@@ -321,6 +427,7 @@ func TestNewConfig(t *testing.T) {
 			},
 			want: config{
 				endpoint: newSetting(defaultEndpoint),
+				tlsFiles: newSetting(tlsreload.Files{CAFile: "invalid_cert", CertFile: "invalid_cert", KeyFile: "invalid_key"}),
 				path:     newSetting(defaultPath),
 				timeout:  newSetting(defaultTimeout),
 				retryCfg: newSetting(defaultRetryCfg),
@@ -335,6 +442,7 @@ func TestNewConfig(t *testing.T) {
 				`invalid header key: %ZZ`,
 				`invalid header value: %ZZ`,
 				`invalid OTEL_EXPORTER_OTLP_LOGS_COMPRESSION value xz: unknown compression: xz`,
+				`invalid OTEL_EXPORTER_OTLP_LOGS_PROTOCOL value grpc: unknown protocol: grpc`,
 				`invalid OTEL_EXPORTER_OTLP_LOGS_TIMEOUT value 100 seconds: strconv.Atoi: parsing "100 seconds": invalid syntax`,
 			},
 		},
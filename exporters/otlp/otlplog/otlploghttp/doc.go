@@ -3,7 +3,7 @@
 
 /*
 Package otlploghttp provides an OTLP log exporter. The exporter uses HTTP to
-transport OTLP protobuf payloads.
+transport OTLP protobuf or JSON payloads.
 
 Exporter should be created using [New].
 
@@ -39,10 +39,19 @@ The configuration can be overridden by [WithTimeout] option.
 
 OTEL_EXPORTER_OTLP_COMPRESSION, OTEL_EXPORTER_OTLP_LOGS_COMPRESSION (default: none) -
 the compression strategy the exporter uses to compress the HTTP body.
-Supported value: "gzip".
+Supported values: "gzip", "zstd", "snappy".
+The "zstd" and "snappy" compressors need to be registered with [google.golang.org/grpc/encoding],
+e.g. by importing [go.opentelemetry.io/otel/exporters/otlp/otlpcompress/zstd] or
+[go.opentelemetry.io/otel/exporters/otlp/otlpcompress/snappy].
 OTEL_EXPORTER_OTLP_LOGS_COMPRESSION takes precedence over OTEL_EXPORTER_OTLP_COMPRESSION.
 The configuration can be overridden by [WithCompression] option.
 
+OTEL_EXPORTER_OTLP_PROTOCOL, OTEL_EXPORTER_OTLP_LOGS_PROTOCOL (default: "http/protobuf") -
+the transport protocol the exporter uses to encode the HTTP body.
+Supported values: "http/protobuf", "http/json".
+OTEL_EXPORTER_OTLP_LOGS_PROTOCOL takes precedence over OTEL_EXPORTER_OTLP_PROTOCOL.
+The configuration can be overridden by [WithEncoding] option.
+
 OTEL_EXPORTER_OTLP_CERTIFICATE, OTEL_EXPORTER_OTLP_LOGS_CERTIFICATE (default: none) -
 the filepath to the trusted certificate to use when verifying a server's TLS credentials.
 OTEL_EXPORTER_OTLP_LOGS_CERTIFICATE takes precedence over OTEL_EXPORTER_OTLP_CERTIFICATE.
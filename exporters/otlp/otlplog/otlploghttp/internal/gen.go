@@ -3,10 +3,22 @@
 
 package internal // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal"
 
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/split.go.tmpl "--data={}" --out=split.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/split_test.go.tmpl "--data={}" --out=split_test.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/result.go.tmpl "--data={}" --out=result.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/result_test.go.tmpl "--data={}" --out=result_test.go
+
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/retry/retry.go.tmpl "--data={}" --out=retry/retry.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/retry/retry_test.go.tmpl "--data={}" --out=retry/retry_test.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/tlsreload/tlsreload.go.tmpl "--data={}" --out=tlsreload/tlsreload.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/tlsreload/tlsreload_test.go.tmpl "--data={}" --out=tlsreload/tlsreload_test.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/failover/failover.go.tmpl "--data={}" --out=failover/failover.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/failover/failover_test.go.tmpl "--data={}" --out=failover/failover_test.go
 
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/attr_test.go.tmpl "--data={}" --out=transform/attr_test.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/log.go.tmpl "--data={}" --out=transform/log.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/log_attr_test.go.tmpl "--data={}" --out=transform/log_attr_test.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/log_test.go.tmpl "--data={}" --out=transform/log_test.go
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpjson/json.go.tmpl "--data={}" --out=otlpjson/json.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpjson/json_test.go.tmpl "--data={}" --out=otlpjson/json_test.go
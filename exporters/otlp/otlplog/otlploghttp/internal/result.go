@@ -0,0 +1,37 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/result.go.tmpl
+
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal"
+
+// ExportResult accumulates the outcome of the requests sent to export a
+// batch of telemetry, including its retries and the requests it is split
+// into.
+type ExportResult struct {
+	// Attempts is the number of requests sent.
+	Attempts int
+	// Rejected is the number of items rejected in partial success
+	// responses.
+	Rejected int64
+	// RejectedMessages are the non-empty error messages of the partial
+	// success responses.
+	RejectedMessages []string
+	// Err is the error the export failed with, or nil if it succeeded.
+	Err error
+}
+
+// Attempt records that a request is sent.
+func (r *ExportResult) Attempt() {
+	r.Attempts++
+}
+
+// PartialSuccess records a partial success response that rejected n items
+// with the error message msg.
+func (r *ExportResult) PartialSuccess(n int64, msg string) {
+	r.Rejected += n
+	if msg != "" {
+		r.RejectedMessages = append(r.RejectedMessages, msg)
+	}
+}
@@ -0,0 +1,29 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/result_test.go.tmpl
+
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportResult(t *testing.T) {
+	var r ExportResult
+	r.Attempt()
+	r.PartialSuccess(2, "bad data")
+	r.Attempt()
+	r.PartialSuccess(0, "")
+	r.Attempt()
+	r.PartialSuccess(1, "")
+
+	assert.Equal(t, ExportResult{
+		Attempts:         3,
+		Rejected:         3,
+		RejectedMessages: []string{"bad data"},
+	}, r)
+}
@@ -0,0 +1,77 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/otlplog/split.go.tmpl
+
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal"
+
+import (
+	"fmt"
+
+	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+// RequestSizeError returns an error describing a request of size bytes that
+// cannot be split to fit in the maximum request size of limit bytes.
+func RequestSizeError(size, limit int) error {
+	return fmt.Errorf("request size of %d bytes exceeds the maximum request size of %d bytes", size, limit)
+}
+
+// SplitResourceLogs splits rl into two batches with about the same number of
+// items. The resources are split first. If rl contains a single resource, its
+// scopes are split and, if it contains a single scope, its log records are
+// split.
+//
+// The returned batches share the resources, scopes and log records of rl.
+// False is returned if rl contains only a single log record and cannot be
+// split.
+func SplitResourceLogs(rl []*logpb.ResourceLogs) ([]*logpb.ResourceLogs, []*logpb.ResourceLogs, bool) {
+	if a, b, ok := halve(rl); ok || len(rl) == 0 {
+		return a, b, ok
+	}
+
+	r := rl[0]
+	if a, b, ok := halve(r.ScopeLogs); ok {
+		return []*logpb.ResourceLogs{withScopeLogs(r, a)},
+			[]*logpb.ResourceLogs{withScopeLogs(r, b)},
+			true
+	}
+	if len(r.ScopeLogs) == 0 {
+		return nil, nil, false
+	}
+
+	s := r.ScopeLogs[0]
+	if a, b, ok := halve(s.LogRecords); ok {
+		return []*logpb.ResourceLogs{withScopeLogs(r, []*logpb.ScopeLogs{withLogRecords(s, a)})},
+			[]*logpb.ResourceLogs{withScopeLogs(r, []*logpb.ScopeLogs{withLogRecords(s, b)})},
+			true
+	}
+	return nil, nil, false
+}
+
+func withScopeLogs(r *logpb.ResourceLogs, ss []*logpb.ScopeLogs) *logpb.ResourceLogs {
+	return &logpb.ResourceLogs{
+		Resource:  r.Resource,
+		ScopeLogs: ss,
+		SchemaUrl: r.SchemaUrl,
+	}
+}
+
+func withLogRecords(s *logpb.ScopeLogs, records []*logpb.LogRecord) *logpb.ScopeLogs {
+	return &logpb.ScopeLogs{
+		Scope:      s.Scope,
+		LogRecords: records,
+		SchemaUrl:  s.SchemaUrl,
+	}
+}
+
+// halve splits s into two halves. False is returned if s contains less than
+// two elements.
+func halve[T any](s []T) ([]T, []T, bool) {
+	if len(s) < 2 {
+		return nil, nil, false
+	}
+	n := len(s) / 2
+	return s[:n:n], s[n:], true
+}
@@ -0,0 +1,20 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package otlpmetricfile provides an OTLP metric exporter that writes the OTLP
+JSON Lines file format: one OTLP/JSON encoded ExportMetricsServiceRequest per
+line. The files written can be read by the OpenTelemetry Collector's
+otlpjsonfile receiver, or replayed with [ReadFile].
+
+Exporter should be created using [New] and used with a
+[go.opentelemetry.io/otel/sdk/metric.PeriodicReader].
+
+The file written, set with [WithPath], can be rotated based on its size
+([WithMaxSize]) and its age ([WithRotationInterval]). A rotated file is
+renamed with the time of its rotation, e.g. "metrics.jsonl" is rotated to
+"metrics-2006-01-02T15-04-05.000000000.jsonl", and can be compressed with
+gzip ([WithGzip]). The number of rotated files kept can be limited with
+[WithMaxBackups].
+*/
+package otlpmetricfile // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricfile"
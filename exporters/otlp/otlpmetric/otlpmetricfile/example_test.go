@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpmetricfile_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricfile"
+	"go.opentelemetry.io/otel/sdk/metric"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+)
+
+func Example() {
+	ctx := context.Background()
+	exp, err := otlpmetricfile.New(
+		otlpmetricfile.WithPath("metrics.jsonl"),
+		// Rotate the file daily or when it reaches 100 MiB.
+		otlpmetricfile.WithRotationInterval(24*time.Hour),
+		otlpmetricfile.WithMaxSize(100<<20),
+		// Keep a week of compressed rotated files.
+		otlpmetricfile.WithMaxBackups(7),
+		otlpmetricfile.WithGzip(),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	meterProvider := metric.NewMeterProvider(metric.WithReader(metric.NewPeriodicReader(exp)))
+	defer func() {
+		if err := meterProvider.Shutdown(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	otel.SetMeterProvider(meterProvider)
+}
+
+func ExampleReadFile() {
+	// Replay the metric data written to metrics.jsonl and its rotated files.
+	err := otlpmetricfile.ReadFile("metrics.jsonl", func(req *colmetricpb.ExportMetricsServiceRequest) error {
+		// E.g. send req to an OTLP endpoint.
+		fmt.Println(len(req.ResourceMetrics))
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
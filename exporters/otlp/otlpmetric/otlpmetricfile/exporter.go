@@ -0,0 +1,158 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpmetricfile // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricfile"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricfile/internal/otlpfile"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricfile/internal/otlpjson"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricfile/internal/transform"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+var _ metric.Exporter = (*Exporter)(nil)
+
+var errShutdown = errors.New("exporter is shutdown")
+
+// Exporter writes metric data as OTLP JSON Lines to a file or an io.Writer.
+type Exporter struct {
+	writer *otlpfile.Writer
+
+	temporalitySelector metric.TemporalitySelector
+	aggregationSelector metric.AggregationSelector
+
+	shutdownMu sync.RWMutex
+	shutdown   bool
+}
+
+// New returns a new [Exporter] configured with options.
+//
+// If a path is set with [WithPath], the file is opened and an error is
+// returned if it cannot be.
+func New(options ...Option) (*Exporter, error) {
+	cfg := newConfig(options)
+	w, err := otlpfile.NewWriter(cfg.file)
+	if err != nil {
+		return nil, err
+	}
+	return &Exporter{
+		writer:              w,
+		temporalitySelector: cfg.temporalitySelector,
+		aggregationSelector: cfg.aggregationSelector,
+	}, nil
+}
+
+// Temporality returns the Temporality to use for an instrument kind.
+func (e *Exporter) Temporality(k metric.InstrumentKind) metricdata.Temporality {
+	return e.temporalitySelector(k)
+}
+
+// Aggregation returns the Aggregation to use for an instrument kind.
+func (e *Exporter) Aggregation(k metric.InstrumentKind) metric.Aggregation {
+	return e.aggregationSelector(k)
+}
+
+// Export writes rm as a single OTLP/JSON encoded export request line.
+//
+// The metric data that cannot be transformed to OTLP is not written, and an
+// error describing it is returned.
+//
+// This method returns an error if called after Shutdown.
+// This method returns an error if the method is canceled by the passed context.
+//
+// This method is safe to call concurrently.
+func (e *Exporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	e.shutdownMu.RLock()
+	defer e.shutdownMu.RUnlock()
+	if e.shutdown {
+		return errShutdown
+	}
+
+	otlpRm, err := transform.ResourceMetrics(rm)
+	// Best effort write of transformable metrics.
+	b, mErr := otlpjson.Marshal(&colmetricpb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricpb.ResourceMetrics{otlpRm},
+	})
+	if mErr == nil {
+		mErr = e.writer.WriteLine(b)
+	}
+	if mErr != nil {
+		if err == nil {
+			return fmt.Errorf("failed to write metrics: %w", mErr)
+		}
+		// Merge the two errors.
+		return fmt.Errorf("failed to write incomplete metrics (%w): %w", err, mErr)
+	}
+	return err
+}
+
+// ForceFlush commits the file written to stable storage.
+//
+// This method returns an error if the method is canceled by the passed context.
+//
+// This method is safe to call concurrently.
+func (e *Exporter) ForceFlush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return e.writer.Sync()
+}
+
+// Shutdown closes the file written.
+//
+// This method returns an error if called after Shutdown.
+//
+// This method is safe to call concurrently.
+func (e *Exporter) Shutdown(context.Context) error {
+	e.shutdownMu.Lock()
+	defer e.shutdownMu.Unlock()
+	if e.shutdown {
+		return errShutdown
+	}
+	e.shutdown = true
+	return e.writer.Close()
+}
+
+// MarshalLog returns logging data about the Exporter.
+func (e *Exporter) MarshalLog() interface{} {
+	return struct{ Type string }{Type: "otlpmetricfile"}
+}
+
+// ReadFile calls fn with each export request read from the files written to
+// path by an [Exporter]: the rotated files from the oldest to the newest
+// first, and path itself last. Rotated files compressed with gzip are
+// decompressed. It stops at the first error returned by fn, and returns it.
+//
+// ReadFile can be used to replay the metric data written, e.g. by sending the
+// export requests to an OTLP endpoint.
+func ReadFile(path string, fn func(*colmetricpb.ExportMetricsServiceRequest) error) error {
+	return otlpfile.ReadFiles(path, decode(fn))
+}
+
+// Read calls fn with each export request read from r. It stops at the first
+// error returned by fn, and returns it.
+func Read(r io.Reader, fn func(*colmetricpb.ExportMetricsServiceRequest) error) error {
+	return otlpfile.Read(r, decode(fn))
+}
+
+func decode(fn func(*colmetricpb.ExportMetricsServiceRequest) error) func([]byte) error {
+	return func(b []byte) error {
+		req := new(colmetricpb.ExportMetricsServiceRequest)
+		if err := otlpjson.Unmarshal(b, req); err != nil {
+			return err
+		}
+		return fn(req)
+	}
+}
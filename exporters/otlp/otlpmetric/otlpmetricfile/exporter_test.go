@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpmetricfile
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+)
+
+func resourceMetrics(names ...string) *metricdata.ResourceMetrics {
+	metrics := make([]metricdata.Metrics, len(names))
+	for i, name := range names {
+		metrics[i] = metricdata.Metrics{
+			Name: name,
+			Data: metricdata.Gauge[int64]{
+				DataPoints: []metricdata.DataPoint[int64]{{Value: 1}},
+			},
+		}
+	}
+	return &metricdata.ResourceMetrics{
+		Resource: resource.NewSchemaless(attribute.String("service.name", "test")),
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope:   instrumentation.Scope{Name: "test"},
+			Metrics: metrics,
+		}},
+	}
+}
+
+func readAll(t *testing.T, read func(func(*colmetricpb.ExportMetricsServiceRequest) error) error) []string {
+	t.Helper()
+	var names []string
+	require.NoError(t, read(func(req *colmetricpb.ExportMetricsServiceRequest) error {
+		for _, rm := range req.ResourceMetrics {
+			for _, sm := range rm.ScopeMetrics {
+				for _, m := range sm.Metrics {
+					names = append(names, m.Name)
+				}
+			}
+		}
+		return nil
+	}))
+	return names
+}
+
+func TestExporterWriter(t *testing.T) {
+	var buf bytes.Buffer
+	exp, err := New(WithWriter(&buf))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, exp.Export(ctx, resourceMetrics("a", "b")))
+	require.NoError(t, exp.Export(ctx, resourceMetrics("c")))
+	require.NoError(t, exp.ForceFlush(ctx))
+
+	assert.Equal(t, 2, strings.Count(buf.String(), "\n"), "one line per export request")
+	assert.Equal(t, []string{"a", "b", "c"}, readAll(t, func(fn func(*colmetricpb.ExportMetricsServiceRequest) error) error {
+		return Read(&buf, fn)
+	}))
+}
+
+func TestExporterFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.jsonl")
+	exp, err := New(WithPath(path), WithMaxSize(1), WithGzip())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for _, name := range []string{"a", "b", "c"} {
+		require.NoError(t, exp.Export(ctx, resourceMetrics(name)))
+	}
+	require.NoError(t, exp.ForceFlush(ctx))
+	require.NoError(t, exp.Shutdown(ctx))
+
+	assert.Equal(t, []string{"a", "b", "c"}, readAll(t, func(fn func(*colmetricpb.ExportMetricsServiceRequest) error) error {
+		return ReadFile(path, fn)
+	}))
+}
+
+func TestExporterPartialTransform(t *testing.T) {
+	var buf bytes.Buffer
+	exp, err := New(WithWriter(&buf))
+	require.NoError(t, err)
+
+	rm := resourceMetrics("valid")
+	rm.ScopeMetrics[0].Metrics = append(rm.ScopeMetrics[0].Metrics, metricdata.Metrics{
+		Name: "invalid",
+		// Unknown aggregation.
+		Data: nil,
+	})
+	assert.Error(t, exp.Export(context.Background(), rm))
+	assert.Equal(t, []string{"valid"}, readAll(t, func(fn func(*colmetricpb.ExportMetricsServiceRequest) error) error {
+		return Read(&buf, fn)
+	}))
+}
+
+func TestExporterShutdown(t *testing.T) {
+	exp, err := New(WithWriter(&bytes.Buffer{}))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, exp.Shutdown(ctx))
+	assert.ErrorIs(t, exp.Shutdown(ctx), errShutdown)
+	assert.ErrorIs(t, exp.Export(ctx, resourceMetrics("a")), errShutdown)
+	assert.NoError(t, exp.ForceFlush(ctx))
+}
+
+func TestExporterCanceled(t *testing.T) {
+	exp, err := New(WithWriter(&bytes.Buffer{}))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, exp.Export(ctx, resourceMetrics("a")), context.Canceled)
+	assert.ErrorIs(t, exp.ForceFlush(ctx), context.Canceled)
+}
+
+func TestExporterSelectors(t *testing.T) {
+	exp, err := New(WithWriter(&bytes.Buffer{}))
+	require.NoError(t, err)
+	assert.Equal(t, metricdata.CumulativeTemporality, exp.Temporality(metric.InstrumentKindCounter))
+	assert.Equal(t, metric.DefaultAggregationSelector(metric.InstrumentKindHistogram), exp.Aggregation(metric.InstrumentKindHistogram))
+
+	exp, err = New(
+		WithWriter(&bytes.Buffer{}),
+		WithTemporalitySelector(func(metric.InstrumentKind) metricdata.Temporality {
+			return metricdata.DeltaTemporality
+		}),
+		WithAggregationSelector(func(metric.InstrumentKind) metric.Aggregation {
+			return metric.AggregationDrop{}
+		}),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, metricdata.DeltaTemporality, exp.Temporality(metric.InstrumentKindCounter))
+	assert.Equal(t, metric.AggregationDrop{}, exp.Aggregation(metric.InstrumentKindHistogram))
+}
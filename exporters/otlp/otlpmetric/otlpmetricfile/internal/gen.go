@@ -0,0 +1,17 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricfile/internal"
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpfile/file.go.tmpl "--data={}" --out=otlpfile/file.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpfile/file_test.go.tmpl "--data={}" --out=otlpfile/file_test.go
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpjson/json.go.tmpl "--data={}" --out=otlpjson/json.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpjson/json_test.go.tmpl "--data={}" --out=otlpjson/json_test.go
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/transform/attribute.go.tmpl "--data={}" --out=transform/attribute.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/transform/attribute_test.go.tmpl "--data={}" --out=transform/attribute_test.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/transform/error.go.tmpl "--data={}" --out=transform/error.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/transform/error_test.go.tmpl "--data={}" --out=transform/error_test.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/transform/metricdata.go.tmpl "--data={}" --out=transform/metricdata.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/transform/metricdata_test.go.tmpl "--data={}" --out=transform/metricdata_test.go
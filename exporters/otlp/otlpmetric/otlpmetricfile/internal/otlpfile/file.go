@@ -0,0 +1,382 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/otlpfile/file.go
+
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package otlpfile provides the writing and reading of OTLP JSON Lines files.
+//
+// An OTLP JSON Lines file holds one OTLP/JSON encoded export request per
+// line. The files written can be rotated based on their size and age.
+// Rotated files are renamed with the time of their rotation, e.g.
+// "traces.jsonl" is rotated to "traces-2006-01-02T15-04-05.000000000.jsonl",
+// and can be compressed with gzip.
+package otlpfile // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricfile/internal/otlpfile"
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// backupTimeFormat is the format of the time in the name of rotated files.
+// It sorts lexicographically in chronological order.
+const backupTimeFormat = "2006-01-02T15-04-05.000000000"
+
+// gzipExt is the extension of compressed rotated files.
+const gzipExt = ".gz"
+
+// errClosed is returned when writing to a closed Writer.
+var errClosed = errors.New("otlpfile: writer closed")
+
+// now returns the current time. It is a variable so tests can override it.
+var now = time.Now
+
+// Config configures a Writer.
+type Config struct {
+	// Path is the path of the file written. If empty, Writer is written to
+	// instead.
+	Path string
+	// Writer is written to if Path is empty. If nil, os.Stdout is used.
+	Writer io.Writer
+
+	// MaxSize is the size in bytes a file is rotated at. A file is not
+	// rotated based on its size if MaxSize is less than 1.
+	MaxSize int64
+	// Interval is the duration a file is rotated after. A file is not
+	// rotated based on its age if Interval is not positive.
+	Interval time.Duration
+	// MaxBackups is the maximum number of rotated files kept. The oldest are
+	// removed first. All rotated files are kept if MaxBackups is less than 1.
+	MaxBackups int
+	// Gzip compresses the rotated files with gzip.
+	Gzip bool
+}
+
+// Writer writes lines to a file, rotating it as configured, or to an
+// io.Writer.
+type Writer struct {
+	cfg Config
+
+	mu     sync.Mutex
+	w      io.Writer
+	file   *os.File
+	size   int64
+	opened time.Time
+	closed bool
+}
+
+// NewWriter returns a Writer configured with cfg. If cfg.Path is set, the file
+// is created if it does not exist, or appended to if it does.
+func NewWriter(cfg Config) (*Writer, error) {
+	w := &Writer{cfg: cfg}
+	if cfg.Path == "" {
+		w.w = cfg.Writer
+		if w.w == nil {
+			w.w = os.Stdout
+		}
+		return w, nil
+	}
+
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// open opens the file at the configured path.
+func (w *Writer) open() error {
+	f, err := os.OpenFile(w.cfg.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Join(err, f.Close())
+	}
+	w.file, w.w, w.size, w.opened = f, f, info.Size(), now()
+	return nil
+}
+
+// WriteLine writes b followed by a newline. The file is rotated before if
+// writing the line would exceed its maximum size, or if it is older than the
+// rotation interval.
+func (w *Writer) WriteLine(b []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return errClosed
+	}
+
+	// Do not modify the array of b.
+	line := append(b[:len(b):len(b)], '\n')
+	if w.shouldRotate(int64(len(line))) {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := w.w.Write(line)
+	w.size += int64(n)
+	return err
+}
+
+// shouldRotate returns if the file needs to be rotated before n bytes are
+// written to it.
+func (w *Writer) shouldRotate(n int64) bool {
+	if w.file == nil || w.size == 0 {
+		return false
+	}
+	if w.cfg.MaxSize > 0 && w.size+n > w.cfg.MaxSize {
+		return true
+	}
+	return w.cfg.Interval > 0 && now().Sub(w.opened) >= w.cfg.Interval
+}
+
+// rotate renames the file with the current time, compresses it if
+// configured, removes the rotated files exceeding the maximum number kept,
+// and opens a new file.
+func (w *Writer) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+	w.file = nil
+
+	backup := w.backupName()
+	if err := os.Rename(w.cfg.Path, backup); err != nil {
+		return errors.Join(err, w.open())
+	}
+	if err := w.open(); err != nil {
+		return err
+	}
+
+	var err error
+	if w.cfg.Gzip {
+		err = compress(backup)
+	}
+	return errors.Join(err, w.removeBackups())
+}
+
+// removeBackups removes the oldest rotated files exceeding the maximum number
+// kept.
+func (w *Writer) removeBackups() error {
+	if w.cfg.MaxBackups < 1 {
+		return nil
+	}
+	backups, err := Backups(w.cfg.Path)
+	if err != nil {
+		return err
+	}
+	if len(backups) <= w.cfg.MaxBackups {
+		return nil
+	}
+
+	for _, name := range backups[:len(backups)-w.cfg.MaxBackups] {
+		err = errors.Join(err, os.Remove(name))
+	}
+	return err
+}
+
+// Sync commits the written file to stable storage. It does nothing if no
+// file is written.
+func (w *Writer) Sync() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed || w.file == nil {
+		return nil
+	}
+	return w.file.Sync()
+}
+
+// Close closes the written file. The io.Writer written to if no file is
+// written is not closed. Close is idempotent.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	if w.file == nil {
+		return nil
+	}
+	return w.file.Close()
+}
+
+// backupName returns the name the file is rotated to. The name is based on
+// the current time, moved forward if a rotated file already has it.
+func (w *Writer) backupName() string {
+	t := now()
+	name := backupName(w.cfg.Path, t)
+	for exists(name) || exists(name+gzipExt) {
+		t = t.Add(time.Nanosecond)
+		name = backupName(w.cfg.Path, t)
+	}
+	return name
+}
+
+// backupName returns the name of the file path rotated at t.
+func backupName(path string, t time.Time) string {
+	dir, base := filepath.Split(path)
+	ext := filepath.Ext(base)
+	prefix := strings.TrimSuffix(base, ext)
+	return filepath.Join(dir, fmt.Sprintf("%s-%s%s", prefix, t.UTC().Format(backupTimeFormat), ext))
+}
+
+func exists(name string) bool {
+	_, err := os.Lstat(name)
+	return err == nil
+}
+
+// compress compresses the file name with gzip and removes it.
+func compress(name string) error {
+	if err := gzipFile(name, name+gzipExt); err != nil {
+		return errors.Join(err, os.Remove(name+gzipExt))
+	}
+	return os.Remove(name)
+}
+
+// gzipFile writes the file src compressed with gzip to the file dst.
+func gzipFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, in.Close()) }()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, out.Close()) }()
+
+	gz := gzip.NewWriter(out)
+	if _, err := io.Copy(gz, in); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// Backups returns the rotated files of path from the oldest to the newest.
+func Backups(path string) ([]string, error) {
+	dir, base := filepath.Split(path)
+	ext := filepath.Ext(base)
+	prefix := strings.TrimSuffix(base, ext) + "-"
+
+	if dir == "" {
+		dir = "."
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	type backup struct {
+		name string
+		t    time.Time
+	}
+	var backups []backup
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		ts := strings.TrimPrefix(name, prefix)
+		ts = strings.TrimSuffix(ts, gzipExt)
+		if !strings.HasSuffix(ts, ext) {
+			continue
+		}
+		t, err := time.Parse(backupTimeFormat, strings.TrimSuffix(ts, ext))
+		if err != nil {
+			// Not a rotated file.
+			continue
+		}
+		backups = append(backups, backup{name: filepath.Join(dir, name), t: t})
+	}
+	sort.SliceStable(backups, func(i, j int) bool { return backups[i].t.Before(backups[j].t) })
+
+	names := make([]string, len(backups))
+	for i, b := range backups {
+		names[i] = b.name
+	}
+	return names, nil
+}
+
+// ReadFiles calls fn with each line of the files written to path, the rotated
+// files from the oldest to the newest first, and path itself last. It stops
+// at the first error returned by fn.
+func ReadFiles(path string, fn func([]byte) error) error {
+	names, err := Backups(path)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(path); err == nil {
+		names = append(names, path)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	for _, name := range names {
+		if err := readFile(name, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readFile calls fn with each line of the file name. The file is
+// decompressed if it is compressed with gzip.
+func readFile(name string, fn func([]byte) error) (err error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, f.Close()) }()
+
+	var r io.Reader = f
+	if strings.HasSuffix(name, gzipExt) {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		defer func() { err = errors.Join(err, gz.Close()) }()
+		r = gz
+	}
+	if err := Read(r, fn); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+// Read calls fn with each non-empty line read from r, without its line
+// ending. It stops at the first error returned by fn.
+func Read(r io.Reader, fn func([]byte) error) error {
+	br := bufio.NewReader(r)
+	for n := 1; ; n++ {
+		line, err := br.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			if fnErr := fn(line); fnErr != nil {
+				return fmt.Errorf("line %d: %w", n, fnErr)
+			}
+		}
+		if err != nil {
+			// io.EOF.
+			return nil
+		}
+	}
+}
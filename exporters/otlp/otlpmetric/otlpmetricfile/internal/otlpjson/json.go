@@ -0,0 +1,110 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/otlpjson/json.go
+
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package otlpjson provides the OTLP/JSON encoding of OTLP messages.
+package otlpjson // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricfile/internal/otlpjson"
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// idFields are the JSON field names of all OTLP trace and span identifiers.
+//
+// The OTLP/JSON encoding deviates from the Protobuf JSON mapping for these
+// fields. They are encoded as case-insensitive hex strings instead of base64.
+var idFields = map[string]bool{
+	"traceId":      true,
+	"spanId":       true,
+	"parentSpanId": true,
+}
+
+// Marshal returns the OTLP/JSON encoding of m.
+//
+// The encoding follows the Protobuf JSON mapping with the OTLP/JSON
+// deviations: trace and span identifiers are hex encoded, enums are encoded
+// as integers, and field names use lowerCamelCase.
+func Marshal(m proto.Message) ([]byte, error) {
+	b, err := protojson.MarshalOptions{UseEnumNumbers: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return convert(b, base64ToHex)
+}
+
+// Unmarshal parses the OTLP/JSON encoded b and stores the result in m.
+//
+// Unknown fields are ignored as required by the OTLP specification.
+func Unmarshal(b []byte, m proto.Message) error {
+	b, err := convert(b, hexToBase64)
+	if err != nil {
+		return err
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(b, m)
+}
+
+// convert returns the JSON b with all identifier fields converted by fn.
+func convert(b []byte, fn func(string) (string, error)) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	// Numbers need to be passed through unmodified.
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if err := convertIDs(v, fn); err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+func convertIDs(v interface{}, fn func(string) (string, error)) error {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if s, ok := val.(string); ok && idFields[key] {
+				id, err := fn(s)
+				if err != nil {
+					return fmt.Errorf("invalid %s: %w", key, err)
+				}
+				v[key] = id
+				continue
+			}
+			if err := convertIDs(val, fn); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, val := range v {
+			if err := convertIDs(val, fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func base64ToHex(s string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hexToBase64(s string) (string, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
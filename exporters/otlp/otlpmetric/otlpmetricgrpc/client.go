@@ -5,6 +5,9 @@ package otlpmetricgrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlpme
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
@@ -12,9 +15,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/failover"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/oconf"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/retry"
 	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
@@ -22,9 +27,15 @@ import (
 )
 
 type client struct {
-	metadata      metadata.MD
-	exportTimeout time.Duration
-	requestFunc   retry.RequestFunc
+	metadata       metadata.MD
+	headerFn       func(context.Context) (map[string]string, error)
+	exportTimeout  time.Duration
+	requestFunc    retry.RequestFunc
+	maxRequestSize int
+	resultHandler  func(internal.ExportResult)
+	// failover is the set of endpoints to fail over between, or nil if the
+	// client only sends to the configured endpoint.
+	failover *failover.Set
 
 	// ourConn keeps track of where conn was created: true if created here in
 	// NewClient, or false if passed with an option. This is important on
@@ -33,14 +44,22 @@ type client struct {
 	ourConn bool
 	conn    *grpc.ClientConn
 	msc     colmetricpb.MetricsServiceClient
+
+	// failoverConns are the connections to the failover endpoints, and
+	// failoverMSC the clients using them by endpoint.
+	failoverConns []*grpc.ClientConn
+	failoverMSC   map[string]colmetricpb.MetricsServiceClient
 }
 
 // newClient creates a new gRPC metric client.
 func newClient(_ context.Context, cfg oconf.Config) (*client, error) {
 	c := &client{
-		exportTimeout: cfg.Metrics.Timeout,
-		requestFunc:   cfg.RetryConfig.RequestFunc(retryable),
-		conn:          cfg.GRPCConn,
+		exportTimeout:  cfg.Metrics.Timeout,
+		maxRequestSize: cfg.Metrics.MaxRequestSize,
+		requestFunc:    cfg.RetryConfig.RequestFunc(retryable),
+		conn:           cfg.GRPCConn,
+		headerFn:       cfg.Metrics.HeaderProvider,
+		resultHandler:  cfg.Metrics.ResultHandler,
 	}
 
 	if len(cfg.Metrics.Headers) > 0 {
@@ -54,6 +73,13 @@ func newClient(_ context.Context, cfg oconf.Config) (*client, error) {
 		dialOpts := []grpc.DialOption{grpc.WithUserAgent(userAgent)}
 		dialOpts = append(dialOpts, cfg.DialOptions...)
 
+		if c.failover = failover.NewSet(cfg.Metrics.Failover); c.failover != nil {
+			if err := c.connectFailover(dialOpts); err != nil {
+				return nil, err
+			}
+			return c, nil
+		}
+
 		conn, err := grpc.NewClient(cfg.Metrics.Endpoint, dialOpts...)
 		if err != nil {
 			return nil, err
@@ -69,6 +95,34 @@ func newClient(_ context.Context, cfg oconf.Config) (*client, error) {
 	return c, nil
 }
 
+// connectFailover creates a gRPC connection to every failover endpoint.
+func (c *client) connectFailover(dialOpts []grpc.DialOption) error {
+	c.failoverMSC = make(map[string]colmetricpb.MetricsServiceClient)
+	for _, endpoint := range c.failover.Endpoints() {
+		conn, err := grpc.NewClient(endpoint, dialOpts...)
+		if err != nil {
+			_ = c.closeConns()
+			return err
+		}
+		c.failoverConns = append(c.failoverConns, conn)
+		c.failoverMSC[endpoint] = colmetricpb.NewMetricsServiceClient(conn)
+	}
+	c.ourConn = true
+	return nil
+}
+
+// closeConns closes the connections created by the client.
+func (c *client) closeConns() error {
+	if c.failover == nil {
+		return c.conn.Close()
+	}
+	var err error
+	for _, conn := range c.failoverConns {
+		err = errors.Join(err, conn.Close())
+	}
+	return err
+}
+
 // Shutdown shuts down the client, freeing all resource.
 //
 // Any active connections to a remote endpoint are closed if they were created
@@ -83,16 +137,18 @@ func (c *client) Shutdown(ctx context.Context) error {
 	c.metadata = nil
 	c.requestFunc = nil
 	c.msc = nil
+	c.failoverMSC = nil
 
 	err := ctx.Err()
 	if c.ourConn {
-		closeErr := c.conn.Close()
+		closeErr := c.closeConns()
 		// A context timeout error takes precedence over this error.
 		if err == nil && closeErr != nil {
 			err = closeErr
 		}
 	}
 	c.conn = nil
+	c.failoverConns = nil
 	return err
 }
 
@@ -115,25 +171,101 @@ func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.Resou
 	ctx, cancel := c.exportContext(ctx)
 	defer cancel()
 
-	return c.requestFunc(ctx, func(iCtx context.Context) error {
-		resp, err := c.msc.Export(iCtx, &colmetricpb.ExportMetricsServiceRequest{
-			ResourceMetrics: []*metricpb.ResourceMetrics{protoMetrics},
-		})
-		if resp != nil && resp.PartialSuccess != nil {
-			msg := resp.PartialSuccess.GetErrorMessage()
-			n := resp.PartialSuccess.GetRejectedDataPoints()
-			if n != 0 || msg != "" {
-				err := internal.MetricPartialSuccessError(n, msg)
-				otel.Handle(err)
-			}
+	res := new(internal.ExportResult)
+	err := c.upload(ctx, protoMetrics, res)
+	if c.resultHandler != nil {
+		res.Err = err
+		c.resultHandler(*res)
+	}
+	return err
+}
+
+// upload sends protoMetrics in a single request. If the request exceeds the
+// maximum request size or is rejected by the server as too large,
+// protoMetrics are split and sent in multiple requests. The outcome of the
+// requests is recorded in res.
+func (c *client) upload(ctx context.Context, protoMetrics *metricpb.ResourceMetrics, res *internal.ExportResult) error {
+	req := &colmetricpb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricpb.ResourceMetrics{protoMetrics},
+	}
+	if c.maxRequestSize > 0 {
+		if size := proto.Size(req); size > c.maxRequestSize {
+			return c.uploadSplit(ctx, protoMetrics, res, internal.RequestSizeError(size, c.maxRequestSize))
 		}
-		// nil is converted to OK.
-		if status.Code(err) == codes.OK {
-			// Success.
-			return nil
+	}
+
+	err := c.requestFunc(ctx, func(iCtx context.Context) error {
+		iCtx, err := c.headerContext(iCtx)
+		if err != nil {
+			return err
 		}
-		return err
+		if c.failover == nil {
+			return export(iCtx, c.msc, req, res)
+		}
+		return c.failover.Do(iCtx, func(endpoint string) error {
+			return export(iCtx, c.failoverMSC[endpoint], req, res)
+		}, shouldFailover)
 	})
+	if tooLarge(err) {
+		return c.uploadSplit(ctx, protoMetrics, res, err)
+	}
+	return err
+}
+
+// export sends req using msc and records the attempt in res.
+func export(ctx context.Context, msc colmetricpb.MetricsServiceClient, req *colmetricpb.ExportMetricsServiceRequest, res *internal.ExportResult) error {
+	res.Attempt()
+	resp, err := msc.Export(ctx, req)
+	if resp != nil && resp.PartialSuccess != nil {
+		msg := resp.PartialSuccess.GetErrorMessage()
+		n := resp.PartialSuccess.GetRejectedDataPoints()
+		if n != 0 || msg != "" {
+			res.PartialSuccess(n, msg)
+			err := internal.MetricPartialSuccessError(n, msg)
+			otel.Handle(err)
+		}
+	}
+	// nil is converted to OK.
+	if status.Code(err) == codes.OK {
+		// Success.
+		return nil
+	}
+	return err
+}
+
+// uploadSplit splits protoMetrics in two and uploads both halves. If
+// protoMetrics cannot be split, err is returned.
+func (c *client) uploadSplit(ctx context.Context, protoMetrics *metricpb.ResourceMetrics, res *internal.ExportResult, err error) error {
+	a, b, ok := internal.SplitResourceMetrics(protoMetrics)
+	if !ok {
+		return err
+	}
+	return errors.Join(c.upload(ctx, a, res), c.upload(ctx, b, res))
+}
+
+// headerContext returns a copy of ctx with the outgoing metadata updated
+// with the headers returned by the configured header provider. If no header
+// provider is configured, ctx is returned.
+func (c *client) headerContext(ctx context.Context) (context.Context, error) {
+	if c.headerFn == nil {
+		return ctx, nil
+	}
+	headers, err := c.headerFn(ctx)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to get headers: %w", err)
+	}
+	if len(headers) == 0 {
+		return ctx, nil
+	}
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		md = metadata.MD{}
+	}
+	for k, v := range headers {
+		md.Set(k, v)
+	}
+	return metadata.NewOutgoingContext(ctx, md), nil
 }
 
 // exportContext returns a copy of parent with an appropriate deadline and
@@ -188,6 +320,31 @@ func retryableGRPCStatus(s *status.Status) (bool, time.Duration) {
 	return false, 0
 }
 
+// tooLarge returns if err identifies a request that was rejected because
+// it exceeds the maximum message size of the client or server.
+func tooLarge(err error) bool {
+	s, ok := status.FromError(err)
+	return ok && s.Code() == codes.ResourceExhausted && strings.Contains(s.Message(), "larger than max")
+}
+
+// shouldFailover returns if err identifies a request that should be sent to
+// the next failover endpoint: the endpoint is unavailable or rejected the
+// request with a status that is not retryable.
+func shouldFailover(err error) bool {
+	s := status.Convert(err)
+	switch s.Code() {
+	case codes.Unavailable:
+		return true
+	case codes.ResourceExhausted:
+		if tooLarge(err) {
+			// The request is split instead.
+			return false
+		}
+	}
+	retry, _ := retryableGRPCStatus(s)
+	return !retry
+}
+
 // throttleDelay returns if the status is RetryInfo
 // and the duration to wait for if an explicit throttle time is included.
 func throttleDelay(s *status.Status) (bool, time.Duration) {
@@ -5,6 +5,9 @@ package otlpmetricgrpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,10 +19,12 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/oconf"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/otest"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
 )
 
 func TestThrottleDelay(t *testing.T) {
@@ -218,6 +223,50 @@ func TestConfig(t *testing.T) {
 		assert.Equal(t, got[key], []string{headers[key]})
 	})
 
+	t.Run("WithHeaderProvider", func(t *testing.T) {
+		rCh := make(chan otest.ExportResult, 2)
+		rCh <- otest.ExportResult{Err: status.Error(codes.Unavailable, "backend")}
+		rCh <- otest.ExportResult{}
+
+		key := "authorization"
+		var calls int
+		provider := func(context.Context) (map[string]string, error) {
+			calls++
+			return map[string]string{key: fmt.Sprintf("Bearer token-%d", calls)}, nil
+		}
+		exp, coll := factoryFunc(rCh,
+			WithHeaders(map[string]string{key: "static"}),
+			WithHeaderProvider(provider),
+			WithRetry(RetryConfig{
+				Enabled:         true,
+				InitialInterval: time.Nanosecond,
+				MaxInterval:     time.Millisecond,
+				MaxElapsedTime:  time.Minute,
+			}),
+		)
+		t.Cleanup(coll.Shutdown)
+		ctx := context.Background()
+		require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+		require.NoError(t, exp.Shutdown(ctx))
+
+		assert.Equal(t, 2, calls, "header provider not called for every attempt")
+		got := coll.Headers()
+		assert.Equal(t, []string{"Bearer token-1", "Bearer token-2"}, got[key])
+	})
+
+	t.Run("WithHeaderProviderError", func(t *testing.T) {
+		provider := func(context.Context) (map[string]string, error) {
+			return nil, errors.New("token unavailable")
+		}
+		exp, coll := factoryFunc(nil, WithHeaderProvider(provider))
+		t.Cleanup(coll.Shutdown)
+		ctx := context.Background()
+		err := exp.Export(ctx, &metricdata.ResourceMetrics{})
+		assert.ErrorContains(t, err, "token unavailable")
+		require.NoError(t, exp.Shutdown(ctx))
+		assert.Empty(t, coll.Collect().Dump())
+	})
+
 	t.Run("WithTimeout", func(t *testing.T) {
 		// Do not send on rCh so the Collector never responds to the client.
 		rCh := make(chan otest.ExportResult)
@@ -234,6 +283,173 @@ func TestConfig(t *testing.T) {
 		assert.ErrorContains(t, err, context.DeadlineExceeded.Error())
 	})
 
+	gauges := func(attrs attribute.Set, names ...string) *metricdata.ResourceMetrics {
+		m := make([]metricdata.Metrics, len(names))
+		for i, n := range names {
+			m[i] = metricdata.Metrics{
+				Name: n,
+				Data: metricdata.Gauge[int64]{
+					DataPoints: []metricdata.DataPoint[int64]{
+						{Attributes: attrs, Value: int64(i)},
+					},
+				},
+			}
+		}
+		return &metricdata.ResourceMetrics{
+			ScopeMetrics: []metricdata.ScopeMetrics{{Metrics: m}},
+		}
+	}
+
+	t.Run("SplitRequestTooLarge", func(t *testing.T) {
+		rCh := make(chan otest.ExportResult, 3)
+		rCh <- otest.ExportResult{
+			Err: status.Error(codes.ResourceExhausted, "grpc: received message larger than max (3 vs. 2)"),
+		}
+		rCh <- otest.ExportResult{}
+		rCh <- otest.ExportResult{}
+		exp, coll := factoryFunc(rCh)
+		t.Cleanup(coll.Shutdown)
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		require.NoError(t, exp.Export(ctx, gauges(*attribute.EmptySet(), "0", "1", "2")))
+
+		got := coll.Collect().Dump()
+		require.Len(t, got, 3, "rejected request and its two halves")
+		assert.Len(t, got[1].ScopeMetrics[0].Metrics, 1)
+		assert.Len(t, got[2].ScopeMetrics[0].Metrics, 2)
+	})
+
+	t.Run("WithMaxRequestSize", func(t *testing.T) {
+		exp, coll := factoryFunc(nil, WithMaxRequestSize(512))
+		t.Cleanup(coll.Shutdown)
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+		rm := gauges(*attribute.EmptySet(), "0", "1", "2")
+		large := attribute.NewSet(attribute.String("large", strings.Repeat("x", 1024)))
+		rm.ScopeMetrics[0].Metrics[0] = gauges(large, "0").ScopeMetrics[0].Metrics[0]
+		err := exp.Export(ctx, rm)
+		assert.ErrorContains(t, err, "exceeds the maximum request size of 512 bytes")
+
+		got := coll.Collect().Dump()
+		require.Len(t, got, 1, "metrics within the maximum request size not batched")
+		m := got[0].ScopeMetrics[0].Metrics
+		require.Len(t, m, 2)
+		assert.Equal(t, "1", m[0].Name)
+		assert.Equal(t, "2", m[1].Name)
+	})
+
+	t.Run("WithFailover", func(t *testing.T) {
+		primary, err := otest.NewGRPCCollector("", nil)
+		require.NoError(t, err)
+		backup, err := otest.NewGRPCCollector("", nil)
+		require.NoError(t, err)
+		t.Cleanup(backup.Shutdown)
+
+		ctx := context.Background()
+		exp, err := New(ctx, WithInsecure(), WithFailover(FailoverConfig{
+			Endpoints: []FailoverEndpoint{
+				{Endpoint: primary.Addr().String()},
+				{Endpoint: backup.Addr().String()},
+			},
+			InitialBackoff: 10 * time.Millisecond,
+			MaxBackoff:     10 * time.Millisecond,
+		}))
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+		require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+		assert.Len(t, primary.Collect().Dump(), 1, "primary endpoint not used")
+		assert.Empty(t, backup.Collect().Dump())
+
+		primary.Shutdown()
+		require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+		assert.Len(t, backup.Collect().Dump(), 1, "no failover to backup endpoint")
+
+		// Restart the primary endpoint and wait for the exporter to return
+		// to it.
+		primary, err = otest.NewGRPCCollector(primary.Addr().String(), nil)
+		require.NoError(t, err)
+		t.Cleanup(primary.Shutdown)
+		assert.Eventually(t, func() bool {
+			require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+			return len(primary.Collect().Dump()) > 0
+		}, 10*time.Second, 50*time.Millisecond, "no return to recovered primary endpoint")
+	})
+
+	t.Run("WithFailoverNonRetryable", func(t *testing.T) {
+		rCh := make(chan otest.ExportResult, 1)
+		rCh <- otest.ExportResult{Err: status.Error(codes.InvalidArgument, "invalid")}
+		primary, err := otest.NewGRPCCollector("", rCh)
+		require.NoError(t, err)
+		t.Cleanup(primary.Shutdown)
+		backup, err := otest.NewGRPCCollector("", nil)
+		require.NoError(t, err)
+		t.Cleanup(backup.Shutdown)
+
+		ctx := context.Background()
+		exp, err := New(ctx, WithInsecure(), WithFailover(FailoverConfig{
+			Endpoints: []FailoverEndpoint{
+				{Endpoint: primary.Addr().String()},
+				{Endpoint: backup.Addr().String()},
+			},
+		}))
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+		require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+		assert.Len(t, primary.Collect().Dump(), 1, "rejected request")
+		assert.Len(t, backup.Collect().Dump(), 1, "no failover to backup endpoint")
+
+		// The primary endpoint is unhealthy until its backoff elapses.
+		require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+		assert.Empty(t, primary.Collect().Dump())
+		assert.Len(t, backup.Collect().Dump(), 1)
+	})
+
+	t.Run("WithExportResultHandler", func(t *testing.T) {
+		rCh := make(chan otest.ExportResult, 3)
+		rCh <- otest.ExportResult{Err: status.Error(codes.Unavailable, "backend")}
+		rCh <- otest.ExportResult{Response: &colmetricpb.ExportMetricsServiceResponse{
+			PartialSuccess: &colmetricpb.ExportMetricsPartialSuccess{
+				RejectedDataPoints: 2,
+				ErrorMessage:       "partially successful",
+			},
+		}}
+		rCh <- otest.ExportResult{Err: status.Error(codes.InvalidArgument, "invalid")}
+
+		var results []ExportResult
+		exp, coll := factoryFunc(rCh,
+			WithRetry(RetryConfig{
+				Enabled:         true,
+				InitialInterval: time.Nanosecond,
+				MaxInterval:     time.Millisecond,
+				MaxElapsedTime:  time.Minute,
+			}),
+			WithExportResultHandler(func(r ExportResult) {
+				results = append(results, r)
+			}),
+		)
+		t.Cleanup(coll.Shutdown)
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+		require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+		require.Len(t, results, 1)
+		assert.Equal(t, ExportResult{
+			Disposition:      ExportPartiallyAccepted,
+			Attempts:         2,
+			Rejected:         2,
+			RejectedMessages: []string{"partially successful"},
+		}, results[0])
+
+		require.Error(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+		require.Len(t, results, 2)
+		assert.Equal(t, ExportFailed, results[1].Disposition)
+		assert.Equal(t, 1, results[1].Attempts)
+		assert.Equal(t, codes.InvalidArgument, status.Code(results[1].Err))
+	})
+
 	t.Run("WithCustomUserAgent", func(t *testing.T) {
 		key := "user-agent"
 		customerUserAgent := "custom-user-agent"
@@ -4,15 +4,20 @@
 package otlpmetricgrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/encoding"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/failover"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/oconf"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/tlsreload"
 	"go.opentelemetry.io/otel/sdk/metric"
 )
 
@@ -36,6 +41,39 @@ func asGRPCOptions(opts []Option) []oconf.GRPCOption {
 // entirely handled by the gRPC ClientConn.
 type RetryConfig retry.Config
 
+// TLSFiles are the paths to the PEM encoded files used to configure TLS.
+//
+// CAFile is the path to the CA bundle used to verify the server certificate.
+// CertFile and KeyFile are the paths to the client certificate and its
+// private key used for mTLS. They are only used if both are set.
+type TLSFiles tlsreload.Files
+
+// FailoverConfig defines the endpoints the exporter fails over between.
+type FailoverConfig struct {
+	// Endpoints are the endpoints in the order they are preferred.
+	Endpoints []FailoverEndpoint
+	// InitialBackoff is the time an endpoint is not used after it failed.
+	// It is doubled for every consecutive failure of the endpoint up to
+	// MaxBackoff. If zero, 5 seconds is used.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum time an endpoint is not used after it
+	// failed. If zero, 1 minute is used.
+	MaxBackoff time.Duration
+}
+
+// FailoverEndpoint is an endpoint the exporter fails over to.
+type FailoverEndpoint struct {
+	// Endpoint is the host and port of the endpoint. It should resemble
+	// "example.com:4317".
+	Endpoint string
+	// Weight is the relative share of the batches sent to the endpoint
+	// while it is healthy. Batches are distributed between all the healthy
+	// endpoints with a non-zero weight. Endpoints with a zero weight are
+	// backups that are only used, in the order they are configured, when
+	// no endpoint with a weight is healthy.
+	Weight int
+}
+
 type wrappedOption struct {
 	oconf.GRPCOption
 }
@@ -113,22 +151,18 @@ func WithReconnectionPeriod(rp time.Duration) Option {
 	})}
 }
 
-func compressorToCompression(compressor string) oconf.Compression {
-	if compressor == "gzip" {
-		return oconf.GzipCompression
-	}
-
-	otel.Handle(fmt.Errorf("invalid compression type: '%s', using no compression as default", compressor))
-	return oconf.NoCompression
-}
-
 // WithCompressor sets the compressor the gRPC client uses.
-// Supported compressor values: "gzip".
+// The compressor needs to be registered with google.golang.org/grpc/encoding.
+// The "gzip" compressor is registered by default, "zstd" and "snappy"
+// compressors are registered by importing
+// go.opentelemetry.io/otel/exporters/otlp/otlpcompress/zstd and
+// go.opentelemetry.io/otel/exporters/otlp/otlpcompress/snappy respectively.
+// If compressor is not registered, no compressor will be used.
 //
 // If the OTEL_EXPORTER_OTLP_COMPRESSION or
 // OTEL_EXPORTER_OTLP_METRICS_COMPRESSION environment variable is set, and
 // this option is not passed, that variable value will be used. That value can
-// be either "none" or "gzip". If both are set,
+// be "none", "gzip", "zstd", or "snappy". If both are set,
 // OTEL_EXPORTER_OTLP_METRICS_COMPRESSION will take precedence.
 //
 // By default, if an environment variable is not set, and this option is not
@@ -136,7 +170,11 @@ func compressorToCompression(compressor string) oconf.Compression {
 //
 // This option has no effect if WithGRPCConn is used.
 func WithCompressor(compressor string) Option {
-	return wrappedOption{oconf.WithCompression(compressorToCompression(compressor))}
+	if encoding.GetCompressor(compressor) == nil {
+		otel.Handle(fmt.Errorf("invalid compression type: '%s', using no compression as default", compressor))
+		return wrappedOption{oconf.WithCompression(oconf.NoCompression)}
+	}
+	return wrappedOption{oconf.WithCompressor(compressor)}
 }
 
 // WithHeaders will send the provided headers with each gRPC requests.
@@ -154,6 +192,23 @@ func WithHeaders(headers map[string]string) Option {
 	return wrappedOption{oconf.WithHeaders(headers)}
 }
 
+// HeaderProvider returns headers to send with a gRPC request. The returned
+// headers are sent in addition to, and take precedence over, the headers set
+// with WithHeaders or the OTEL_EXPORTER_OTLP_HEADERS and
+// OTEL_EXPORTER_OTLP_METRICS_HEADERS environment variables. If an error is
+// returned, the request is not sent and the export fails with the error.
+type HeaderProvider func(context.Context) (map[string]string, error)
+
+// WithHeaderProvider sets a HeaderProvider that is called before every export
+// attempt, including retries, to get the headers sent with the gRPC request.
+// This can be used to send headers whose values change over time, e.g.
+// short-lived bearer tokens.
+//
+// By default, no header provider is used.
+func WithHeaderProvider(provider HeaderProvider) Option {
+	return wrappedOption{oconf.WithHeaderProvider(provider)}
+}
+
 // WithTLSCredentials sets the gRPC connection to use creds.
 //
 // If the OTEL_EXPORTER_OTLP_CERTIFICATE or
@@ -169,10 +224,32 @@ func WithHeaders(headers map[string]string) Option {
 func WithTLSCredentials(creds credentials.TransportCredentials) Option {
 	return wrappedOption{oconf.NewGRPCOption(func(cfg oconf.Config) oconf.Config {
 		cfg.Metrics.GRPCCredentials = creds
+		cfg.Metrics.TLSFiles = tlsreload.Files{}
 		return cfg
 	})}
 }
 
+// WithTLSFiles sets the files the CA bundle, client certificate and client
+// key used for TLS are loaded from. The files are loaded when a connection to
+// the collector is established and are reloaded when their modification time
+// or size changes, so rotated certificates are used without restarting the
+// exporter. If a changed file cannot be loaded, the error is passed to the
+// global ErrorHandler and the previously loaded certificates are used.
+//
+// If the OTEL_EXPORTER_OTLP_CERTIFICATE, OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE
+// and OTEL_EXPORTER_OTLP_CLIENT_KEY environment variables, or their signal
+// specific counterparts, are set, the files they define are also reloaded
+// when changed. The files passed with this option take precedence
+// over those environment variables.
+//
+// Credentials passed with WithTLSCredentials after this option take precedence
+// over the files.
+//
+// This option has no effect if WithGRPCConn is used.
+func WithTLSFiles(files TLSFiles) Option {
+	return wrappedOption{oconf.WithTLSFiles(tlsreload.Files(files))}
+}
+
 // WithServiceConfig defines the default gRPC service config used.
 //
 // This option has no effect if WithGRPCConn is used.
@@ -231,6 +308,64 @@ func WithTimeout(duration time.Duration) Option {
 	return wrappedOption{oconf.WithTimeout(duration)}
 }
 
+// WithMaxRequestSize sets the maximum size, in bytes, of the serialized and
+// uncompressed export request message. A batch of metric data that exceeds this
+// size is split into smaller requests before it is sent. If a single data point
+// exceeds this size on its own, it is dropped and an error is returned.
+//
+// Regardless of this option, a batch is split and the smaller requests are sent
+// if the target endpoint rejects the batch with a ResourceExhausted status code
+// because the message is larger than its maximum message size.
+//
+// By default, the size of export requests is not limited.
+func WithMaxRequestSize(n int) Option {
+	return wrappedOption{oconf.WithMaxRequestSize(n)}
+}
+
+// WithFailover sets the endpoints the exporter sends batches of metric data to
+// instead of the endpoint set with WithEndpoint, WithEndpointURL, or
+// environment variables. A batch is sent to the first healthy endpoint. If
+// that endpoint cannot be reached, or rejects the batch with a status code
+// that is not retryable, it is marked as unhealthy and the batch is sent to
+// the next endpoint. An unhealthy endpoint is used again once its backoff has
+// elapsed, so the exporter returns to a preferred endpoint when it recovers.
+//
+// The headers, TLS, and other settings of the exporter are used for all the
+// endpoints. This option has no effect if WithGRPCConn is used.
+//
+// By default, the exporter does not fail over.
+func WithFailover(settings FailoverConfig) Option {
+	c := failover.Config{
+		Endpoints:      make([]failover.Endpoint, len(settings.Endpoints)),
+		InitialBackoff: settings.InitialBackoff,
+		MaxBackoff:     settings.MaxBackoff,
+	}
+	for i, e := range settings.Endpoints {
+		c.Endpoints[i] = failover.Endpoint(e)
+	}
+	return wrappedOption{oconf.WithFailover(c)}
+}
+
+// WithExportResultHandler sets a handler that is called with the result of the
+// export of every batch of metric data. The result reports how many data points
+// the endpoint rejected in partial success responses and why, how many export
+// requests were sent, and whether the batch was dropped. It can be used to
+// measure data loss or to drive a circuit breaker.
+//
+// The handler is called synchronously once the export of a batch, including its
+// retries and split requests, has completed. It needs to return promptly to not
+// block the exporter. Partial success responses are still reported to the
+// global error handler.
+//
+// By default, no handler is called.
+func WithExportResultHandler(h ExportResultHandler) Option {
+	var fn func(internal.ExportResult)
+	if h != nil {
+		fn = func(r internal.ExportResult) { h(newExportResult(r)) }
+	}
+	return wrappedOption{oconf.WithResultHandler(fn)}
+}
+
 // WithRetry sets the retry policy for transient retryable errors that are
 // returned by the target endpoint.
 //
@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 
@@ -331,7 +332,7 @@ func (c *HTTPCollector) record(r *http.Request) ExportResult {
 
 func (c *HTTPCollector) readBody(r *http.Request) (body []byte, err error) {
 	var reader io.ReadCloser
-	switch r.Header.Get("Content-Encoding") {
+	switch name := r.Header.Get("Content-Encoding"); name {
 	case "gzip":
 		reader, err = gzip.NewReader(r.Body)
 		if err != nil {
@@ -341,8 +342,28 @@ func (c *HTTPCollector) readBody(r *http.Request) (body []byte, err error) {
 				Status: http.StatusInternalServerError,
 			}
 		}
-	default:
+	case "":
 		reader = r.Body
+	default:
+		comp := encoding.GetCompressor(name)
+		if comp == nil {
+			return nil, &HTTPResponseError{
+				Err:    fmt.Errorf("unsupported content-encoding: %s", name),
+				Status: http.StatusUnsupportedMediaType,
+			}
+		}
+		var dr io.Reader
+		dr, err = comp.Decompress(r.Body)
+		if err != nil {
+			return nil, &HTTPResponseError{
+				Err:    err,
+				Status: http.StatusInternalServerError,
+			}
+		}
+		reader = struct {
+			io.Reader
+			io.Closer
+		}{dr, r.Body}
 	}
 
 	defer func() {
@@ -0,0 +1,160 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/otlpmetric/split.go.tmpl
+
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal"
+
+import (
+	"fmt"
+
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+// RequestSizeError returns an error describing a request of size bytes that
+// cannot be split to fit in the maximum request size of limit bytes.
+func RequestSizeError(size, limit int) error {
+	return fmt.Errorf("request size of %d bytes exceeds the maximum request size of %d bytes", size, limit)
+}
+
+// SplitResourceMetrics splits rm into two batches with about the same number
+// of items. The scopes of rm are split first. If rm contains a single scope,
+// its metrics are split and, if it contains a single metric, the data points
+// of that metric are split.
+//
+// The returned batches share the scopes, metrics and data points of rm.
+// False is returned if rm contains only a single data point and cannot be
+// split.
+func SplitResourceMetrics(rm *metricpb.ResourceMetrics) (*metricpb.ResourceMetrics, *metricpb.ResourceMetrics, bool) {
+	if rm == nil {
+		return nil, nil, false
+	}
+	if a, b, ok := halve(rm.ScopeMetrics); ok {
+		return withScopeMetrics(rm, a), withScopeMetrics(rm, b), true
+	}
+	if len(rm.ScopeMetrics) == 0 {
+		return nil, nil, false
+	}
+
+	sm := rm.ScopeMetrics[0]
+	if a, b, ok := halve(sm.Metrics); ok {
+		return withScopeMetrics(rm, []*metricpb.ScopeMetrics{withMetrics(sm, a)}),
+			withScopeMetrics(rm, []*metricpb.ScopeMetrics{withMetrics(sm, b)}),
+			true
+	}
+	if len(sm.Metrics) == 0 {
+		return nil, nil, false
+	}
+
+	if a, b, ok := splitMetric(sm.Metrics[0]); ok {
+		return withScopeMetrics(rm, []*metricpb.ScopeMetrics{withMetrics(sm, []*metricpb.Metric{a})}),
+			withScopeMetrics(rm, []*metricpb.ScopeMetrics{withMetrics(sm, []*metricpb.Metric{b})}),
+			true
+	}
+	return nil, nil, false
+}
+
+func withScopeMetrics(rm *metricpb.ResourceMetrics, sm []*metricpb.ScopeMetrics) *metricpb.ResourceMetrics {
+	return &metricpb.ResourceMetrics{
+		Resource:     rm.Resource,
+		ScopeMetrics: sm,
+		SchemaUrl:    rm.SchemaUrl,
+	}
+}
+
+func withMetrics(sm *metricpb.ScopeMetrics, m []*metricpb.Metric) *metricpb.ScopeMetrics {
+	return &metricpb.ScopeMetrics{
+		Scope:     sm.Scope,
+		Metrics:   m,
+		SchemaUrl: sm.SchemaUrl,
+	}
+}
+
+// splitMetric splits the data points of m into two metrics with the same
+// name, description, unit, metadata and aggregation.
+func splitMetric(m *metricpb.Metric) (*metricpb.Metric, *metricpb.Metric, bool) {
+	a := &metricpb.Metric{
+		Name:        m.Name,
+		Description: m.Description,
+		Unit:        m.Unit,
+		Metadata:    m.Metadata,
+	}
+	b := &metricpb.Metric{
+		Name:        m.Name,
+		Description: m.Description,
+		Unit:        m.Unit,
+		Metadata:    m.Metadata,
+	}
+
+	switch d := m.Data.(type) {
+	case *metricpb.Metric_Gauge:
+		dpA, dpB, ok := halve(d.Gauge.GetDataPoints())
+		if !ok {
+			return nil, nil, false
+		}
+		a.Data = &metricpb.Metric_Gauge{Gauge: &metricpb.Gauge{DataPoints: dpA}}
+		b.Data = &metricpb.Metric_Gauge{Gauge: &metricpb.Gauge{DataPoints: dpB}}
+	case *metricpb.Metric_Sum:
+		dpA, dpB, ok := halve(d.Sum.GetDataPoints())
+		if !ok {
+			return nil, nil, false
+		}
+		a.Data = &metricpb.Metric_Sum{Sum: &metricpb.Sum{
+			DataPoints:             dpA,
+			AggregationTemporality: d.Sum.AggregationTemporality,
+			IsMonotonic:            d.Sum.IsMonotonic,
+		}}
+		b.Data = &metricpb.Metric_Sum{Sum: &metricpb.Sum{
+			DataPoints:             dpB,
+			AggregationTemporality: d.Sum.AggregationTemporality,
+			IsMonotonic:            d.Sum.IsMonotonic,
+		}}
+	case *metricpb.Metric_Histogram:
+		dpA, dpB, ok := halve(d.Histogram.GetDataPoints())
+		if !ok {
+			return nil, nil, false
+		}
+		a.Data = &metricpb.Metric_Histogram{Histogram: &metricpb.Histogram{
+			DataPoints:             dpA,
+			AggregationTemporality: d.Histogram.AggregationTemporality,
+		}}
+		b.Data = &metricpb.Metric_Histogram{Histogram: &metricpb.Histogram{
+			DataPoints:             dpB,
+			AggregationTemporality: d.Histogram.AggregationTemporality,
+		}}
+	case *metricpb.Metric_ExponentialHistogram:
+		dpA, dpB, ok := halve(d.ExponentialHistogram.GetDataPoints())
+		if !ok {
+			return nil, nil, false
+		}
+		a.Data = &metricpb.Metric_ExponentialHistogram{ExponentialHistogram: &metricpb.ExponentialHistogram{
+			DataPoints:             dpA,
+			AggregationTemporality: d.ExponentialHistogram.AggregationTemporality,
+		}}
+		b.Data = &metricpb.Metric_ExponentialHistogram{ExponentialHistogram: &metricpb.ExponentialHistogram{
+			DataPoints:             dpB,
+			AggregationTemporality: d.ExponentialHistogram.AggregationTemporality,
+		}}
+	case *metricpb.Metric_Summary:
+		dpA, dpB, ok := halve(d.Summary.GetDataPoints())
+		if !ok {
+			return nil, nil, false
+		}
+		a.Data = &metricpb.Metric_Summary{Summary: &metricpb.Summary{DataPoints: dpA}}
+		b.Data = &metricpb.Metric_Summary{Summary: &metricpb.Summary{DataPoints: dpB}}
+	default:
+		return nil, nil, false
+	}
+	return a, b, true
+}
+
+// halve splits s into two halves. False is returned if s contains less than
+// two elements.
+func halve[T any](s []T) ([]T, []T, bool) {
+	if len(s) < 2 {
+		return nil, nil, false
+	}
+	n := len(s) / 2
+	return s[:n:n], s[n:], true
+}
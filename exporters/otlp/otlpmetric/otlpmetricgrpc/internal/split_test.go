@@ -0,0 +1,206 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/otlpmetric/split_test.go.tmpl
+
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+var (
+	res   = &resourcepb.Resource{DroppedAttributesCount: 1}
+	scope = &commonpb.InstrumentationScope{Name: "scope"}
+
+	numDP = []*metricpb.NumberDataPoint{
+		{Value: &metricpb.NumberDataPoint_AsInt{AsInt: 0}},
+		{Value: &metricpb.NumberDataPoint_AsInt{AsInt: 1}},
+		{Value: &metricpb.NumberDataPoint_AsInt{AsInt: 2}},
+	}
+	histDP = []*metricpb.HistogramDataPoint{
+		{Count: 0},
+		{Count: 1},
+	}
+	expHistDP = []*metricpb.ExponentialHistogramDataPoint{
+		{Count: 0},
+		{Count: 1},
+	}
+	summaryDP = []*metricpb.SummaryDataPoint{
+		{Count: 0},
+		{Count: 1},
+	}
+
+	cumulative = metricpb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE
+)
+
+func rm(m ...*metricpb.Metric) *metricpb.ResourceMetrics {
+	sm := &metricpb.ScopeMetrics{Scope: scope, Metrics: m, SchemaUrl: "s"}
+	return &metricpb.ResourceMetrics{
+		Resource:     res,
+		ScopeMetrics: []*metricpb.ScopeMetrics{sm},
+		SchemaUrl:    "r",
+	}
+}
+
+func metric(data any) *metricpb.Metric {
+	m := &metricpb.Metric{Name: "m", Description: "d", Unit: "1"}
+	switch d := data.(type) {
+	case *metricpb.Gauge:
+		m.Data = &metricpb.Metric_Gauge{Gauge: d}
+	case *metricpb.Sum:
+		m.Data = &metricpb.Metric_Sum{Sum: d}
+	case *metricpb.Histogram:
+		m.Data = &metricpb.Metric_Histogram{Histogram: d}
+	case *metricpb.ExponentialHistogram:
+		m.Data = &metricpb.Metric_ExponentialHistogram{ExponentialHistogram: d}
+	case *metricpb.Summary:
+		m.Data = &metricpb.Metric_Summary{Summary: d}
+	}
+	return m
+}
+
+func TestSplitResourceMetrics(t *testing.T) {
+	m0, m1 := &metricpb.Metric{Name: "0"}, &metricpb.Metric{Name: "1"}
+	sm0 := &metricpb.ScopeMetrics{Scope: scope, Metrics: []*metricpb.Metric{m0}}
+	sm1 := &metricpb.ScopeMetrics{Scope: scope, Metrics: []*metricpb.Metric{m1}}
+
+	testCases := []struct {
+		name string
+		rm   *metricpb.ResourceMetrics
+		a, b *metricpb.ResourceMetrics
+		ok   bool
+	}{
+		{
+			name: "Nil",
+		},
+		{
+			name: "NoScopes",
+			rm:   &metricpb.ResourceMetrics{Resource: res},
+		},
+		{
+			name: "NoMetrics",
+			rm:   rm(),
+		},
+		{
+			name: "SingleDataPoint",
+			rm:   rm(metric(&metricpb.Gauge{DataPoints: numDP[:1]})),
+		},
+		{
+			name: "NoData",
+			rm:   rm(m0),
+		},
+		{
+			name: "Scopes",
+			rm: &metricpb.ResourceMetrics{
+				Resource:     res,
+				ScopeMetrics: []*metricpb.ScopeMetrics{sm0, sm1},
+				SchemaUrl:    "r",
+			},
+			a: &metricpb.ResourceMetrics{
+				Resource:     res,
+				ScopeMetrics: []*metricpb.ScopeMetrics{sm0},
+				SchemaUrl:    "r",
+			},
+			b: &metricpb.ResourceMetrics{
+				Resource:     res,
+				ScopeMetrics: []*metricpb.ScopeMetrics{sm1},
+				SchemaUrl:    "r",
+			},
+			ok: true,
+		},
+		{
+			name: "Metrics",
+			rm:   rm(m0, m1),
+			a:    rm(m0),
+			b:    rm(m1),
+			ok:   true,
+		},
+		{
+			name: "Gauge",
+			rm:   rm(metric(&metricpb.Gauge{DataPoints: numDP})),
+			a:    rm(metric(&metricpb.Gauge{DataPoints: numDP[:1]})),
+			b:    rm(metric(&metricpb.Gauge{DataPoints: numDP[1:]})),
+			ok:   true,
+		},
+		{
+			name: "Sum",
+			rm: rm(metric(&metricpb.Sum{
+				DataPoints:             numDP,
+				AggregationTemporality: cumulative,
+				IsMonotonic:            true,
+			})),
+			a: rm(metric(&metricpb.Sum{
+				DataPoints:             numDP[:1],
+				AggregationTemporality: cumulative,
+				IsMonotonic:            true,
+			})),
+			b: rm(metric(&metricpb.Sum{
+				DataPoints:             numDP[1:],
+				AggregationTemporality: cumulative,
+				IsMonotonic:            true,
+			})),
+			ok: true,
+		},
+		{
+			name: "Histogram",
+			rm: rm(metric(&metricpb.Histogram{
+				DataPoints:             histDP,
+				AggregationTemporality: cumulative,
+			})),
+			a: rm(metric(&metricpb.Histogram{
+				DataPoints:             histDP[:1],
+				AggregationTemporality: cumulative,
+			})),
+			b: rm(metric(&metricpb.Histogram{
+				DataPoints:             histDP[1:],
+				AggregationTemporality: cumulative,
+			})),
+			ok: true,
+		},
+		{
+			name: "ExponentialHistogram",
+			rm: rm(metric(&metricpb.ExponentialHistogram{
+				DataPoints:             expHistDP,
+				AggregationTemporality: cumulative,
+			})),
+			a: rm(metric(&metricpb.ExponentialHistogram{
+				DataPoints:             expHistDP[:1],
+				AggregationTemporality: cumulative,
+			})),
+			b: rm(metric(&metricpb.ExponentialHistogram{
+				DataPoints:             expHistDP[1:],
+				AggregationTemporality: cumulative,
+			})),
+			ok: true,
+		},
+		{
+			name: "Summary",
+			rm:   rm(metric(&metricpb.Summary{DataPoints: summaryDP})),
+			a:    rm(metric(&metricpb.Summary{DataPoints: summaryDP[:1]})),
+			b:    rm(metric(&metricpb.Summary{DataPoints: summaryDP[1:]})),
+			ok:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, b, ok := SplitResourceMetrics(tc.rm)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.a, a)
+			assert.Equal(t, tc.b, b)
+		})
+	}
+}
+
+func TestRequestSizeError(t *testing.T) {
+	err := RequestSizeError(10, 5)
+	assert.EqualError(t, err, "request size of 10 bytes exceeds the maximum request size of 5 bytes")
+}
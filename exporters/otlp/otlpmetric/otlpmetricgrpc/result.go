@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpmetricgrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal"
+)
+
+// ExportResult is the result of the export of a batch of metric data.
+type ExportResult struct {
+	// Disposition is the final disposition of the batch.
+	Disposition ExportDisposition
+	// Attempts is the number of export requests sent for the batch. It
+	// includes retries, requests sent to failover endpoints, and the
+	// requests the batch is split into.
+	Attempts int
+	// Rejected is the number of data points the endpoint rejected in its partial
+	// success responses.
+	Rejected int64
+	// RejectedMessages are the error messages the endpoint sent with its
+	// partial success responses. These may be warnings that do not reject
+	// anything.
+	RejectedMessages []string
+	// Err is the error the export failed with. It is only set if the
+	// Disposition is ExportFailed.
+	Err error
+}
+
+// ExportDisposition is the final disposition of an exported batch.
+type ExportDisposition int
+
+const (
+	// ExportAccepted is the disposition of a batch the endpoint accepted in
+	// full.
+	ExportAccepted ExportDisposition = iota
+	// ExportPartiallyAccepted is the disposition of a batch the endpoint
+	// accepted while rejecting some of its data points.
+	ExportPartiallyAccepted
+	// ExportFailed is the disposition of a batch that, at least in part,
+	// could not be exported and was dropped.
+	ExportFailed
+)
+
+// String returns the name of d.
+func (d ExportDisposition) String() string {
+	switch d {
+	case ExportAccepted:
+		return "accepted"
+	case ExportPartiallyAccepted:
+		return "partially accepted"
+	case ExportFailed:
+		return "failed"
+	}
+	return fmt.Sprintf("ExportDisposition(%d)", int(d))
+}
+
+// ExportResultHandler handles the result of the export of a batch of
+// metric data.
+type ExportResultHandler func(ExportResult)
+
+// newExportResult returns the ExportResult for the outcome r.
+func newExportResult(r internal.ExportResult) ExportResult {
+	res := ExportResult{
+		Disposition:      ExportAccepted,
+		Attempts:         r.Attempts,
+		Rejected:         r.Rejected,
+		RejectedMessages: r.RejectedMessages,
+		Err:              r.Err,
+	}
+	switch {
+	case r.Err != nil:
+		res.Disposition = ExportFailed
+	case r.Rejected > 0:
+		res.Disposition = ExportPartiallyAccepted
+	}
+	return res
+}
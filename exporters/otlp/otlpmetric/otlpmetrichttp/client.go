@@ -18,22 +18,31 @@ import (
 	"sync"
 	"time"
 
+	"google.golang.org/grpc/encoding"
 	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/failover"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/oconf"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/otlpjson"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/tlsreload"
 	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
 	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
 )
 
 type client struct {
 	// req is cloned for every upload the client makes.
-	req         *http.Request
-	compression Compression
-	requestFunc retry.RequestFunc
-	httpClient  *http.Client
+	req            *http.Request
+	compressor     string
+	encoding       Encoding
+	headerFn       func(*http.Request, []byte) (map[string]string, error)
+	requestFunc    retry.RequestFunc
+	maxRequestSize int
+	failover       *failover.Set
+	resultHandler  func(internal.ExportResult)
+	httpClient     *http.Client
 }
 
 // Keep it in sync with golang's DefaultTransport from net/http! We
@@ -60,12 +69,22 @@ func newClient(cfg oconf.Config) (*client, error) {
 		Timeout:   cfg.Metrics.Timeout,
 	}
 
-	if cfg.Metrics.TLSCfg != nil || cfg.Metrics.Proxy != nil {
+	tlsCfg := cfg.Metrics.TLSCfg
+	if !cfg.Metrics.TLSFiles.Empty() {
+		serverName := tlsreload.Host(cfg.Metrics.Endpoint)
+		if len(cfg.Metrics.Failover.Endpoints) > 0 {
+			// Verify the server certificate for the endpoint connected to.
+			serverName = ""
+		}
+		tlsCfg = tlsreload.Config(tlsCfg, serverName, cfg.Metrics.TLSFiles)
+	}
+
+	if tlsCfg != nil || cfg.Metrics.Proxy != nil {
 		clonedTransport := ourTransport.Clone()
 		httpClient.Transport = clonedTransport
 
-		if cfg.Metrics.TLSCfg != nil {
-			clonedTransport.TLSClientConfig = cfg.Metrics.TLSCfg
+		if tlsCfg != nil {
+			clonedTransport.TLSClientConfig = tlsCfg
 		}
 		if cfg.Metrics.Proxy != nil {
 			clonedTransport.Proxy = cfg.Metrics.Proxy
@@ -94,13 +113,23 @@ func newClient(cfg oconf.Config) (*client, error) {
 			req.Header.Set(k, v)
 		}
 	}
-	req.Header.Set("Content-Type", "application/x-protobuf")
+	enc := Encoding(cfg.Metrics.Encoding)
+	contentType := contentTypeProto
+	if enc == JSONEncoding {
+		contentType = contentTypeJSON
+	}
+	req.Header.Set("Content-Type", contentType)
 
 	return &client{
-		compression: Compression(cfg.Metrics.Compression),
-		req:         req,
-		requestFunc: cfg.RetryConfig.RequestFunc(evaluate),
-		httpClient:  httpClient,
+		compressor:     cfg.Metrics.CompressorName(),
+		encoding:       enc,
+		headerFn:       cfg.Metrics.HTTPHeaderProvider,
+		req:            req,
+		requestFunc:    cfg.RetryConfig.RequestFunc(evaluate),
+		httpClient:     httpClient,
+		maxRequestSize: cfg.Metrics.MaxRequestSize,
+		failover:       failover.NewSet(cfg.Metrics.Failover),
+		resultHandler:  cfg.Metrics.ResultHandler,
 	}, nil
 }
 
@@ -124,19 +153,37 @@ func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.Resou
 	// ensures this is not called after the Exporter is shutdown. Only thing
 	// to do here is send data.
 
+	res := new(internal.ExportResult)
+	err := c.upload(ctx, protoMetrics, res)
+	if c.resultHandler != nil {
+		res.Err = err
+		c.resultHandler(*res)
+	}
+	return err
+}
+
+// upload sends protoMetrics in a single request. If the request exceeds the
+// maximum request size or is rejected by the server as too large,
+// protoMetrics are split and sent in multiple requests. The outcome of the
+// requests is recorded in res.
+func (c *client) upload(ctx context.Context, protoMetrics *metricpb.ResourceMetrics, res *internal.ExportResult) error {
 	pbRequest := &colmetricpb.ExportMetricsServiceRequest{
 		ResourceMetrics: []*metricpb.ResourceMetrics{protoMetrics},
 	}
-	body, err := proto.Marshal(pbRequest)
+	body, err := c.marshal(pbRequest)
 	if err != nil {
 		return err
 	}
+	if limit := c.maxRequestSize; limit > 0 && len(body) > limit {
+		return c.uploadSplit(ctx, protoMetrics, res, internal.RequestSizeError(len(body), limit))
+	}
 	request, err := c.newRequest(ctx, body)
 	if err != nil {
 		return err
 	}
+	header := request.Header
 
-	return c.requestFunc(ctx, func(iCtx context.Context) error {
+	send := func(iCtx context.Context) error {
 		select {
 		case <-iCtx.Done():
 			return iCtx.Err()
@@ -144,6 +191,16 @@ func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.Resou
 		}
 
 		request.reset(iCtx)
+		if c.headerFn != nil {
+			// Do not pass headers provided for a previous attempt.
+			request.Header = header
+			h, err := c.providedHeader(request, header)
+			if err != nil {
+				return err
+			}
+			request.Header = h
+		}
+		res.Attempt()
 		resp, err := c.httpClient.Do(request.Request)
 		var urlErr *url.Error
 		if errors.As(err, &urlErr) && urlErr.Temporary() {
@@ -167,9 +224,16 @@ func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.Resou
 				return nil
 			}
 
-			if resp.Header.Get("Content-Type") == "application/x-protobuf" {
+			var unmarshal func([]byte, proto.Message) error
+			switch resp.Header.Get("Content-Type") {
+			case contentTypeProto:
+				unmarshal = proto.Unmarshal
+			case contentTypeJSON:
+				unmarshal = otlpjson.Unmarshal
+			}
+			if unmarshal != nil {
 				var respProto colmetricpb.ExportMetricsServiceResponse
-				if err := proto.Unmarshal(respData.Bytes(), &respProto); err != nil {
+				if err := unmarshal(respData.Bytes(), &respProto); err != nil {
 					return err
 				}
 
@@ -177,6 +241,7 @@ func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.Resou
 					msg := respProto.PartialSuccess.GetErrorMessage()
 					n := respProto.PartialSuccess.GetRejectedDataPoints()
 					if n != 0 || msg != "" {
+						res.PartialSuccess(n, msg)
 						err := internal.MetricPartialSuccessError(n, msg)
 						otel.Handle(err)
 					}
@@ -207,15 +272,43 @@ func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.Resou
 				e := errors.New(respStr)
 				rErr = newResponseError(resp.Header, e)
 			}
+		case sc == http.StatusRequestEntityTooLarge:
+			// The request is split and sent again by upload.
+			rErr = tooLargeError{err: fmt.Errorf("failed to send metrics to %s: %s", request.URL, resp.Status)}
 		default:
-			rErr = fmt.Errorf("failed to send metrics to %s: %s", request.URL, resp.Status)
+			rErr = statusError{err: fmt.Errorf("failed to send metrics to %s: %s", request.URL, resp.Status)}
 		}
 
 		if err := resp.Body.Close(); err != nil {
 			return err
 		}
 		return rErr
+	}
+	err = c.requestFunc(ctx, func(iCtx context.Context) error {
+		if c.failover == nil {
+			return send(iCtx)
+		}
+		return c.failover.Do(iCtx, func(endpoint string) error {
+			u := *request.URL
+			u.Host = endpoint
+			request.URL, request.Host = &u, endpoint
+			return send(iCtx)
+		}, shouldFailover)
 	})
+	if errors.As(err, new(tooLargeError)) {
+		return c.uploadSplit(ctx, protoMetrics, res, err)
+	}
+	return err
+}
+
+// uploadSplit splits protoMetrics in two and uploads both halves. If
+// protoMetrics cannot be split, err is returned.
+func (c *client) uploadSplit(ctx context.Context, protoMetrics *metricpb.ResourceMetrics, res *internal.ExportResult, err error) error {
+	a, b, ok := internal.SplitResourceMetrics(protoMetrics)
+	if !ok {
+		return err
+	}
+	return errors.Join(c.upload(ctx, a, res), c.upload(ctx, b, res))
 }
 
 var gzPool = sync.Pool{
@@ -225,15 +318,38 @@ var gzPool = sync.Pool{
 	},
 }
 
+// providedHeader returns a copy of header updated with the headers returned
+// by the configured header provider for r.
+func (c *client) providedHeader(r request, header http.Header) (http.Header, error) {
+	headers, err := c.headerFn(r.Request, r.body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get headers: %w", err)
+	}
+	h := header.Clone()
+	for k, v := range headers {
+		h.Set(k, v)
+	}
+	return h, nil
+}
+
+// marshal returns the encoding of m with the configured encoding.
+func (c *client) marshal(m proto.Message) ([]byte, error) {
+	if c.encoding == JSONEncoding {
+		return otlpjson.Marshal(m)
+	}
+	return proto.Marshal(m)
+}
+
 func (c *client) newRequest(ctx context.Context, body []byte) (request, error) {
 	r := c.req.Clone(ctx)
 	req := request{Request: r}
 
-	switch c.compression {
-	case NoCompression:
+	switch c.compressor {
+	case "":
 		r.ContentLength = (int64)(len(body))
+		req.body = body
 		req.bodyReader = bodyReader(body)
-	case GzipCompression:
+	case "gzip":
 		// Ensure the content length is not used.
 		r.ContentLength = -1
 		r.Header.Set("Content-Encoding", "gzip")
@@ -252,12 +368,47 @@ func (c *client) newRequest(ctx context.Context, body []byte) (request, error) {
 			return req, err
 		}
 
-		req.bodyReader = bodyReader(b.Bytes())
+		req.body = b.Bytes()
+		req.bodyReader = bodyReader(req.body)
+	default:
+		b, err := compress(c.compressor, body)
+		if err != nil {
+			return req, err
+		}
+
+		// Ensure the content length is not used.
+		r.ContentLength = -1
+		r.Header.Set("Content-Encoding", c.compressor)
+		req.body = b
+		req.bodyReader = bodyReader(b)
 	}
 
 	return req, nil
 }
 
+// compress returns body compressed with the compressor registered with
+// google.golang.org/grpc/encoding under name.
+func compress(name string, body []byte) ([]byte, error) {
+	comp := encoding.GetCompressor(name)
+	if comp == nil {
+		return nil, fmt.Errorf("compressor not registered: %s", name)
+	}
+
+	var b bytes.Buffer
+	w, err := comp.Compress(&b)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(body); err != nil {
+		return nil, err
+	}
+	// Close needs to be called to ensure body is fully written.
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
 // bodyReader returns a closure returning a new reader for buf.
 func bodyReader(buf []byte) func() io.ReadCloser {
 	return func() io.ReadCloser {
@@ -269,6 +420,8 @@ func bodyReader(buf []byte) func() io.ReadCloser {
 type request struct {
 	*http.Request
 
+	// body is the content of the request Body.
+	body []byte
 	// bodyReader allows the same body to be used for multiple requests.
 	bodyReader func() io.ReadCloser
 }
@@ -326,6 +479,45 @@ func (e retryableError) As(target interface{}) bool {
 	}
 }
 
+// statusError represents a request rejected by the server with a status
+// code that is not retryable.
+type statusError struct {
+	err error
+}
+
+func (e statusError) Error() string {
+	return e.err.Error()
+}
+
+func (e statusError) Unwrap() error {
+	return e.err
+}
+
+// shouldFailover returns if err identifies a request that should be sent to
+// the next failover endpoint: the endpoint could not be connected to or it
+// rejected the request with a status code that is not retryable.
+func shouldFailover(err error) bool {
+	if errors.As(err, new(statusError)) {
+		return true
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// tooLargeError represents a request rejected by the server because it is
+// too large.
+type tooLargeError struct {
+	err error
+}
+
+func (e tooLargeError) Error() string {
+	return e.err.Error()
+}
+
+func (e tooLargeError) Unwrap() error {
+	return e.err
+}
+
 // evaluate returns if err is retry-able. If it is and it includes an explicit
 // throttling delay, that delay is also returned.
 func evaluate(err error) (bool, time.Duration) {
@@ -4,26 +4,59 @@
 package otlpmetrichttp
 
 import (
+	"compress/flate"
 	"context"
 	"crypto/tls"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/oconf"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/otest"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/otlpjson"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
 	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
 )
 
+// testCompressorName is the name of the compressor registered to test custom
+// compression.
+const testCompressorName = "otlp-test-deflate"
+
+func init() {
+	encoding.RegisterCompressor(deflateCompressor{})
+}
+
+// deflateCompressor is a custom compressor using the DEFLATE format.
+type deflateCompressor struct{}
+
+func (deflateCompressor) Name() string { return testCompressorName }
+
+func (deflateCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return flate.NewWriter(w, flate.DefaultCompression)
+}
+
+func (deflateCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	return flate.NewReader(r), nil
+}
+
 type clientShim struct {
 	*client
 }
@@ -154,6 +187,78 @@ func TestConfig(t *testing.T) {
 		assert.Len(t, coll.Collect().Dump(), 1)
 	})
 
+	t.Run("WithCompressor", func(t *testing.T) {
+		exp, coll := factoryFunc("", nil, WithCompressor(testCompressorName))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		assert.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+		assert.Len(t, coll.Collect().Dump(), 1)
+	})
+
+	t.Run("WithUnregisteredCompressor", func(t *testing.T) {
+		exp, coll := factoryFunc("", nil, WithCompressor("unregistered"))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		// Payloads are sent uncompressed.
+		assert.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+		assert.Len(t, coll.Collect().Dump(), 1)
+	})
+
+	t.Run("WithHeaderProvider", func(t *testing.T) {
+		rCh := make(chan otest.ExportResult, 2)
+		rCh <- otest.ExportResult{Err: &otest.HTTPResponseError{
+			Status: http.StatusServiceUnavailable,
+			Err:    errors.New(""),
+		}}
+		rCh <- otest.ExportResult{}
+
+		key := http.CanonicalHeaderKey("authorization")
+		var calls int
+		provider := func(r *http.Request, body []byte) (map[string]string, error) {
+			calls++
+			assert.Equal(t, "static", r.Header.Get(key), "provided headers passed to provider")
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/v1/metrics", r.URL.Path)
+			assert.NotEmpty(t, body, "request body not passed to provider")
+			return map[string]string{key: fmt.Sprintf("Bearer token-%d", calls)}, nil
+		}
+		exp, coll := factoryFunc("", rCh,
+			WithHeaders(map[string]string{key: "static"}),
+			WithHeaderProvider(provider),
+			WithRetry(RetryConfig{
+				Enabled:         true,
+				InitialInterval: time.Nanosecond,
+				MaxInterval:     time.Millisecond,
+				MaxElapsedTime:  time.Minute,
+			}),
+		)
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		// Push this after Shutdown so the HTTP server doesn't hang.
+		t.Cleanup(func() { close(rCh) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+
+		assert.Equal(t, 2, calls, "header provider not called for every attempt")
+		got := coll.Headers()
+		assert.Equal(t, []string{"Bearer token-1", "Bearer token-2"}, got[key])
+	})
+
+	t.Run("WithHeaderProviderError", func(t *testing.T) {
+		provider := func(*http.Request, []byte) (map[string]string, error) {
+			return nil, errors.New("token unavailable")
+		}
+		exp, coll := factoryFunc("", nil, WithHeaderProvider(provider))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		err := exp.Export(ctx, &metricdata.ResourceMetrics{})
+		assert.ErrorContains(t, err, "token unavailable")
+		assert.Empty(t, coll.Collect().Dump())
+	})
+
 	t.Run("WithRetry", func(t *testing.T) {
 		emptyErr := errors.New("")
 		rCh := make(chan otest.ExportResult, 5)
@@ -216,6 +321,134 @@ func TestConfig(t *testing.T) {
 		assert.ErrorIs(t, err, *retryErr)
 	})
 
+	gauges := func(attrs attribute.Set, names ...string) *metricdata.ResourceMetrics {
+		m := make([]metricdata.Metrics, len(names))
+		for i, n := range names {
+			m[i] = metricdata.Metrics{
+				Name: n,
+				Data: metricdata.Gauge[int64]{
+					DataPoints: []metricdata.DataPoint[int64]{
+						{Attributes: attrs, Value: int64(i)},
+					},
+				},
+			}
+		}
+		return &metricdata.ResourceMetrics{
+			ScopeMetrics: []metricdata.ScopeMetrics{{Metrics: m}},
+		}
+	}
+
+	t.Run("SplitRequestTooLarge", func(t *testing.T) {
+		rCh := make(chan otest.ExportResult, 3)
+		rCh <- otest.ExportResult{Err: &otest.HTTPResponseError{
+			Status: http.StatusRequestEntityTooLarge,
+			Err:    errors.New("request too large"),
+		}}
+		rCh <- otest.ExportResult{}
+		rCh <- otest.ExportResult{}
+		exp, coll := factoryFunc("", rCh)
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		require.NoError(t, exp.Export(ctx, gauges(*attribute.EmptySet(), "0", "1", "2")))
+
+		got := coll.Collect().Dump()
+		require.Len(t, got, 3, "rejected request and its two halves")
+		assert.Len(t, got[1].ScopeMetrics[0].Metrics, 1)
+		assert.Len(t, got[2].ScopeMetrics[0].Metrics, 2)
+	})
+
+	t.Run("WithMaxRequestSize", func(t *testing.T) {
+		exp, coll := factoryFunc("", nil, WithMaxRequestSize(512))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+		rm := gauges(*attribute.EmptySet(), "0", "1", "2")
+		large := attribute.NewSet(attribute.String("large", strings.Repeat("x", 1024)))
+		rm.ScopeMetrics[0].Metrics[0] = gauges(large, "0").ScopeMetrics[0].Metrics[0]
+		err := exp.Export(ctx, rm)
+		assert.ErrorContains(t, err, "exceeds the maximum request size of 512 bytes")
+
+		got := coll.Collect().Dump()
+		require.Len(t, got, 1, "metrics within the maximum request size not batched")
+		m := got[0].ScopeMetrics[0].Metrics
+		require.Len(t, m, 2)
+		assert.Equal(t, "1", m[0].Name)
+		assert.Equal(t, "2", m[1].Name)
+	})
+
+	t.Run("WithFailover", func(t *testing.T) {
+		ctx := context.Background()
+		primary, err := otest.NewHTTPCollector("", nil)
+		require.NoError(t, err)
+		backup, err := otest.NewHTTPCollector("", nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, backup.Shutdown(ctx)) })
+
+		exp, err := New(ctx, WithInsecure(), WithFailover(FailoverConfig{
+			Endpoints: []FailoverEndpoint{
+				{Endpoint: primary.Addr().String()},
+				{Endpoint: backup.Addr().String()},
+			},
+			InitialBackoff: 10 * time.Millisecond,
+			MaxBackoff:     10 * time.Millisecond,
+		}))
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+		require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+		assert.Len(t, primary.Collect().Dump(), 1, "primary endpoint not used")
+		assert.Empty(t, backup.Collect().Dump())
+
+		require.NoError(t, primary.Shutdown(ctx))
+		require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+		assert.Len(t, backup.Collect().Dump(), 1, "no failover to backup endpoint")
+
+		// Restart the primary endpoint and wait for the exporter to return
+		// to it.
+		primary, err = otest.NewHTTPCollector("http://"+primary.Addr().String(), nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, primary.Shutdown(ctx)) })
+		assert.Eventually(t, func() bool {
+			require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+			return len(primary.Collect().Dump()) > 0
+		}, 10*time.Second, 50*time.Millisecond, "no return to recovered primary endpoint")
+	})
+
+	t.Run("WithFailoverNonRetryable", func(t *testing.T) {
+		ctx := context.Background()
+		rCh := make(chan otest.ExportResult, 1)
+		rCh <- otest.ExportResult{Err: &otest.HTTPResponseError{
+			Status: http.StatusBadRequest,
+			Err:    errors.New("invalid"),
+		}}
+		primary, err := otest.NewHTTPCollector("", rCh)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, primary.Shutdown(ctx)) })
+		backup, err := otest.NewHTTPCollector("", nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, backup.Shutdown(ctx)) })
+
+		exp, err := New(ctx, WithInsecure(), WithFailover(FailoverConfig{
+			Endpoints: []FailoverEndpoint{
+				{Endpoint: primary.Addr().String()},
+				{Endpoint: backup.Addr().String()},
+			},
+		}))
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+		require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+		assert.Len(t, primary.Collect().Dump(), 1, "rejected request")
+		assert.Len(t, backup.Collect().Dump(), 1, "no failover to backup endpoint")
+
+		// The primary endpoint is unhealthy until its backoff elapses.
+		require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+		assert.Empty(t, primary.Collect().Dump())
+		assert.Len(t, backup.Collect().Dump(), 1)
+	})
+
 	t.Run("WithURLPath", func(t *testing.T) {
 		path := "/prefix/v2/metrics"
 		ePt := fmt.Sprintf("http://localhost:0%s", path)
@@ -271,3 +504,166 @@ func TestConfig(t *testing.T) {
 		assert.Equal(t, got[headerKeySetInProxy], []string{headerValueSetInProxy})
 	})
 }
+
+func TestClientTLSFiles(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte("not yet written"), 0o600))
+
+	ctx := context.Background()
+	opts := []Option{
+		WithEndpoint(srv.Listener.Addr().String()),
+		WithTLSFiles(TLSFiles{CAFile: caFile}),
+		WithRetry(RetryConfig{Enabled: false}),
+	}
+	cfg := oconf.NewHTTPConfig(asHTTPOptions(opts)...)
+	client, err := newClient(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, client.Shutdown(ctx)) })
+
+	err = client.UploadMetrics(ctx, &mpb.ResourceMetrics{})
+	assert.ErrorContains(t, err, "failed to load TLS files")
+
+	// The CA bundle is written after the client was created.
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caFile, ca, 0o600))
+	assert.NoError(t, client.UploadMetrics(ctx, &mpb.ResourceMetrics{}))
+}
+
+func TestExportResultHandler(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		statuses = []int{http.StatusServiceUnavailable, http.StatusOK, http.StatusBadRequest}
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		mu.Lock()
+		status := statuses[0]
+		statuses = statuses[1:]
+		mu.Unlock()
+
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		resp, err := otlpjson.Marshal(&colmetricpb.ExportMetricsServiceResponse{
+			PartialSuccess: &colmetricpb.ExportMetricsPartialSuccess{
+				RejectedDataPoints: 2,
+				ErrorMessage:       "partially successful",
+			},
+		})
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(resp)
+	}))
+	t.Cleanup(srv.Close)
+
+	var results []ExportResult
+	ctx := context.Background()
+	exp, err := New(ctx,
+		WithEndpointURL(srv.URL),
+		WithRetry(RetryConfig{
+			Enabled:         true,
+			InitialInterval: time.Nanosecond,
+			MaxInterval:     time.Millisecond,
+			MaxElapsedTime:  time.Minute,
+		}),
+		WithExportResultHandler(func(r ExportResult) {
+			results = append(results, r)
+		}),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+	require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+	require.Len(t, results, 1)
+	assert.Equal(t, ExportResult{
+		Disposition:      ExportPartiallyAccepted,
+		Attempts:         2,
+		Rejected:         2,
+		RejectedMessages: []string{"partially successful"},
+	}, results[0])
+
+	require.Error(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+	require.Len(t, results, 2)
+	assert.Equal(t, ExportFailed, results[1].Disposition)
+	assert.Equal(t, 1, results[1].Attempts)
+	assert.ErrorContains(t, results[1].Err, "400 Bad Request")
+}
+
+func TestClientJSONEncoding(t *testing.T) {
+	var (
+		mu          sync.Mutex
+		contentType string
+		got         []*colmetricpb.ExportMetricsServiceRequest
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		req := new(colmetricpb.ExportMetricsServiceRequest)
+		if err := otlpjson.Unmarshal(body, req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		contentType = r.Header.Get("Content-Type")
+		got = append(got, req)
+		mu.Unlock()
+
+		resp, err := otlpjson.Marshal(&colmetricpb.ExportMetricsServiceResponse{
+			PartialSuccess: &colmetricpb.ExportMetricsPartialSuccess{
+				RejectedDataPoints: 1,
+				ErrorMessage:       "partially successful",
+			},
+		})
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(resp)
+	}))
+	t.Cleanup(srv.Close)
+
+	var errs []error
+	eh := otel.GetErrorHandler()
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { errs = append(errs, err) }))
+	t.Cleanup(func() { otel.SetErrorHandler(eh) })
+
+	ctx := context.Background()
+	opts := []Option{
+		WithEndpointURL(srv.URL),
+		WithEncoding(JSONEncoding),
+	}
+	cfg := oconf.NewHTTPConfig(asHTTPOptions(opts)...)
+	client, err := newClient(cfg)
+	require.NoError(t, err)
+
+	rm := &mpb.ResourceMetrics{
+		ScopeMetrics: []*mpb.ScopeMetrics{{
+			Metrics: []*mpb.Metric{{Name: "test"}},
+		}},
+	}
+	require.NoError(t, client.UploadMetrics(ctx, rm))
+	require.NoError(t, client.Shutdown(ctx))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, "application/json", contentType)
+	require.Len(t, got, 1)
+	require.Len(t, got[0].ResourceMetrics, 1)
+	assert.Equal(t, "test", got[0].ResourceMetrics[0].ScopeMetrics[0].Metrics[0].Name)
+
+	require.Len(t, errs, 1)
+	assert.ErrorContains(t, errs[0], "partially successful")
+}
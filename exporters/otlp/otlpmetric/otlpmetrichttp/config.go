@@ -9,8 +9,11 @@ import (
 	"net/url"
 	"time"
 
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/failover"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/oconf"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/tlsreload"
 	"go.opentelemetry.io/otel/sdk/metric"
 )
 
@@ -18,6 +21,9 @@ import (
 // collector.
 type Compression oconf.Compression
 
+// Encoding describes the encoding of payloads sent to the collector.
+type Encoding oconf.Encoding
+
 // HTTPTransportProxyFunc is a function that resolves which URL to use as proxy for a given request.
 // This type is compatible with http.Transport.Proxy and can be used to set a custom proxy function
 // to the OTLP HTTP client.
@@ -30,6 +36,31 @@ const (
 	// GzipCompression tells the driver to send payloads after
 	// compressing them with gzip.
 	GzipCompression = Compression(oconf.GzipCompression)
+	// ZstdCompression tells the driver to send payloads after
+	// compressing them with zstd.
+	//
+	// A compressor named "zstd" needs to be registered with
+	// google.golang.org/grpc/encoding, e.g. by importing
+	// go.opentelemetry.io/otel/exporters/otlp/otlpcompress/zstd.
+	// Otherwise, payloads are sent uncompressed.
+	ZstdCompression = Compression(oconf.ZstdCompression)
+	// SnappyCompression tells the driver to send payloads after
+	// compressing them with snappy.
+	//
+	// A compressor named "snappy" needs to be registered with
+	// google.golang.org/grpc/encoding, e.g. by importing
+	// go.opentelemetry.io/otel/exporters/otlp/otlpcompress/snappy.
+	// Otherwise, payloads are sent uncompressed.
+	SnappyCompression = Compression(oconf.SnappyCompression)
+)
+
+const (
+	// ProtobufEncoding tells the driver to send payloads encoded with the
+	// protobuf binary format ("application/x-protobuf").
+	ProtobufEncoding = Encoding(oconf.ProtobufEncoding)
+	// JSONEncoding tells the driver to send payloads encoded with the
+	// OTLP/JSON format ("application/json").
+	JSONEncoding = Encoding(oconf.JSONEncoding)
 )
 
 // Option applies an option to the Exporter.
@@ -49,6 +80,39 @@ func asHTTPOptions(opts []Option) []oconf.HTTPOption {
 // that failed.
 type RetryConfig retry.Config
 
+// TLSFiles are the paths to the PEM encoded files used to configure TLS.
+//
+// CAFile is the path to the CA bundle used to verify the server certificate.
+// CertFile and KeyFile are the paths to the client certificate and its
+// private key used for mTLS. They are only used if both are set.
+type TLSFiles tlsreload.Files
+
+// FailoverConfig defines the endpoints the exporter fails over between.
+type FailoverConfig struct {
+	// Endpoints are the endpoints in the order they are preferred.
+	Endpoints []FailoverEndpoint
+	// InitialBackoff is the time an endpoint is not used after it failed.
+	// It is doubled for every consecutive failure of the endpoint up to
+	// MaxBackoff. If zero, 5 seconds is used.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum time an endpoint is not used after it
+	// failed. If zero, 1 minute is used.
+	MaxBackoff time.Duration
+}
+
+// FailoverEndpoint is an endpoint the exporter fails over to.
+type FailoverEndpoint struct {
+	// Endpoint is the host and port of the endpoint. It should resemble
+	// "example.com:4318".
+	Endpoint string
+	// Weight is the relative share of the batches sent to the endpoint
+	// while it is healthy. Batches are distributed between all the healthy
+	// endpoints with a non-zero weight. Endpoints with a zero weight are
+	// backups that are only used, in the order they are configured, when
+	// no endpoint with a weight is healthy.
+	Weight int
+}
+
 type wrappedOption struct {
 	oconf.HTTPOption
 }
@@ -100,7 +164,7 @@ func WithEndpointURL(u string) Option {
 // If the OTEL_EXPORTER_OTLP_COMPRESSION or
 // OTEL_EXPORTER_OTLP_METRICS_COMPRESSION environment variable is set, and
 // this option is not passed, that variable value will be used. That value can
-// be either "none" or "gzip". If both are set,
+// be "none", "gzip", "zstd", or "snappy". If both are set,
 // OTEL_EXPORTER_OTLP_METRICS_COMPRESSION will take precedence.
 //
 // By default, if an environment variable is not set, and this option is not
@@ -109,6 +173,38 @@ func WithCompression(compression Compression) Option {
 	return wrappedOption{oconf.WithCompression(oconf.Compression(compression))}
 }
 
+// WithCompressor sets the compressor registered with
+// google.golang.org/grpc/encoding under name as the compressor the Exporter
+// will use to compress the HTTP body. The name is used as the value of the
+// Content-Encoding header. This can be used to send payloads compressed with
+// a custom encoder.
+//
+// If no compressor is registered under name, payloads are sent uncompressed
+// and this is reported to the global error handler (see otel.Handle).
+//
+// This option overrides the compression set by the WithCompression option
+// and the OTEL_EXPORTER_OTLP_COMPRESSION or
+// OTEL_EXPORTER_OTLP_METRICS_COMPRESSION environment variables. Passing
+// WithCompression after this option overrides it in turn.
+func WithCompressor(name string) Option {
+	return wrappedOption{oconf.WithCompressor(name)}
+}
+
+// WithEncoding sets the encoding the Exporter will use to encode the HTTP
+// body.
+//
+// If the OTEL_EXPORTER_OTLP_PROTOCOL or OTEL_EXPORTER_OTLP_METRICS_PROTOCOL
+// environment variable is set, and this option is not passed, that variable
+// value will be used. That value can be either "http/protobuf" or
+// "http/json". If both are set, OTEL_EXPORTER_OTLP_METRICS_PROTOCOL will take
+// precedence.
+//
+// By default, if an environment variable is not set, and this option is not
+// passed, ProtobufEncoding will be used.
+func WithEncoding(encoding Encoding) Option {
+	return wrappedOption{oconf.WithEncoding(oconf.Encoding(encoding))}
+}
+
 // WithURLPath sets the URL path the Exporter will send requests to.
 //
 // If the OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_METRICS_ENDPOINT
@@ -137,6 +233,25 @@ func WithTLSClientConfig(tlsCfg *tls.Config) Option {
 	return wrappedOption{oconf.WithTLSClientConfig(tlsCfg)}
 }
 
+// WithTLSFiles sets the files the CA bundle, client certificate and client
+// key used for TLS are loaded from. The files are loaded when a connection to
+// the collector is established and are reloaded when their modification time
+// or size changes, so rotated certificates are used without restarting the
+// exporter. If a changed file cannot be loaded, the error is passed to the
+// global ErrorHandler and the previously loaded certificates are used.
+//
+// If the OTEL_EXPORTER_OTLP_CERTIFICATE, OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE
+// and OTEL_EXPORTER_OTLP_CLIENT_KEY environment variables, or their signal
+// specific counterparts, are set, the files they define are also reloaded
+// when changed. The files passed with this option take precedence
+// over those environment variables.
+//
+// The other settings of a tls.Config passed with WithTLSClientConfig are kept.
+// If WithTLSClientConfig is passed after this option, the files are not used.
+func WithTLSFiles(files TLSFiles) Option {
+	return wrappedOption{oconf.WithTLSFiles(tlsreload.Files(files))}
+}
+
 // WithInsecure disables client transport security for the Exporter's HTTP
 // connection.
 //
@@ -167,6 +282,26 @@ func WithHeaders(headers map[string]string) Option {
 	return wrappedOption{oconf.WithHeaders(headers)}
 }
 
+// HeaderProvider returns headers to send with an HTTP request. req is the
+// request about to be sent, with the headers set by the exporter and with
+// WithHeaders, and body is the content of its Body as sent, i.e. encoded and
+// compressed. req and body must not be modified, and the Body of req must not
+// be read.
+//
+// The returned headers are sent in addition to, and take precedence over, the
+// headers of req. If an error is returned, the request is not sent and the
+// export fails with the error.
+type HeaderProvider func(req *http.Request, body []byte) (map[string]string, error)
+
+// WithHeaderProvider sets a HeaderProvider that is called before every export
+// attempt, including retries, to get the headers sent with the HTTP request.
+// This can be used to send headers whose values change over time, e.g.
+// short-lived bearer tokens, or that are computed from the request, e.g.
+// request signatures.
+func WithHeaderProvider(provider HeaderProvider) Option {
+	return wrappedOption{oconf.WithHTTPHeaderProvider(provider)}
+}
+
 // WithTimeout sets the max amount of time an Exporter will attempt an export.
 //
 // This takes precedence over any retry settings defined by WithRetry. Once
@@ -185,6 +320,64 @@ func WithTimeout(duration time.Duration) Option {
 	return wrappedOption{oconf.WithTimeout(duration)}
 }
 
+// WithMaxRequestSize sets the maximum size, in bytes, of the serialized and
+// uncompressed export request body. A batch of metric data that exceeds this
+// size is split into smaller requests before it is sent. If a single data point
+// exceeds this size on its own, it is dropped and an error is returned.
+//
+// Regardless of this option, a batch is split and the smaller requests are sent
+// if the target endpoint rejects the batch with a 413 (Payload Too Large)
+// status code.
+//
+// By default, the size of export requests is not limited.
+func WithMaxRequestSize(n int) Option {
+	return wrappedOption{oconf.WithMaxRequestSize(n)}
+}
+
+// WithFailover sets the endpoints the exporter sends batches of metric data to
+// instead of the endpoint set with WithEndpoint, WithEndpointURL, or
+// environment variables. A batch is sent to the first healthy endpoint. If
+// that endpoint cannot be reached, or rejects the batch with a status code
+// that is not retryable, it is marked as unhealthy and the batch is sent to
+// the next endpoint. An unhealthy endpoint is used again once its backoff has
+// elapsed, so the exporter returns to a preferred endpoint when it recovers.
+//
+// The scheme, URL path, headers, TLS, and other settings of the exporter are
+// used for all the endpoints.
+//
+// By default, the exporter does not fail over.
+func WithFailover(settings FailoverConfig) Option {
+	c := failover.Config{
+		Endpoints:      make([]failover.Endpoint, len(settings.Endpoints)),
+		InitialBackoff: settings.InitialBackoff,
+		MaxBackoff:     settings.MaxBackoff,
+	}
+	for i, e := range settings.Endpoints {
+		c.Endpoints[i] = failover.Endpoint(e)
+	}
+	return wrappedOption{oconf.WithFailover(c)}
+}
+
+// WithExportResultHandler sets a handler that is called with the result of the
+// export of every batch of metric data. The result reports how many data points
+// the endpoint rejected in partial success responses and why, how many export
+// requests were sent, and whether the batch was dropped. It can be used to
+// measure data loss or to drive a circuit breaker.
+//
+// The handler is called synchronously once the export of a batch, including its
+// retries and split requests, has completed. It needs to return promptly to not
+// block the exporter. Partial success responses are still reported to the
+// global error handler.
+//
+// By default, no handler is called.
+func WithExportResultHandler(h ExportResultHandler) Option {
+	var fn func(internal.ExportResult)
+	if h != nil {
+		fn = func(r internal.ExportResult) { h(newExportResult(r)) }
+	}
+	return wrappedOption{oconf.WithResultHandler(fn)}
+}
+
 // WithRetry sets the retry policy for transient retryable errors that are
 // returned by the target endpoint.
 //
@@ -2,11 +2,15 @@
 // SPDX-License-Identifier: Apache-2.0
 
 /*
-Package otlpmetrichttp provides an OTLP metrics exporter using HTTP with protobuf payloads.
+Package otlpmetrichttp provides an OTLP metrics exporter using HTTP with protobuf or JSON payloads.
 By default the telemetry is sent to https://localhost:4318/v1/metrics.
 
 Exporter should be created using [New] and used with a [metric.PeriodicReader].
 
+For receivers that pull metric data, a [Handler] created using [NewHandler]
+can be used as a [metric.Reader] and served as an [net/http.Handler].
+The environment variables described below do not apply to the Handler.
+
 The environment variables described below can be used for configuration.
 
 OTEL_EXPORTER_OTLP_ENDPOINT (default: "https://localhost:4318") -
@@ -39,10 +43,19 @@ The configuration can be overridden by [WithTimeout] option.
 
 OTEL_EXPORTER_OTLP_COMPRESSION, OTEL_EXPORTER_OTLP_METRICS_COMPRESSION (default: none) -
 compression strategy the exporter uses to compress the HTTP body.
-Supported values: "gzip".
+Supported values: "gzip", "zstd", "snappy".
+The "zstd" and "snappy" compressors need to be registered with [google.golang.org/grpc/encoding],
+e.g. by importing [go.opentelemetry.io/otel/exporters/otlp/otlpcompress/zstd] or
+[go.opentelemetry.io/otel/exporters/otlp/otlpcompress/snappy].
 OTEL_EXPORTER_OTLP_METRICS_COMPRESSION takes precedence over OTEL_EXPORTER_OTLP_COMPRESSION.
 The configuration can be overridden by [WithCompression] option.
 
+OTEL_EXPORTER_OTLP_PROTOCOL, OTEL_EXPORTER_OTLP_METRICS_PROTOCOL (default: "http/protobuf") -
+transport protocol the exporter uses to encode the HTTP body.
+Supported values: "http/protobuf", "http/json".
+OTEL_EXPORTER_OTLP_METRICS_PROTOCOL takes precedence over OTEL_EXPORTER_OTLP_PROTOCOL.
+The configuration can be overridden by [WithEncoding] option.
+
 OTEL_EXPORTER_OTLP_CERTIFICATE, OTEL_EXPORTER_OTLP_METRICS_CERTIFICATE (default: none) -
 filepath to the trusted certificate to use when verifying a server's TLS credentials.
 OTEL_EXPORTER_OTLP_METRICS_CERTIFICATE takes precedence over OTEL_EXPORTER_OTLP_CERTIFICATE.
@@ -5,6 +5,7 @@ package otlpmetrichttp_test
 
 import (
 	"context"
+	"net/http"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
@@ -29,3 +30,19 @@ func Example() {
 	// From here, the meterProvider can be used by instrumentation to collect
 	// telemetry.
 }
+
+func ExampleNewHandler() {
+	ctx := context.Background()
+	handler := otlpmetrichttp.NewHandler()
+
+	meterProvider := metric.NewMeterProvider(metric.WithReader(handler))
+	defer func() {
+		if err := meterProvider.Shutdown(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	otel.SetMeterProvider(meterProvider)
+
+	// Serve the collected metric data to receivers that pull it.
+	http.Handle("/v1/metrics", handler)
+}
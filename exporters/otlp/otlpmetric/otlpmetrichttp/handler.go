@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpmetrichttp // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/otlpjson"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/transform"
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+const (
+	contentTypeProto = "application/x-protobuf"
+	contentTypeJSON  = "application/json"
+)
+
+// Handler is a metric Reader that serves the metric data it collects over
+// HTTP. It is an alternative to the push based Exporter for receivers that
+// pull OTLP metric data.
+//
+// Each request served by the Handler collects the current metric data and
+// responds with it encoded as an OTLP ExportMetricsServiceRequest. The
+// encoding is negotiated using the Accept header of the request: OTLP/JSON is
+// used if "application/json" is accepted before "application/x-protobuf",
+// otherwise binary Protobuf is used.
+type Handler struct {
+	metric.Reader
+
+	rmPool sync.Pool
+}
+
+var (
+	_ metric.Reader = (*Handler)(nil)
+	_ http.Handler  = (*Handler)(nil)
+)
+
+// NewHandler returns a Handler that collects metric data from the
+// MeterProvider it is registered with, and any Producer passed using the
+// metric.WithProducer option, when it serves a request.
+//
+// By default, the Handler uses cumulative temporality for all instruments.
+func NewHandler(opts ...metric.ManualReaderOption) *Handler {
+	return &Handler{
+		Reader: metric.NewManualReader(opts...),
+		rmPool: sync.Pool{
+			New: func() interface{} {
+				return &metricdata.ResourceMetrics{}
+			},
+		},
+	}
+}
+
+// ServeHTTP collects the current metric data and writes it to w.
+//
+// Only GET and HEAD requests are served.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	rm := h.rmPool.Get().(*metricdata.ResourceMetrics)
+	defer h.rmPool.Put(rm)
+
+	err := h.Reader.Collect(r.Context(), rm)
+	if errors.Is(err, metric.ErrReaderNotRegistered) || errors.Is(err, metric.ErrReaderShutdown) {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	if err != nil {
+		// Best effort serving of the metric data that was collected.
+		otel.Handle(err)
+	}
+	defer global.Debug("OTLP/HTTP handler serve", "Data", rm)
+
+	otlpRm, err := transform.ResourceMetrics(rm)
+	if err != nil {
+		// Best effort serving of transformable metrics.
+		otel.Handle(err)
+	}
+	msg := &colmetricpb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricpb.ResourceMetrics{otlpRm},
+	}
+
+	contentType := negotiate(r.Header.Values("Accept"))
+	var body []byte
+	if contentType == contentTypeJSON {
+		body, err = otlpjson.Marshal(msg)
+	} else {
+		body, err = proto.Marshal(msg)
+	}
+	if err != nil {
+		otel.Handle(err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodHead {
+		return
+	}
+	if _, err := w.Write(body); err != nil {
+		otel.Handle(err)
+	}
+}
+
+// negotiate returns the content type to respond with based on the Accept
+// header values of a request.
+//
+// The first supported media type listed is used. Quality values are not
+// considered. If no supported media type is listed, binary Protobuf is used.
+func negotiate(accept []string) string {
+	for _, value := range accept {
+		for _, mediaRange := range strings.Split(value, ",") {
+			mediaType, _, err := mime.ParseMediaType(mediaRange)
+			if err != nil {
+				continue
+			}
+			switch mediaType {
+			case contentTypeJSON:
+				return contentTypeJSON
+			case contentTypeProto:
+				return contentTypeProto
+			}
+		}
+	}
+	return contentTypeProto
+}
+
+// MarshalLog returns logging data about the Handler.
+func (h *Handler) MarshalLog() interface{} {
+	return struct{ Type string }{Type: "OTLP/HTTP handler"}
+}
@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpmetrichttp
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/otlpjson"
+	"go.opentelemetry.io/otel/sdk/metric"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+)
+
+func TestHandler(t *testing.T) {
+	h := NewHandler()
+	mp := metric.NewMeterProvider(metric.WithReader(h))
+	t.Cleanup(func() { _ = mp.Shutdown(context.Background()) })
+
+	ctr, err := mp.Meter("TestHandler").Int64Counter("requests")
+	require.NoError(t, err)
+	ctr.Add(context.Background(), 3)
+
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	get := func(t *testing.T, accept string) (*http.Response, []byte) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, srv.URL, http.NoBody)
+		require.NoError(t, err)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		resp, err := srv.Client().Do(req)
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		return resp, body
+	}
+
+	assertRequest := func(t *testing.T, msg *colmetricpb.ExportMetricsServiceRequest) {
+		t.Helper()
+		require.Len(t, msg.ResourceMetrics, 1)
+		require.Len(t, msg.ResourceMetrics[0].ScopeMetrics, 1)
+		sm := msg.ResourceMetrics[0].ScopeMetrics[0]
+		assert.Equal(t, "TestHandler", sm.Scope.Name)
+		require.Len(t, sm.Metrics, 1)
+		assert.Equal(t, "requests", sm.Metrics[0].Name)
+		dps := sm.Metrics[0].GetSum().GetDataPoints()
+		require.Len(t, dps, 1)
+		assert.Equal(t, int64(3), dps[0].GetAsInt())
+	}
+
+	t.Run("Protobuf", func(t *testing.T) {
+		for _, accept := range []string{"", "*/*", contentTypeProto, "text/plain, application/x-protobuf;q=0.9"} {
+			resp, body := get(t, accept)
+			assert.Equal(t, contentTypeProto, resp.Header.Get("Content-Type"), accept)
+
+			msg := new(colmetricpb.ExportMetricsServiceRequest)
+			require.NoError(t, proto.Unmarshal(body, msg), accept)
+			assertRequest(t, msg)
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		for _, accept := range []string{contentTypeJSON, "application/json; charset=utf-8", "application/json, application/x-protobuf"} {
+			resp, body := get(t, accept)
+			assert.Equal(t, contentTypeJSON, resp.Header.Get("Content-Type"), accept)
+
+			msg := new(colmetricpb.ExportMetricsServiceRequest)
+			require.NoError(t, otlpjson.Unmarshal(body, msg), accept)
+			assertRequest(t, msg)
+		}
+	})
+}
+
+func TestHandlerMethodNotAllowed(t *testing.T) {
+	h := NewHandler()
+	_ = metric.NewMeterProvider(metric.WithReader(h))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", http.NoBody))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(t, "GET, HEAD", w.Header().Get("Allow"))
+}
+
+func TestHandlerHead(t *testing.T) {
+	h := NewHandler()
+	_ = metric.NewMeterProvider(metric.WithReader(h))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodHead, "/", http.NoBody))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, contentTypeProto, w.Header().Get("Content-Type"))
+	assert.Zero(t, w.Body.Len())
+}
+
+func TestHandlerUnavailable(t *testing.T) {
+	h := NewHandler()
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code, "unregistered")
+
+	mp := metric.NewMeterProvider(metric.WithReader(h))
+	require.NoError(t, mp.Shutdown(context.Background()))
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code, "shutdown")
+}
@@ -0,0 +1,196 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/failover/failover.go.tmpl
+
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package failover provides the selection of the endpoint an exporter sends
+// its requests to from a set of endpoints whose health is tracked.
+package failover // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/failover"
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Default backoff of an endpoint after it failed.
+const (
+	DefaultInitialBackoff = 5 * time.Second
+	DefaultMaxBackoff     = time.Minute
+)
+
+// Config defines the endpoints an exporter fails over between.
+type Config struct {
+	// Endpoints are the endpoints in the order they are preferred.
+	Endpoints []Endpoint
+	// InitialBackoff is the time an endpoint is not used after it failed.
+	// The backoff is doubled for every consecutive failure up to MaxBackoff.
+	// If zero, DefaultInitialBackoff is used.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum time an endpoint is not used after it
+	// failed. If zero, DefaultMaxBackoff is used.
+	MaxBackoff time.Duration
+}
+
+// Endpoint is an endpoint an exporter fails over to.
+type Endpoint struct {
+	// Endpoint is the address of the endpoint.
+	Endpoint string
+	// Weight is the relative share of the requests sent to the endpoint
+	// while it is healthy. The requests are distributed between all the
+	// healthy endpoints with a non-zero weight. Endpoints with a zero
+	// weight are backups that are only used, in the order they are
+	// configured, when no endpoint with a weight is healthy.
+	Weight int
+}
+
+// Set tracks the health of the endpoints of a Config. It is safe for
+// concurrent use.
+type Set struct {
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+
+	// now and intn are used for testing.
+	now  func() time.Time
+	intn func(int) int
+
+	mu        sync.Mutex
+	endpoints []*endpoint
+}
+
+type endpoint struct {
+	address string
+	weight  int
+
+	failures int
+	retryAt  time.Time
+}
+
+// NewSet returns a Set of the endpoints of c. It returns nil if c does not
+// contain any endpoint.
+func NewSet(c Config) *Set {
+	if len(c.Endpoints) == 0 {
+		return nil
+	}
+
+	s := &Set{
+		initialBackoff: c.InitialBackoff,
+		maxBackoff:     c.MaxBackoff,
+		now:            time.Now,
+		intn:           rand.Intn,
+	}
+	if s.initialBackoff <= 0 {
+		s.initialBackoff = DefaultInitialBackoff
+	}
+	if s.maxBackoff <= 0 {
+		s.maxBackoff = DefaultMaxBackoff
+	}
+	if s.maxBackoff < s.initialBackoff {
+		s.maxBackoff = s.initialBackoff
+	}
+	for _, e := range c.Endpoints {
+		s.endpoints = append(s.endpoints, &endpoint{address: e.Endpoint, weight: e.Weight})
+	}
+	return s
+}
+
+// Endpoints returns the addresses of all endpoints in s.
+func (s *Set) Endpoints() []string {
+	out := make([]string, len(s.endpoints))
+	for i, e := range s.endpoints {
+		out[i] = e.address
+	}
+	return out
+}
+
+// Do calls fn with the address of the endpoints in s, in the order they are
+// preferred, until fn succeeds or returns an error for which failover
+// returns false. The endpoint that fn failed for is marked as unhealthy and
+// is only preferred again after its backoff has elapsed. An endpoint is
+// marked as healthy again when fn succeeds for it.
+//
+// Endpoints that are unhealthy are still tried, after all the healthy
+// endpoints, so that a request is not dropped while all endpoints are
+// unhealthy. The last error returned by fn is returned if it does not
+// succeed for any endpoint. Do stops and returns the error returned by fn
+// if ctx is done.
+func (s *Set) Do(ctx context.Context, fn func(endpoint string) error, failover func(error) bool) error {
+	var err error
+	for _, e := range s.candidates() {
+		err = fn(e.address)
+		if err == nil {
+			s.succeeded(e)
+			return nil
+		}
+		if ctx.Err() != nil || !failover(err) {
+			return err
+		}
+		s.failed(e)
+	}
+	return err
+}
+
+// candidates returns the endpoints in the order they are tried.
+func (s *Set) candidates() []*endpoint {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	var weighted, backups, unhealthy []*endpoint
+	var total int
+	for _, e := range s.endpoints {
+		switch {
+		case now.Before(e.retryAt):
+			unhealthy = append(unhealthy, e)
+		case e.weight > 0:
+			weighted = append(weighted, e)
+			total += e.weight
+		default:
+			backups = append(backups, e)
+		}
+	}
+
+	if len(weighted) > 1 {
+		// Move the endpoint the request is distributed to to the front. The
+		// other weighted endpoints keep their order.
+		n := s.intn(total)
+		for i, e := range weighted {
+			if n < e.weight {
+				copy(weighted[1:i+1], weighted[:i])
+				weighted[0] = e
+				break
+			}
+			n -= e.weight
+		}
+	}
+
+	out := make([]*endpoint, 0, len(s.endpoints))
+	out = append(out, weighted...)
+	out = append(out, backups...)
+	return append(out, unhealthy...)
+}
+
+func (s *Set) succeeded(e *endpoint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e.failures = 0
+	e.retryAt = time.Time{}
+}
+
+func (s *Set) failed(e *endpoint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	backoff := s.initialBackoff
+	for i := 0; i < e.failures && backoff < s.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > s.maxBackoff {
+		backoff = s.maxBackoff
+	}
+	e.failures++
+	e.retryAt = s.now().Add(backoff)
+}
@@ -7,15 +7,23 @@
 package oconf
 
 import (
+	"context"
 	"errors"
+	"io"
 	"net/http"
 	"net/url"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/envconfig"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/failover"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/tlsreload"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
@@ -43,6 +51,25 @@ kbQ/kxzi9Ego0ZJdiXxkmv/C05QFddCW7Y0ZsJCLHGogQsYnWJBXUZOV
 `
 )
 
+func init() {
+	encoding.RegisterCompressor(testCompressor{})
+}
+
+// testCompressor is a no-op compressor registered as "custom".
+type testCompressor struct{}
+
+func (testCompressor) Name() string { return "custom" }
+
+func (testCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return nopWriteCloser{w}, nil
+}
+
+func (testCompressor) Decompress(r io.Reader) (io.Reader, error) { return r, nil }
+
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
+
 type env map[string]string
 
 func (e *env) getEnv(env string) string {
@@ -328,6 +355,49 @@ func TestConfigs(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "Test Environment Certificate Files",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_CERTIFICATE":                "cert_path",
+				"OTEL_EXPORTER_OTLP_METRICS_CLIENT_CERTIFICATE": "client_cert_path",
+				"OTEL_EXPORTER_OTLP_METRICS_CLIENT_KEY":         "client_key_path",
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				want := tlsreload.Files{CAFile: "cert_path", CertFile: "client_cert_path", KeyFile: "client_key_path"}
+				assert.Equal(t, want, c.Metrics.TLSFiles)
+				if grpcOption {
+					assert.NotNil(t, c.Metrics.GRPCCredentials)
+				}
+			},
+		},
+		{
+			name: "Test With TLS Files",
+			opts: []GenericOption{
+				WithTLSFiles(tlsreload.Files{CAFile: "ca.pem"}),
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				assert.Equal(t, tlsreload.Files{CAFile: "ca.pem"}, c.Metrics.TLSFiles)
+				if grpcOption {
+					require.NotNil(t, c.Metrics.GRPCCredentials)
+					assert.Equal(t, "localhost", c.Metrics.GRPCCredentials.Info().ServerName)
+				}
+			},
+		},
+		{
+			name: "Test With Certificate Overrides Environment Certificate Files",
+			opts: []GenericOption{
+				WithTLSClientConfig(tlsCert),
+			},
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_METRICS_CERTIFICATE": "cert_path",
+			},
+			fileReader: fileReader{
+				"cert_path": []byte(WeakCertificate),
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				assert.True(t, c.Metrics.TLSFiles.Empty())
+			},
+		},
 
 		// Headers tests
 		{
@@ -367,6 +437,36 @@ func TestConfigs(t *testing.T) {
 			},
 		},
 
+		{
+			name: "Test With HeaderProvider",
+			opts: []GenericOption{
+				WithHeaderProvider(func(context.Context) (map[string]string, error) {
+					return map[string]string{"h1": "v1"}, nil
+				}),
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				require.NotNil(t, c.Metrics.HeaderProvider)
+				h, err := c.Metrics.HeaderProvider(context.Background())
+				require.NoError(t, err)
+				assert.Equal(t, map[string]string{"h1": "v1"}, h)
+			},
+		},
+		{
+			name: "Test With HTTPHeaderProvider",
+			opts: []GenericOption{
+				WithHTTPHeaderProvider(func(r *http.Request, body []byte) (map[string]string, error) {
+					return map[string]string{"h1": r.Method + " " + string(body)}, nil
+				}),
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				require.NotNil(t, c.Metrics.HTTPHeaderProvider)
+				r := &http.Request{Method: http.MethodPost}
+				h, err := c.Metrics.HTTPHeaderProvider(r, []byte("body"))
+				require.NoError(t, err)
+				assert.Equal(t, map[string]string{"h1": "POST body"}, h)
+			},
+		},
+
 		// Compression Tests
 		{
 			name: "Test With Compression",
@@ -407,8 +507,147 @@ func TestConfigs(t *testing.T) {
 				assert.Equal(t, NoCompression, c.Metrics.Compression)
 			},
 		},
+		{
+			name: "Test Environment Unregistered Zstd Compression",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_COMPRESSION": "zstd",
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				assert.Equal(t, NoCompression, c.Metrics.Compression)
+				assert.Equal(t, "", c.Metrics.CompressorName())
+			},
+		},
+		{
+			name: "Test Environment Unregistered Snappy Compression",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_METRICS_COMPRESSION": "snappy",
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				assert.Equal(t, NoCompression, c.Metrics.Compression)
+				assert.Equal(t, "", c.Metrics.CompressorName())
+			},
+		},
+		{
+			name: "Test With Compressor",
+			opts: []GenericOption{
+				WithCompressor("custom"),
+			},
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_METRICS_COMPRESSION": "gzip",
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				assert.Equal(t, "custom", c.Metrics.CompressorName())
+			},
+		},
+		{
+			name: "Test With Compression After Compressor",
+			opts: []GenericOption{
+				WithCompressor("custom"),
+				WithCompression(NoCompression),
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				assert.Equal(t, "", c.Metrics.CompressorName())
+			},
+		},
+
+		// Encoding Tests
+		{
+			name: "Test Default Encoding",
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				assert.Equal(t, ProtobufEncoding, c.Metrics.Encoding)
+			},
+		},
+		{
+			name: "Test With Encoding",
+			opts: []GenericOption{
+				WithEncoding(JSONEncoding),
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				assert.Equal(t, JSONEncoding, c.Metrics.Encoding)
+			},
+		},
+		{
+			name: "Test Environment Encoding",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_PROTOCOL": "http/json",
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				assert.Equal(t, JSONEncoding, c.Metrics.Encoding)
+			},
+		},
+		{
+			name: "Test Environment Signal Specific Encoding",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_PROTOCOL":         "http/json",
+				"OTEL_EXPORTER_OTLP_METRICS_PROTOCOL": "http/protobuf",
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				assert.Equal(t, ProtobufEncoding, c.Metrics.Encoding)
+			},
+		},
+		{
+			name: "Test Environment Unsupported Encoding",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_PROTOCOL": "grpc",
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				assert.Equal(t, ProtobufEncoding, c.Metrics.Encoding)
+			},
+		},
+		{
+			name: "Test Mixed Environment and With Encoding",
+			opts: []GenericOption{
+				WithEncoding(ProtobufEncoding),
+			},
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_METRICS_PROTOCOL": "http/json",
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				assert.Equal(t, ProtobufEncoding, c.Metrics.Encoding)
+			},
+		},
 
 		// Timeout Tests
+		{
+			name: "Test With Max Request Size",
+			opts: []GenericOption{
+				WithMaxRequestSize(1024),
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				assert.Equal(t, 1024, c.Metrics.MaxRequestSize)
+			},
+		},
+		{
+			name: "Test With Failover",
+			opts: []GenericOption{
+				WithFailover(failover.Config{
+					Endpoints: []failover.Endpoint{
+						{Endpoint: "primary:4317"},
+						{Endpoint: "backup:4317"},
+					},
+					InitialBackoff: time.Second,
+				}),
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				want := failover.Config{
+					Endpoints: []failover.Endpoint{
+						{Endpoint: "primary:4317"},
+						{Endpoint: "backup:4317"},
+					},
+					InitialBackoff: time.Second,
+				}
+				assert.Equal(t, want, c.Metrics.Failover)
+			},
+		},
+		{
+			name: "Test With ResultHandler",
+			opts: []GenericOption{
+				WithResultHandler(func(internal.ExportResult) {}),
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) {
+				assert.NotNil(t, c.Metrics.ResultHandler)
+			},
+		},
 		{
 			name: "Test With Timeout",
 			opts: []GenericOption{
@@ -536,6 +775,29 @@ func deltaSelector(metric.InstrumentKind) metricdata.Temporality {
 	return metricdata.DeltaTemporality
 }
 
+func TestUnregisteredCompressor(t *testing.T) {
+	var got []error
+	origEH := otel.GetErrorHandler()
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { got = append(got, err) }))
+	t.Cleanup(func() { otel.SetErrorHandler(origEH) })
+
+	origEOR := DefaultEnvOptionsReader
+	DefaultEnvOptionsReader = envconfig.EnvOptionsReader{
+		GetEnv:    (&env{"OTEL_EXPORTER_OTLP_COMPRESSION": "zstd"}).getEnv,
+		Namespace: "OTEL_EXPORTER_OTLP",
+	}
+	t.Cleanup(func() { DefaultEnvOptionsReader = origEOR })
+
+	cfg := ApplyHTTPEnvConfigs(Config{})
+	assert.Equal(t, ZstdCompression, cfg.Metrics.Compression, "environment not parsed")
+
+	cfg = NewHTTPConfig()
+	assert.Equal(t, NoCompression, cfg.Metrics.Compression)
+	assert.Equal(t, "", cfg.Metrics.CompressorName())
+	require.Len(t, got, 1, "unregistered compressor not reported")
+	assert.ErrorContains(t, got[0], "compressor not registered: zstd")
+}
+
 func asHTTPOptions(opts []GenericOption) []HTTPOption {
 	converted := make([]HTTPOption, len(opts))
 	for i, o := range opts {
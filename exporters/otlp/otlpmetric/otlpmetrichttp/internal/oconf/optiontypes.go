@@ -29,6 +29,26 @@ const (
 	// GzipCompression tells the driver to send payloads after
 	// compressing them with gzip.
 	GzipCompression
+	// ZstdCompression tells the driver to send payloads after
+	// compressing them with zstd. A compressor named "zstd" needs to be
+	// registered with google.golang.org/grpc/encoding.
+	ZstdCompression
+	// SnappyCompression tells the driver to send payloads after
+	// compressing them with snappy. A compressor named "snappy" needs to be
+	// registered with google.golang.org/grpc/encoding.
+	SnappyCompression
+)
+
+// Encoding describes the encoding of payloads sent to the collector.
+type Encoding int
+
+const (
+	// ProtobufEncoding tells the driver to send payloads encoded with the
+	// protobuf binary format.
+	ProtobufEncoding Encoding = iota
+	// JSONEncoding tells the driver to send payloads encoded with the
+	// OTLP/JSON format.
+	JSONEncoding
 )
 
 // RetrySettings defines configuration for retrying batches in case of export failure
@@ -0,0 +1,110 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/otlpjson/json_test.go
+
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpjson
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+var (
+	traceID = []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+	spanID  = []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+
+	exemplar = &mpb.Exemplar{
+		TraceId: traceID,
+		SpanId:  spanID,
+	}
+
+	dataPoint = &mpb.NumberDataPoint{
+		TimeUnixNano: 1,
+		Value:        &mpb.NumberDataPoint_AsInt{AsInt: 1 << 62},
+		Exemplars:    []*mpb.Exemplar{exemplar},
+	}
+
+	metric = &mpb.Metric{
+		Name: "sum",
+		Data: &mpb.Metric_Sum{Sum: &mpb.Sum{
+			AggregationTemporality: mpb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			IsMonotonic:            true,
+			DataPoints:             []*mpb.NumberDataPoint{dataPoint},
+		}},
+	}
+
+	msg = &mpb.ResourceMetrics{
+		ScopeMetrics: []*mpb.ScopeMetrics{
+			{Metrics: []*mpb.Metric{metric}},
+		},
+	}
+)
+
+type jsonExemplar struct {
+	TraceID string `json:"traceId"`
+	SpanID  string `json:"spanId"`
+}
+
+type jsonDataPoint struct {
+	AsInt     string         `json:"asInt"`
+	Exemplars []jsonExemplar `json:"exemplars"`
+}
+
+type jsonSum struct {
+	AggregationTemporality json.Number     `json:"aggregationTemporality"`
+	DataPoints             []jsonDataPoint `json:"dataPoints"`
+}
+
+type jsonMetric struct {
+	Sum jsonSum `json:"sum"`
+}
+
+type jsonScopeMetrics struct {
+	Metrics []jsonMetric `json:"metrics"`
+}
+
+type jsonResourceMetrics struct {
+	ScopeMetrics []jsonScopeMetrics `json:"scopeMetrics"`
+}
+
+func TestMarshal(t *testing.T) {
+	b, err := Marshal(msg)
+	require.NoError(t, err)
+
+	var got jsonResourceMetrics
+	require.NoError(t, json.Unmarshal(b, &got))
+
+	sum := got.ScopeMetrics[0].Metrics[0].Sum
+	assert.Equal(t, json.Number("2"), sum.AggregationTemporality, "enum not encoded as integer")
+	assert.Equal(t, "4611686018427387904", sum.DataPoints[0].AsInt, "int64 not encoded as string")
+	ex := sum.DataPoints[0].Exemplars[0]
+	assert.Equal(t, "0102030405060708090a0b0c0d0e0f10", ex.TraceID)
+	assert.Equal(t, "0102030405060708", ex.SpanID)
+}
+
+func TestUnmarshal(t *testing.T) {
+	b, err := Marshal(msg)
+	require.NoError(t, err)
+
+	got := new(mpb.ResourceMetrics)
+	require.NoError(t, Unmarshal(b, got))
+	assert.True(t, proto.Equal(msg, got), "round trip: want %v, got %v", msg, got)
+}
+
+func TestUnmarshalInvalidID(t *testing.T) {
+	b := []byte(`{"scopeMetrics":[{"metrics":[{"sum":{"dataPoints":[{"exemplars":[{"traceId":"not hex"}]}]}}]}]}`)
+	assert.ErrorContains(t, Unmarshal(b, new(mpb.ResourceMetrics)), "invalid traceId")
+}
+
+func TestUnmarshalUnknownField(t *testing.T) {
+	b := []byte(`{"unknown":true,"scopeMetrics":[]}`)
+	assert.NoError(t, Unmarshal(b, new(mpb.ResourceMetrics)))
+}
@@ -0,0 +1,251 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/tlsreload/tlsreload_test.go.tmpl
+
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tlsreload
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+)
+
+type pemCertificate struct {
+	Certificate []byte
+	PrivateKey  []byte
+}
+
+func generateCertificate(t *testing.T, org string) pemCertificate {
+	t.Helper()
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	require.NoError(t, err)
+	tmpl := x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{Organization: []string{org}},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv6loopback, net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &priv.PublicKey, priv)
+	require.NoError(t, err)
+	privDER, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+
+	var crt, key bytes.Buffer
+	require.NoError(t, pem.Encode(&crt, &pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	require.NoError(t, pem.Encode(&key, &pem.Block{Type: "PRIVATE KEY", Bytes: privDER}))
+	return pemCertificate{Certificate: crt.Bytes(), PrivateKey: key.Bytes()}
+}
+
+// writeFile writes data to path and ensures the change is detected even on
+// file systems with a coarse modification time resolution.
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+
+	var mod time.Time
+	if fi, err := os.Stat(path); err == nil {
+		mod = fi.ModTime()
+	}
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	if !mod.IsZero() {
+		next := mod.Add(time.Second)
+		require.NoError(t, os.Chtimes(path, next, next))
+	}
+}
+
+// server is a TLS server that records the organization of the client
+// certificates it receives.
+type server struct {
+	addr    string
+	clients chan string
+}
+
+func newServer(t *testing.T, crt pemCertificate) *server {
+	t.Helper()
+
+	pair, err := tls.X509KeyPair(crt.Certificate, crt.PrivateKey)
+	require.NoError(t, err)
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{pair},
+		ClientAuth:   tls.RequestClientCert,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	s := &server{addr: ln.Addr().String(), clients: make(chan string, 10)}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			tlsConn := conn.(*tls.Conn)
+			if tlsConn.Handshake() == nil {
+				var org string
+				if peers := tlsConn.ConnectionState().PeerCertificates; len(peers) > 0 {
+					org = peers[0].Subject.Organization[0]
+				}
+				s.clients <- org
+			}
+			_ = conn.Close()
+		}
+	}()
+	return s
+}
+
+func dial(t *testing.T, addr string, cfg *tls.Config) error {
+	t.Helper()
+
+	conn, err := tls.Dial("tcp", addr, cfg)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func TestConfigReloadsCAFile(t *testing.T) {
+	crt0, crt1 := generateCertificate(t, "0"), generateCertificate(t, "1")
+	srv0, srv1 := newServer(t, crt0), newServer(t, crt1)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	writeFile(t, caFile, crt0.Certificate)
+
+	cfg := Config(nil, "localhost", Files{CAFile: caFile})
+	assert.NoError(t, dial(t, srv0.addr, cfg))
+	assert.Error(t, dial(t, srv1.addr, cfg), "server certificate not signed by the CA")
+
+	writeFile(t, caFile, crt1.Certificate)
+	assert.NoError(t, dial(t, srv1.addr, cfg))
+	assert.Error(t, dial(t, srv0.addr, cfg), "rotated CA still trusted")
+}
+
+func TestConfigReloadsClientCertificate(t *testing.T) {
+	srvCrt := generateCertificate(t, "server")
+	srv := newServer(t, srvCrt)
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client-key.pem")
+	writeFile(t, caFile, srvCrt.Certificate)
+
+	crt0 := generateCertificate(t, "client-0")
+	writeFile(t, certFile, crt0.Certificate)
+	writeFile(t, keyFile, crt0.PrivateKey)
+
+	cfg := Config(nil, "127.0.0.1", Files{CAFile: caFile, CertFile: certFile, KeyFile: keyFile})
+	require.NoError(t, dial(t, srv.addr, cfg))
+	assert.Equal(t, "client-0", <-srv.clients)
+
+	crt1 := generateCertificate(t, "client-1")
+	writeFile(t, certFile, crt1.Certificate)
+	writeFile(t, keyFile, crt1.PrivateKey)
+	require.NoError(t, dial(t, srv.addr, cfg))
+	assert.Equal(t, "client-1", <-srv.clients)
+}
+
+func TestConfigKeepsLastLoadedFilesOnError(t *testing.T) {
+	srvCrt := generateCertificate(t, "server")
+	srv := newServer(t, srvCrt)
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client-key.pem")
+	writeFile(t, caFile, srvCrt.Certificate)
+
+	crt0 := generateCertificate(t, "client-0")
+	writeFile(t, certFile, crt0.Certificate)
+	writeFile(t, keyFile, crt0.PrivateKey)
+
+	cfg := Config(nil, "localhost", Files{CAFile: caFile, CertFile: certFile, KeyFile: keyFile})
+	require.NoError(t, dial(t, srv.addr, cfg))
+	assert.Equal(t, "client-0", <-srv.clients)
+
+	var errs []error
+	eh := otel.ErrorHandlerFunc(func(e error) { errs = append(errs, e) })
+	defer func(orig otel.ErrorHandler) {
+		otel.SetErrorHandler(orig)
+	}(otel.GetErrorHandler())
+	otel.SetErrorHandler(eh)
+
+	// Only the certificate is rotated, the key does not match it yet.
+	writeFile(t, certFile, generateCertificate(t, "client-1").Certificate)
+	writeFile(t, caFile, []byte("invalid"))
+	require.NoError(t, dial(t, srv.addr, cfg))
+	assert.Equal(t, "client-0", <-srv.clients)
+	assert.Len(t, errs, 2)
+}
+
+func TestConfigMissingFiles(t *testing.T) {
+	srv := newServer(t, generateCertificate(t, "server"))
+
+	dir := t.TempDir()
+	cfg := Config(nil, "localhost", Files{CAFile: filepath.Join(dir, "ca.pem")})
+	assert.ErrorContains(t, dial(t, srv.addr, cfg), "failed to load TLS files")
+}
+
+func TestConfigVerifiesServerName(t *testing.T) {
+	crt := generateCertificate(t, "server")
+	srv := newServer(t, crt)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	writeFile(t, caFile, crt.Certificate)
+
+	assert.NoError(t, dial(t, srv.addr, Config(nil, "127.0.0.1", Files{CAFile: caFile})))
+	assert.Error(t, dial(t, srv.addr, Config(nil, "example.com", Files{CAFile: caFile})))
+	assert.Error(t, dial(t, srv.addr, Config(nil, "", Files{CAFile: caFile})))
+
+	base := &tls.Config{ServerName: "example.com"}
+	assert.Error(t, dial(t, srv.addr, Config(base, "localhost", Files{CAFile: caFile})))
+}
+
+func TestConfigBase(t *testing.T) {
+	base := &tls.Config{MinVersion: tls.VersionTLS13}
+	cfg := Config(base, "localhost", Files{CAFile: "ca.pem", CertFile: "crt.pem", KeyFile: "key.pem"})
+	assert.Equal(t, uint16(tls.VersionTLS13), cfg.MinVersion)
+	assert.Equal(t, "localhost", cfg.ServerName)
+	assert.True(t, cfg.InsecureSkipVerify, "standard verification not replaced")
+	assert.NotNil(t, cfg.VerifyConnection)
+	assert.NotNil(t, cfg.GetClientCertificate)
+	assert.False(t, base.InsecureSkipVerify, "base modified")
+	assert.Empty(t, base.ServerName, "base modified")
+
+	cfg = Config(&tls.Config{InsecureSkipVerify: true}, "localhost", Files{CAFile: "ca.pem"})
+	assert.Nil(t, cfg.VerifyConnection, "insecure base verified")
+
+	cfg = Config(nil, "localhost", Files{CertFile: "crt.pem"})
+	assert.Nil(t, cfg.GetClientCertificate, "client certificate without key used")
+}
+
+func TestHost(t *testing.T) {
+	assert.Equal(t, "localhost", Host("localhost:4317"))
+	assert.Equal(t, "::1", Host("[::1]:4317"))
+	assert.Equal(t, "collector", Host("collector"))
+}
@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlptracefile // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracefile"
+
+import (
+	"io"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracefile/internal/otlpfile"
+)
+
+// config contains options for the OTLP file exporter.
+type config struct {
+	file otlpfile.Config
+}
+
+// newConfig creates a validated config configured with options.
+func newConfig(options []Option) config {
+	var cfg config
+	for _, opt := range options {
+		cfg = opt.apply(cfg)
+	}
+	return cfg
+}
+
+// Option sets the configuration value for an Exporter.
+type Option interface {
+	apply(config) config
+}
+
+type fnOpt func(config) config
+
+func (f fnOpt) apply(c config) config { return f(c) }
+
+// WithPath sets the path of the file the export requests are written to. The
+// file is created if it does not exist, and appended to if it does.
+//
+// This option takes precedence over [WithWriter].
+func WithPath(path string) Option {
+	return fnOpt(func(c config) config {
+		c.file.Path = path
+		return c
+	})
+}
+
+// WithWriter sets the destination the export requests are written to if no
+// path is set with [WithPath]. The file rotation options do not apply to w.
+//
+// By default, if neither this option nor [WithPath] is passed, the export
+// requests are written to os.Stdout.
+func WithWriter(w io.Writer) Option {
+	return fnOpt(func(c config) config {
+		c.file.Writer = w
+		return c
+	})
+}
+
+// WithMaxSize sets the size in bytes the file is rotated at. The file is
+// rotated before an export request that would make it exceed size is
+// written.
+//
+// By default, if this option is not passed or size is less than 1, the file
+// is not rotated based on its size.
+func WithMaxSize(size int64) Option {
+	return fnOpt(func(c config) config {
+		c.file.MaxSize = size
+		return c
+	})
+}
+
+// WithRotationInterval sets the duration after which the file is rotated. The
+// file is rotated before the first export request written after it is older
+// than d.
+//
+// By default, if this option is not passed or d is not positive, the file is
+// not rotated based on its age.
+func WithRotationInterval(d time.Duration) Option {
+	return fnOpt(func(c config) config {
+		c.file.Interval = d
+		return c
+	})
+}
+
+// WithMaxBackups sets the maximum number of rotated files kept. The oldest
+// rotated files are removed first.
+//
+// By default, if this option is not passed or n is less than 1, all rotated
+// files are kept.
+func WithMaxBackups(n int) Option {
+	return fnOpt(func(c config) config {
+		c.file.MaxBackups = n
+		return c
+	})
+}
+
+// WithGzip compresses the rotated files with gzip. The ".gz" extension is
+// appended to the name of the compressed files.
+//
+// By default, if this option is not passed, rotated files are not
+// compressed.
+func WithGzip() Option {
+	return fnOpt(func(c config) config {
+		c.file.Gzip = true
+		return c
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlptracefile_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracefile"
+	"go.opentelemetry.io/otel/sdk/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+)
+
+func Example() {
+	ctx := context.Background()
+	exp, err := otlptracefile.New(
+		otlptracefile.WithPath("traces.jsonl"),
+		// Rotate the file daily or when it reaches 100 MiB.
+		otlptracefile.WithRotationInterval(24*time.Hour),
+		otlptracefile.WithMaxSize(100<<20),
+		// Keep a week of compressed rotated files.
+		otlptracefile.WithMaxBackups(7),
+		otlptracefile.WithGzip(),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	tracerProvider := trace.NewTracerProvider(trace.WithBatcher(exp))
+	defer func() {
+		if err := tracerProvider.Shutdown(ctx); err != nil {
+			panic(err)
+		}
+	}()
+	otel.SetTracerProvider(tracerProvider)
+}
+
+func ExampleReadFile() {
+	// Replay the spans written to traces.jsonl and its rotated files.
+	err := otlptracefile.ReadFile("traces.jsonl", func(req *coltracepb.ExportTraceServiceRequest) error {
+		// E.g. send req to an OTLP endpoint.
+		fmt.Println(len(req.ResourceSpans))
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlptracefile // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracefile"
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/tracetransform"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracefile/internal/otlpfile"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracefile/internal/otlpjson"
+	"go.opentelemetry.io/otel/sdk/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+)
+
+var _ trace.SpanExporter = (*Exporter)(nil)
+
+// Exporter writes spans as OTLP JSON Lines to a file or an io.Writer.
+type Exporter struct {
+	writer  *otlpfile.Writer
+	stopped atomic.Bool
+}
+
+// New returns a new [Exporter] configured with options.
+//
+// If a path is set with [WithPath], the file is opened and an error is
+// returned if it cannot be.
+func New(options ...Option) (*Exporter, error) {
+	cfg := newConfig(options)
+	w, err := otlpfile.NewWriter(cfg.file)
+	if err != nil {
+		return nil, err
+	}
+	return &Exporter{writer: w}, nil
+}
+
+// ExportSpans writes spans as a single OTLP/JSON encoded export request line.
+//
+// This method is safe to call concurrently.
+func (e *Exporter) ExportSpans(ctx context.Context, spans []trace.ReadOnlySpan) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if e.stopped.Load() || len(spans) == 0 {
+		return nil
+	}
+
+	b, err := otlpjson.Marshal(&coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: tracetransform.Spans(spans),
+	})
+	if err != nil {
+		return err
+	}
+	return e.writer.WriteLine(b)
+}
+
+// Shutdown closes the file written. Spans are no longer written after
+// Shutdown is called.
+//
+// This method is safe to call concurrently.
+func (e *Exporter) Shutdown(context.Context) error {
+	e.stopped.Store(true)
+	return e.writer.Close()
+}
+
+// MarshalLog is the marshaling function used by the logging system to represent this Exporter.
+func (e *Exporter) MarshalLog() interface{} {
+	return struct{ Type string }{Type: "otlptracefile"}
+}
+
+// ReadFile calls fn with each export request read from the files written to
+// path by an [Exporter]: the rotated files from the oldest to the newest
+// first, and path itself last. Rotated files compressed with gzip are
+// decompressed. It stops at the first error returned by fn, and returns it.
+//
+// ReadFile can be used to replay the spans written, e.g. by sending the
+// export requests to an OTLP endpoint.
+func ReadFile(path string, fn func(*coltracepb.ExportTraceServiceRequest) error) error {
+	return otlpfile.ReadFiles(path, decode(fn))
+}
+
+// Read calls fn with each export request read from r. It stops at the first
+// error returned by fn, and returns it.
+func Read(r io.Reader, fn func(*coltracepb.ExportTraceServiceRequest) error) error {
+	return otlpfile.Read(r, decode(fn))
+}
+
+func decode(fn func(*coltracepb.ExportTraceServiceRequest) error) func([]byte) error {
+	return func(b []byte) error {
+		req := new(coltracepb.ExportTraceServiceRequest)
+		if err := otlpjson.Unmarshal(b, req); err != nil {
+			return err
+		}
+		return fn(req)
+	}
+}
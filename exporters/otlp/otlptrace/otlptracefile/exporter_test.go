@@ -0,0 +1,172 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlptracefile
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	api "go.opentelemetry.io/otel/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+)
+
+var (
+	traceID = api.TraceID{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+	spanID  = api.SpanID{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+)
+
+func spans(names ...string) []trace.ReadOnlySpan {
+	stubs := make(tracetest.SpanStubs, len(names))
+	for i, name := range names {
+		stubs[i] = tracetest.SpanStub{
+			Name: name,
+			SpanContext: api.NewSpanContext(api.SpanContextConfig{
+				TraceID: traceID,
+				SpanID:  spanID,
+			}),
+			StartTime: time.Unix(1, 0),
+			EndTime:   time.Unix(2, 0),
+			Resource:  resource.NewSchemaless(attribute.String("service.name", "test")),
+		}
+	}
+	return stubs.Snapshots()
+}
+
+func readAll(t *testing.T, read func(func(*coltracepb.ExportTraceServiceRequest) error) error) []string {
+	t.Helper()
+	var names []string
+	require.NoError(t, read(func(req *coltracepb.ExportTraceServiceRequest) error {
+		for _, rs := range req.ResourceSpans {
+			for _, ss := range rs.ScopeSpans {
+				for _, s := range ss.Spans {
+					names = append(names, s.Name)
+				}
+			}
+		}
+		return nil
+	}))
+	return names
+}
+
+func TestExporterWriter(t *testing.T) {
+	var buf bytes.Buffer
+	exp, err := New(WithWriter(&buf))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, exp.ExportSpans(ctx, spans("a", "b")))
+	require.NoError(t, exp.ExportSpans(ctx, nil))
+	require.NoError(t, exp.ExportSpans(ctx, spans("c")))
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 2, "one line per export request")
+
+	// The OTLP/JSON encoding uses hex encoded IDs.
+	var v struct {
+		ResourceSpans []struct {
+			ScopeSpans []struct {
+				Spans []struct {
+					TraceID string `json:"traceId"`
+					SpanID  string `json:"spanId"`
+				}
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &v))
+	s := v.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	assert.Equal(t, traceID.String(), s.TraceID)
+	assert.Equal(t, spanID.String(), s.SpanID)
+
+	assert.Equal(t, []string{"a", "b", "c"}, readAll(t, func(fn func(*coltracepb.ExportTraceServiceRequest) error) error {
+		return Read(&buf, fn)
+	}))
+}
+
+func TestExporterFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	exp, err := New(WithPath(path), WithMaxSize(1), WithMaxBackups(1), WithGzip())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for _, name := range []string{"a", "b", "c"} {
+		require.NoError(t, exp.ExportSpans(ctx, spans(name)))
+	}
+	require.NoError(t, exp.Shutdown(ctx))
+
+	// Only one rotated file is kept.
+	assert.Equal(t, []string{"b", "c"}, readAll(t, func(fn func(*coltracepb.ExportTraceServiceRequest) error) error {
+		return ReadFile(path, fn)
+	}))
+}
+
+func TestExporterInvalidPath(t *testing.T) {
+	_, err := New(WithPath(filepath.Join(t.TempDir(), "missing", "traces.jsonl")))
+	assert.Error(t, err)
+}
+
+func TestExporterShutdown(t *testing.T) {
+	var buf bytes.Buffer
+	exp, err := New(WithWriter(&buf))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, exp.Shutdown(ctx))
+	require.NoError(t, exp.Shutdown(ctx))
+	assert.NoError(t, exp.ExportSpans(ctx, spans("a")))
+	assert.Empty(t, buf.String())
+}
+
+func TestExporterExportCanceled(t *testing.T) {
+	exp, err := New(WithWriter(&bytes.Buffer{}))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, exp.ExportSpans(ctx, spans("a")), context.Canceled)
+}
+
+func TestExporterConcurrentSafe(t *testing.T) {
+	var buf bytes.Buffer
+	exp, err := New(WithWriter(&buf))
+	require.NoError(t, err)
+
+	const goroutines = 10
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for i := 0; i < goroutines; i++ {
+		go func() {
+			defer wg.Done()
+			_ = exp.ExportSpans(context.Background(), spans("a"))
+		}()
+	}
+	wg.Wait()
+	require.NoError(t, exp.Shutdown(context.Background()))
+
+	assert.Len(t, readAll(t, func(fn func(*coltracepb.ExportTraceServiceRequest) error) error {
+		return Read(&buf, fn)
+	}), goroutines)
+}
+
+func TestReadError(t *testing.T) {
+	err := Read(strings.NewReader("{}\ninvalid\n"), func(*coltracepb.ExportTraceServiceRequest) error { return nil })
+	assert.ErrorContains(t, err, "line 2")
+
+	errStop := errors.New("stop")
+	err = Read(strings.NewReader("{}\n"), func(*coltracepb.ExportTraceServiceRequest) error { return errStop })
+	assert.ErrorIs(t, err, errStop)
+}
@@ -0,0 +1,10 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracefile/internal"
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpfile/file.go.tmpl "--data={}" --out=otlpfile/file.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpfile/file_test.go.tmpl "--data={}" --out=otlpfile/file_test.go
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpjson/json.go.tmpl "--data={}" --out=otlpjson/json.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpjson/json_test.go.tmpl "--data={}" --out=otlpjson/json_test.go
@@ -0,0 +1,236 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/otlpfile/file_test.go
+
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfile
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setNow sets the time returned by now to start, and returns a pointer to it
+// so tests can move it.
+func setNow(t *testing.T, start time.Time) *time.Time {
+	t.Helper()
+	current := start
+	orig := now
+	now = func() time.Time { return current }
+	t.Cleanup(func() { now = orig })
+	return &current
+}
+
+func readLines(t *testing.T, path string) []string {
+	t.Helper()
+	var lines []string
+	require.NoError(t, ReadFiles(path, func(b []byte) error {
+		lines = append(lines, string(b))
+		return nil
+	}))
+	return lines
+}
+
+func writeLines(t *testing.T, w *Writer, lines ...string) {
+	t.Helper()
+	for _, l := range lines {
+		require.NoError(t, w.WriteLine([]byte(l)))
+	}
+}
+
+func TestWriterIOWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(Config{Writer: &buf, MaxSize: 1})
+	require.NoError(t, err)
+
+	writeLines(t, w, "a", "b")
+	assert.NoError(t, w.Sync())
+	assert.NoError(t, w.Close())
+	assert.NoError(t, w.Close(), "Close is idempotent")
+	assert.ErrorIs(t, w.WriteLine([]byte("c")), errClosed)
+
+	assert.Equal(t, "a\nb\n", buf.String())
+}
+
+func TestWriterAppend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte("existing\n"), 0o600))
+
+	w, err := NewWriter(Config{Path: path})
+	require.NoError(t, err)
+	writeLines(t, w, "a")
+	require.NoError(t, w.Sync())
+	require.NoError(t, w.Close())
+
+	assert.Equal(t, []string{"existing", "a"}, readLines(t, path))
+	backups, err := Backups(path)
+	require.NoError(t, err)
+	assert.Empty(t, backups)
+}
+
+func TestWriterNotModifyLine(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(Config{Writer: &buf})
+	require.NoError(t, err)
+
+	b := make([]byte, 1, 2)
+	b[0] = 'a'
+	require.NoError(t, w.WriteLine(b))
+	assert.Equal(t, []byte{'a', 0}, b[:2])
+}
+
+func TestWriterRotateSize(t *testing.T) {
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	current := setNow(t, start)
+
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	// Two 3 bytes lines fit in a file.
+	w, err := NewWriter(Config{Path: path, MaxSize: 6})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = w.Close() })
+
+	writeLines(t, w, "aa", "bb")
+	*current = current.Add(time.Millisecond)
+	writeLines(t, w, "cc", "dd")
+	*current = current.Add(time.Millisecond)
+	// Lines larger than the maximum size are written to a file of their own.
+	writeLines(t, w, "too long", "ee")
+
+	backups, err := Backups(path)
+	require.NoError(t, err)
+	dir := filepath.Dir(path)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "traces-2024-01-02T03-04-05.001000000.jsonl"),
+		filepath.Join(dir, "traces-2024-01-02T03-04-05.002000000.jsonl"),
+		// Rotated at the same time as the previous file.
+		filepath.Join(dir, "traces-2024-01-02T03-04-05.002000001.jsonl"),
+	}, backups)
+
+	assert.Equal(t, []string{"aa", "bb", "cc", "dd", "too long", "ee"}, readLines(t, path))
+}
+
+func TestWriterRotateInterval(t *testing.T) {
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	current := setNow(t, start)
+
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	w, err := NewWriter(Config{Path: path, Interval: time.Minute})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = w.Close() })
+
+	writeLines(t, w, "a")
+	*current = current.Add(30 * time.Second)
+	writeLines(t, w, "b")
+
+	backups, err := Backups(path)
+	require.NoError(t, err)
+	assert.Empty(t, backups)
+
+	*current = current.Add(30 * time.Second)
+	writeLines(t, w, "c")
+
+	backups, err = Backups(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(filepath.Dir(path), "traces-2024-01-02T03-05-05.000000000.jsonl"),
+	}, backups)
+	assert.Equal(t, []string{"a", "b", "c"}, readLines(t, path))
+}
+
+func TestWriterMaxBackups(t *testing.T) {
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	current := setNow(t, start)
+
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	w, err := NewWriter(Config{Path: path, MaxSize: 1, MaxBackups: 2})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = w.Close() })
+
+	for _, l := range []string{"a", "b", "c", "d", "e"} {
+		*current = current.Add(time.Second)
+		writeLines(t, w, l)
+	}
+
+	backups, err := Backups(path)
+	require.NoError(t, err)
+	assert.Len(t, backups, 2)
+	assert.Equal(t, []string{"c", "d", "e"}, readLines(t, path))
+}
+
+func TestWriterGzip(t *testing.T) {
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	current := setNow(t, start)
+
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	w, err := NewWriter(Config{Path: path, MaxSize: 1, Gzip: true})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = w.Close() })
+
+	for _, l := range []string{"a", "b", "c"} {
+		*current = current.Add(time.Second)
+		writeLines(t, w, l)
+	}
+
+	backups, err := Backups(path)
+	require.NoError(t, err)
+	require.Len(t, backups, 2)
+	for _, b := range backups {
+		assert.True(t, strings.HasSuffix(b, ".jsonl.gz"), b)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, readLines(t, path))
+}
+
+func TestBackupsIgnoresOtherFiles(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "traces.jsonl")
+	for _, name := range []string{
+		"traces.jsonl",
+		"traces-invalid.jsonl",
+		"traces-2024-01-02T03-04-05.000000000.json",
+		"metrics-2024-01-02T03-04-05.000000000.jsonl",
+		"traces-2024-01-02T03-04-05.000000000.jsonl",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0o600))
+	}
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "traces-2024-01-02T03-04-06.000000000.jsonl"), 0o700))
+
+	backups, err := Backups(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "traces-2024-01-02T03-04-05.000000000.jsonl")}, backups)
+}
+
+func TestReadFilesMissing(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	assert.Empty(t, readLines(t, path))
+
+	assert.Error(t, ReadFiles(filepath.Join(path, "missing", "traces.jsonl"), func([]byte) error { return nil }))
+}
+
+func TestRead(t *testing.T) {
+	var lines []string
+	err := Read(strings.NewReader("a\n\n  \nb\r\nc"), func(b []byte) error {
+		lines = append(lines, string(b))
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, lines)
+
+	errStop := errors.New("stop")
+	err = Read(strings.NewReader("a\nb\n"), func(b []byte) error {
+		if string(b) == "b" {
+			return errStop
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, errStop)
+	assert.ErrorContains(t, err, "line 2")
+}
@@ -6,6 +6,8 @@ package otlptracegrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlptra
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,10 +16,12 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/failover"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/otlpconfig"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/retry"
 	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
@@ -25,11 +29,17 @@ import (
 )
 
 type client struct {
-	endpoint      string
-	dialOpts      []grpc.DialOption
-	metadata      metadata.MD
-	exportTimeout time.Duration
-	requestFunc   retry.RequestFunc
+	endpoint       string
+	dialOpts       []grpc.DialOption
+	metadata       metadata.MD
+	headerFn       func(context.Context) (map[string]string, error)
+	exportTimeout  time.Duration
+	requestFunc    retry.RequestFunc
+	maxRequestSize int
+	resultHandler  func(internal.ExportResult)
+	// failover is the set of endpoints to fail over between, or nil if the
+	// client only sends to endpoint.
+	failover *failover.Set
 
 	// stopCtx is used as a parent context for all exports. Therefore, when it
 	// is canceled with the stopFunc all exports are canceled.
@@ -45,6 +55,11 @@ type client struct {
 	conn    *grpc.ClientConn
 	tscMu   sync.RWMutex
 	tsc     coltracepb.TraceServiceClient
+
+	// failoverConns are the connections to the failover endpoints, and
+	// failoverTSC the clients using them by endpoint.
+	failoverConns []*grpc.ClientConn
+	failoverTSC   map[string]coltracepb.TraceServiceClient
 }
 
 // Compile time check *client implements otlptrace.Client.
@@ -61,13 +76,20 @@ func newClient(opts ...Option) *client {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	c := &client{
-		endpoint:      cfg.Traces.Endpoint,
-		exportTimeout: cfg.Traces.Timeout,
-		requestFunc:   cfg.RetryConfig.RequestFunc(retryable),
-		dialOpts:      cfg.DialOptions,
-		stopCtx:       ctx,
-		stopFunc:      cancel,
-		conn:          cfg.GRPCConn,
+		endpoint:       cfg.Traces.Endpoint,
+		exportTimeout:  cfg.Traces.Timeout,
+		maxRequestSize: cfg.Traces.MaxRequestSize,
+		requestFunc:    cfg.RetryConfig.RequestFunc(retryable),
+		dialOpts:       cfg.DialOptions,
+		stopCtx:        ctx,
+		stopFunc:       cancel,
+		conn:           cfg.GRPCConn,
+		headerFn:       cfg.Traces.HeaderProvider,
+		resultHandler:  cfg.Traces.ResultHandler,
+	}
+
+	if c.conn == nil {
+		c.failover = failover.NewSet(cfg.Traces.Failover)
 	}
 
 	if len(cfg.Traces.Headers) > 0 {
@@ -79,6 +101,9 @@ func newClient(opts ...Option) *client {
 
 // Start establishes a gRPC connection to the collector.
 func (c *client) Start(context.Context) error {
+	if c.failover != nil {
+		return c.startFailover()
+	}
 	if c.conn == nil {
 		// If the caller did not provide a ClientConn when the client was
 		// created, create one using the configuration they did provide.
@@ -101,6 +126,32 @@ func (c *client) Start(context.Context) error {
 	return nil
 }
 
+// startFailover establishes a gRPC connection to every failover endpoint.
+func (c *client) startFailover() error {
+	conns := make([]*grpc.ClientConn, 0, len(c.failover.Endpoints()))
+	tscs := make(map[string]coltracepb.TraceServiceClient)
+	for _, endpoint := range c.failover.Endpoints() {
+		conn, err := grpc.NewClient(endpoint, c.dialOpts...)
+		if err != nil {
+			for _, conn := range conns {
+				_ = conn.Close()
+			}
+			return err
+		}
+		conns = append(conns, conn)
+		tscs[endpoint] = coltracepb.NewTraceServiceClient(conn)
+	}
+	c.ourConn = true
+	c.failoverConns = conns
+	c.failoverTSC = tscs
+
+	c.tscMu.Lock()
+	c.tsc = tscs[c.failover.Endpoints()[0]]
+	c.tscMu.Unlock()
+
+	return nil
+}
+
 var errAlreadyStopped = errors.New("the client is already stopped")
 
 // Stop shuts down the client.
@@ -159,7 +210,7 @@ func (c *client) Stop(ctx context.Context) error {
 	c.tsc = nil
 
 	if c.ourConn {
-		closeErr := c.conn.Close()
+		closeErr := c.closeConns()
 		// A context timeout error takes precedence over this error.
 		if err == nil && closeErr != nil {
 			err = closeErr
@@ -168,6 +219,18 @@ func (c *client) Stop(ctx context.Context) error {
 	return err
 }
 
+// closeConns closes the connections created by the client.
+func (c *client) closeConns() error {
+	if c.failover == nil {
+		return c.conn.Close()
+	}
+	var err error
+	for _, conn := range c.failoverConns {
+		err = errors.Join(err, conn.Close())
+	}
+	return err
+}
+
 var errShutdown = errors.New("the client is shutdown")
 
 // UploadTraces sends a batch of spans.
@@ -189,25 +252,99 @@ func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.Resourc
 	ctx, cancel := c.exportContext(ctx)
 	defer cancel()
 
-	return c.requestFunc(ctx, func(iCtx context.Context) error {
-		resp, err := c.tsc.Export(iCtx, &coltracepb.ExportTraceServiceRequest{
-			ResourceSpans: protoSpans,
-		})
-		if resp != nil && resp.PartialSuccess != nil {
-			msg := resp.PartialSuccess.GetErrorMessage()
-			n := resp.PartialSuccess.GetRejectedSpans()
-			if n != 0 || msg != "" {
-				err := internal.TracePartialSuccessError(n, msg)
-				otel.Handle(err)
-			}
+	res := new(internal.ExportResult)
+	err := c.upload(ctx, protoSpans, res)
+	if c.resultHandler != nil {
+		res.Err = err
+		c.resultHandler(*res)
+	}
+	return err
+}
+
+// upload sends protoSpans in a single request. If the request exceeds the
+// maximum request size or is rejected by the server as too large, protoSpans
+// are split and sent in multiple requests. The outcome of the requests is
+// recorded in res.
+func (c *client) upload(ctx context.Context, protoSpans []*tracepb.ResourceSpans, res *internal.ExportResult) error {
+	req := &coltracepb.ExportTraceServiceRequest{ResourceSpans: protoSpans}
+	if c.maxRequestSize > 0 {
+		if size := proto.Size(req); size > c.maxRequestSize {
+			return c.uploadSplit(ctx, protoSpans, res, internal.RequestSizeError(size, c.maxRequestSize))
+		}
+	}
+
+	err := c.requestFunc(ctx, func(iCtx context.Context) error {
+		iCtx, err := c.headerContext(iCtx)
+		if err != nil {
+			return err
 		}
-		// nil is converted to OK.
-		if status.Code(err) == codes.OK {
-			// Success.
-			return nil
+		if c.failover == nil {
+			return export(iCtx, c.tsc, req, res)
 		}
-		return err
+		return c.failover.Do(iCtx, func(endpoint string) error {
+			return export(iCtx, c.failoverTSC[endpoint], req, res)
+		}, shouldFailover)
 	})
+	if tooLarge(err) {
+		return c.uploadSplit(ctx, protoSpans, res, err)
+	}
+	return err
+}
+
+// export sends req using tsc and records the attempt in res.
+func export(ctx context.Context, tsc coltracepb.TraceServiceClient, req *coltracepb.ExportTraceServiceRequest, res *internal.ExportResult) error {
+	res.Attempt()
+	resp, err := tsc.Export(ctx, req)
+	if resp != nil && resp.PartialSuccess != nil {
+		msg := resp.PartialSuccess.GetErrorMessage()
+		n := resp.PartialSuccess.GetRejectedSpans()
+		if n != 0 || msg != "" {
+			res.PartialSuccess(n, msg)
+			err := internal.TracePartialSuccessError(n, msg)
+			otel.Handle(err)
+		}
+	}
+	// nil is converted to OK.
+	if status.Code(err) == codes.OK {
+		// Success.
+		return nil
+	}
+	return err
+}
+
+// uploadSplit splits protoSpans in two and uploads both halves. If
+// protoSpans cannot be split, err is returned.
+func (c *client) uploadSplit(ctx context.Context, protoSpans []*tracepb.ResourceSpans, res *internal.ExportResult, err error) error {
+	a, b, ok := internal.SplitResourceSpans(protoSpans)
+	if !ok {
+		return err
+	}
+	return errors.Join(c.upload(ctx, a, res), c.upload(ctx, b, res))
+}
+
+// headerContext returns a copy of ctx with the outgoing metadata updated
+// with the headers returned by the configured header provider. If no header
+// provider is configured, ctx is returned.
+func (c *client) headerContext(ctx context.Context) (context.Context, error) {
+	if c.headerFn == nil {
+		return ctx, nil
+	}
+	headers, err := c.headerFn(ctx)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to get headers: %w", err)
+	}
+	if len(headers) == 0 {
+		return ctx, nil
+	}
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		md = metadata.MD{}
+	}
+	for k, v := range headers {
+		md.Set(k, v)
+	}
+	return metadata.NewOutgoingContext(ctx, md), nil
 }
 
 // exportContext returns a copy of parent with an appropriate deadline and
@@ -272,6 +409,31 @@ func retryableGRPCStatus(s *status.Status) (bool, time.Duration) {
 	return false, 0
 }
 
+// tooLarge returns if err identifies a request that was rejected because
+// it exceeds the maximum message size of the client or server.
+func tooLarge(err error) bool {
+	s, ok := status.FromError(err)
+	return ok && s.Code() == codes.ResourceExhausted && strings.Contains(s.Message(), "larger than max")
+}
+
+// shouldFailover returns if err identifies a request that should be sent to
+// the next failover endpoint: the endpoint is unavailable or rejected the
+// request with a status that is not retryable.
+func shouldFailover(err error) bool {
+	s := status.Convert(err)
+	switch s.Code() {
+	case codes.Unavailable:
+		return true
+	case codes.ResourceExhausted:
+		if tooLarge(err) {
+			// The request is split instead.
+			return false
+		}
+	}
+	retry, _ := retryableGRPCStatus(s)
+	return !retry
+}
+
 // throttleDelay returns of the status is RetryInfo
 // and the its duration to wait for if an explicit throttle time.
 func throttleDelay(s *status.Status) (bool, time.Duration) {
@@ -4,9 +4,11 @@
 package otlptracegrpc_test
 
 import (
+	"compress/flate"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"strings"
 	"testing"
@@ -18,6 +20,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
 	"google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/status"
 
@@ -36,6 +39,27 @@ func TestMain(m *testing.M) {
 	goleak.VerifyTestMain(m)
 }
 
+// testCompressorName is the name of the compressor registered to test custom
+// compression.
+const testCompressorName = "otlp-test-deflate"
+
+func init() {
+	encoding.RegisterCompressor(deflateCompressor{})
+}
+
+// deflateCompressor is a custom compressor using the DEFLATE format.
+type deflateCompressor struct{}
+
+func (deflateCompressor) Name() string { return testCompressorName }
+
+func (deflateCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return flate.NewWriter(w, flate.DefaultCompression)
+}
+
+func (deflateCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	return flate.NewReader(r), nil
+}
+
 var roSpans = tracetest.SpanStubs{{Name: "Span 0"}}.Snapshots()
 
 func contextWithTimeout(parent context.Context, t *testing.T, timeout time.Duration) (context.Context, context.CancelFunc) {
@@ -66,6 +90,12 @@ func TestNewEndToEnd(t *testing.T) {
 				otlptracegrpc.WithCompressor(gzip.Name),
 			},
 		},
+		{
+			name: "WithCustomCompressor",
+			additionalOpts: []otlptracegrpc.Option{
+				otlptracegrpc.WithCompressor(testCompressorName),
+			},
+		},
 		{
 			name: "WithServiceConfig",
 			additionalOpts: []otlptracegrpc.Option{
@@ -227,6 +257,54 @@ func TestNewWithHeaders(t *testing.T) {
 	assert.Equal(t, "value1", headers.Get("header1")[0])
 }
 
+func TestNewWithHeaderProvider(t *testing.T) {
+	mc := runMockCollectorWithConfig(t, &mockConfig{
+		errors: []error{status.Error(codes.Unavailable, "backend")},
+	})
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	var calls int
+	provider := func(context.Context) (map[string]string, error) {
+		calls++
+		return map[string]string{"header1": fmt.Sprintf("token-%d", calls)}, nil
+	}
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, mc.endpoint,
+		otlptracegrpc.WithHeaders(map[string]string{"header1": "value1", "header2": "value2"}),
+		otlptracegrpc.WithHeaderProvider(provider),
+		otlptracegrpc.WithRetry(otlptracegrpc.RetryConfig{
+			Enabled:         true,
+			InitialInterval: time.Nanosecond,
+			MaxInterval:     time.Nanosecond,
+			MaxElapsedTime:  time.Minute,
+		}))
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+	require.NoError(t, exp.ExportSpans(ctx, roSpans))
+
+	// The provider is called for the failed attempt and the retry.
+	assert.Equal(t, 2, calls)
+	headers := mc.getHeaders()
+	assert.Equal(t, []string{"token-2"}, headers.Get("header1"))
+	assert.Equal(t, []string{"value2"}, headers.Get("header2"))
+}
+
+func TestNewWithHeaderProviderError(t *testing.T) {
+	mc := runMockCollector(t)
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	provider := func(context.Context) (map[string]string, error) {
+		return nil, errors.New("token unavailable")
+	}
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, mc.endpoint, otlptracegrpc.WithHeaderProvider(provider))
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+	err := exp.ExportSpans(ctx, roSpans)
+	assert.ErrorContains(t, err, "token unavailable")
+	assert.Empty(t, mc.getSpans())
+}
+
 func TestExportSpansTimeoutHonored(t *testing.T) {
 	ctx, cancel := contextWithTimeout(context.Background(), t, 1*time.Minute)
 	t.Cleanup(cancel)
@@ -406,6 +484,182 @@ func TestPartialSuccess(t *testing.T) {
 	require.Contains(t, errs[0].Error(), "2 spans rejected")
 }
 
+func TestExportResultHandler(t *testing.T) {
+	retry := otlptracegrpc.WithRetry(otlptracegrpc.RetryConfig{
+		Enabled:         true,
+		InitialInterval: time.Nanosecond,
+		MaxInterval:     time.Nanosecond,
+		MaxElapsedTime:  time.Minute,
+	})
+
+	t.Run("PartialSuccess", func(t *testing.T) {
+		mc := runMockCollectorWithConfig(t, &mockConfig{
+			errors: []error{status.Error(codes.Unavailable, "unavailable")},
+			partial: &coltracepb.ExportTracePartialSuccess{
+				RejectedSpans: 2,
+				ErrorMessage:  "partially successful",
+			},
+		})
+		t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+		var results []otlptracegrpc.ExportResult
+		ctx := context.Background()
+		exp := newGRPCExporter(t, ctx, mc.endpoint, retry,
+			otlptracegrpc.WithExportResultHandler(func(r otlptracegrpc.ExportResult) {
+				results = append(results, r)
+			}))
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		require.NoError(t, exp.ExportSpans(ctx, roSpans))
+
+		assert.Equal(t, []otlptracegrpc.ExportResult{{
+			Disposition:      otlptracegrpc.ExportPartiallyAccepted,
+			Attempts:         2,
+			Rejected:         2,
+			RejectedMessages: []string{"partially successful"},
+		}}, results)
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		mc := runMockCollectorWithConfig(t, &mockConfig{
+			errors: []error{status.Error(codes.InvalidArgument, "invalid")},
+		})
+		t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+		var results []otlptracegrpc.ExportResult
+		ctx := context.Background()
+		exp := newGRPCExporter(t, ctx, mc.endpoint, retry,
+			otlptracegrpc.WithExportResultHandler(func(r otlptracegrpc.ExportResult) {
+				results = append(results, r)
+			}))
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		require.Error(t, exp.ExportSpans(ctx, roSpans))
+
+		require.Len(t, results, 1)
+		assert.Equal(t, otlptracegrpc.ExportFailed, results[0].Disposition)
+		assert.Equal(t, 1, results[0].Attempts)
+		assert.Equal(t, codes.InvalidArgument, status.Code(results[0].Err))
+	})
+}
+
+func TestSplitRequestTooLarge(t *testing.T) {
+	mc := runMockCollectorWithConfig(t, &mockConfig{
+		errors: []error{
+			status.Error(codes.ResourceExhausted, "grpc: received message larger than max (3 vs. 2)"),
+		},
+	})
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, mc.endpoint)
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+	spans := tracetest.SpanStubs{{Name: "0"}, {Name: "1"}, {Name: "2"}}.Snapshots()
+	require.NoError(t, exp.ExportSpans(ctx, spans))
+	assert.Len(t, mc.getSpans(), 3)
+	assert.Equal(t, 3, mc.traceSvc.requests, "rejected request and its two halves")
+}
+
+func TestSplitRequestTooLargeUnsplittable(t *testing.T) {
+	mc := runMockCollectorWithConfig(t, &mockConfig{
+		errors: []error{
+			status.Error(codes.ResourceExhausted, "grpc: received message larger than max (2 vs. 1)"),
+		},
+	})
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, mc.endpoint)
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+	err := exp.ExportSpans(ctx, roSpans)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(errors.Unwrap(err)))
+	assert.Equal(t, 1, mc.traceSvc.requests)
+}
+
+func TestMaxRequestSize(t *testing.T) {
+	mc := runMockCollector(t)
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, mc.endpoint, otlptracegrpc.WithMaxRequestSize(512))
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+	large := attribute.String("large", strings.Repeat("x", 1024))
+	spans := tracetest.SpanStubs{
+		{Name: "0", Attributes: []attribute.KeyValue{large}},
+		{Name: "1"},
+		{Name: "2"},
+	}.Snapshots()
+	err := exp.ExportSpans(ctx, spans)
+	assert.ErrorContains(t, err, "exceeds the maximum request size of 512 bytes")
+
+	got := mc.getSpans()
+	require.Len(t, got, 2, "spans within the maximum request size not exported")
+	assert.Equal(t, "1", got[0].Name)
+	assert.Equal(t, "2", got[1].Name)
+	assert.Equal(t, 1, mc.traceSvc.requests, "spans within the maximum request size not batched")
+}
+
+func TestFailover(t *testing.T) {
+	primary := runMockCollector(t)
+	backup := runMockCollector(t)
+	t.Cleanup(func() { require.NoError(t, backup.stop()) })
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, "", otlptracegrpc.WithFailover(otlptracegrpc.FailoverConfig{
+		Endpoints: []otlptracegrpc.FailoverEndpoint{
+			{Endpoint: primary.endpoint},
+			{Endpoint: backup.endpoint},
+		},
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     10 * time.Millisecond,
+	}))
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+	require.NoError(t, exp.ExportSpans(ctx, roSpans))
+	assert.Len(t, primary.getSpans(), 1, "primary endpoint not used")
+	assert.Empty(t, backup.getSpans())
+
+	require.NoError(t, primary.stop())
+	require.NoError(t, exp.ExportSpans(ctx, roSpans))
+	assert.Len(t, backup.getSpans(), 1, "no failover to backup endpoint")
+
+	// Restart the primary endpoint and wait for the exporter to return to it.
+	primary = runMockCollectorAtEndpoint(t, primary.endpoint)
+	t.Cleanup(func() { require.NoError(t, primary.stop()) })
+	assert.Eventually(t, func() bool {
+		require.NoError(t, exp.ExportSpans(ctx, roSpans))
+		return len(primary.getSpans()) > 0
+	}, 10*time.Second, 50*time.Millisecond, "no return to recovered primary endpoint")
+}
+
+func TestFailoverNonRetryable(t *testing.T) {
+	primary := runMockCollectorWithConfig(t, &mockConfig{
+		errors: []error{status.Error(codes.InvalidArgument, "invalid")},
+	})
+	t.Cleanup(func() { require.NoError(t, primary.stop()) })
+	backup := runMockCollector(t)
+	t.Cleanup(func() { require.NoError(t, backup.stop()) })
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, "", otlptracegrpc.WithFailover(otlptracegrpc.FailoverConfig{
+		Endpoints: []otlptracegrpc.FailoverEndpoint{
+			{Endpoint: primary.endpoint},
+			{Endpoint: backup.endpoint},
+		},
+	}))
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+	require.NoError(t, exp.ExportSpans(ctx, roSpans))
+	assert.Empty(t, primary.getSpans())
+	assert.Len(t, backup.getSpans(), 1, "no failover to backup endpoint")
+
+	// The primary endpoint is unhealthy until its backoff elapses.
+	require.NoError(t, exp.ExportSpans(ctx, roSpans))
+	assert.Equal(t, 1, primary.traceSvc.requests)
+	assert.Len(t, backup.getSpans(), 2)
+}
+
 func TestCustomUserAgent(t *testing.T) {
 	customUserAgent := "custom-user-agent"
 	mc := runMockCollector(t)
@@ -41,7 +41,10 @@ The configuration can be overridden by [WithTimeout] option.
 
 OTEL_EXPORTER_OTLP_COMPRESSION, OTEL_EXPORTER_OTLP_TRACES_COMPRESSION (default: none) -
 the gRPC compressor the exporter uses.
-Supported value: "gzip".
+Supported values: "gzip", "zstd", "snappy".
+The "zstd" and "snappy" compressors need to be registered with [google.golang.org/grpc/encoding],
+e.g. by importing [go.opentelemetry.io/otel/exporters/otlp/otlpcompress/zstd] or
+[go.opentelemetry.io/otel/exporters/otlp/otlpcompress/snappy].
 OTEL_EXPORTER_OTLP_TRACES_COMPRESSION takes precedence over OTEL_EXPORTER_OTLP_COMPRESSION.
 The configuration can be overridden by [WithCompressor], [WithGRPCConn] options.
 
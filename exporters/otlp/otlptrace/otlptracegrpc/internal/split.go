@@ -0,0 +1,75 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/otlptrace/split.go.tmpl
+
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal"
+
+import (
+	"fmt"
+
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+// RequestSizeError returns an error describing a request of size bytes that
+// cannot be split to fit in the maximum request size of limit bytes.
+func RequestSizeError(size, limit int) error {
+	return fmt.Errorf("request size of %d bytes exceeds the maximum request size of %d bytes", size, limit)
+}
+
+// SplitResourceSpans splits rs into two batches with about the same number of
+// items. The resources are split first. If rs contains a single resource, its
+// scopes are split and, if it contains a single scope, its spans are split.
+//
+// The returned batches share the resources, scopes and spans of rs. False is
+// returned if rs contains only a single span and cannot be split.
+func SplitResourceSpans(rs []*tracepb.ResourceSpans) ([]*tracepb.ResourceSpans, []*tracepb.ResourceSpans, bool) {
+	if a, b, ok := halve(rs); ok || len(rs) == 0 {
+		return a, b, ok
+	}
+
+	r := rs[0]
+	if a, b, ok := halve(r.ScopeSpans); ok {
+		return []*tracepb.ResourceSpans{withScopeSpans(r, a)},
+			[]*tracepb.ResourceSpans{withScopeSpans(r, b)},
+			true
+	}
+	if len(r.ScopeSpans) == 0 {
+		return nil, nil, false
+	}
+
+	s := r.ScopeSpans[0]
+	if a, b, ok := halve(s.Spans); ok {
+		return []*tracepb.ResourceSpans{withScopeSpans(r, []*tracepb.ScopeSpans{withSpans(s, a)})},
+			[]*tracepb.ResourceSpans{withScopeSpans(r, []*tracepb.ScopeSpans{withSpans(s, b)})},
+			true
+	}
+	return nil, nil, false
+}
+
+func withScopeSpans(r *tracepb.ResourceSpans, ss []*tracepb.ScopeSpans) *tracepb.ResourceSpans {
+	return &tracepb.ResourceSpans{
+		Resource:   r.Resource,
+		ScopeSpans: ss,
+		SchemaUrl:  r.SchemaUrl,
+	}
+}
+
+func withSpans(s *tracepb.ScopeSpans, spans []*tracepb.Span) *tracepb.ScopeSpans {
+	return &tracepb.ScopeSpans{
+		Scope:     s.Scope,
+		Spans:     spans,
+		SchemaUrl: s.SchemaUrl,
+	}
+}
+
+// halve splits s into two halves. False is returned if s contains less than
+// two elements.
+func halve[T any](s []T) ([]T, []T, bool) {
+	if len(s) < 2 {
+		return nil, nil, false
+	}
+	n := len(s) / 2
+	return s[:n:n], s[n:], true
+}
@@ -0,0 +1,114 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/otlptrace/split_test.go.tmpl
+
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+var (
+	res   = &resourcepb.Resource{DroppedAttributesCount: 1}
+	scope = &commonpb.InstrumentationScope{Name: "scope"}
+)
+
+func spans(names ...string) []*tracepb.Span {
+	out := make([]*tracepb.Span, len(names))
+	for i, n := range names {
+		out[i] = &tracepb.Span{Name: n}
+	}
+	return out
+}
+
+func scopeSpans(s []*tracepb.Span) *tracepb.ScopeSpans {
+	return &tracepb.ScopeSpans{Scope: scope, Spans: s, SchemaUrl: "s"}
+}
+
+func resourceSpans(ss ...*tracepb.ScopeSpans) []*tracepb.ResourceSpans {
+	rs := &tracepb.ResourceSpans{Resource: res, ScopeSpans: ss, SchemaUrl: "r"}
+	return []*tracepb.ResourceSpans{rs}
+}
+
+func TestSplitResourceSpans(t *testing.T) {
+	r0 := &tracepb.ResourceSpans{SchemaUrl: "0"}
+	r1 := &tracepb.ResourceSpans{SchemaUrl: "1"}
+	r2 := &tracepb.ResourceSpans{SchemaUrl: "2"}
+	s0 := scopeSpans(spans("a"))
+	s1 := scopeSpans(spans("b", "c"))
+	s2 := scopeSpans(spans("d", "e", "f"))
+
+	testCases := []struct {
+		name string
+		rs   []*tracepb.ResourceSpans
+		a, b []*tracepb.ResourceSpans
+		ok   bool
+	}{
+		{
+			name: "Empty",
+		},
+		{
+			name: "SingleSpan",
+			rs:   resourceSpans(s0),
+		},
+		{
+			name: "NoScopes",
+			rs:   []*tracepb.ResourceSpans{r0},
+		},
+		{
+			name: "Resources",
+			rs:   []*tracepb.ResourceSpans{r0, r1, r2},
+			a:    []*tracepb.ResourceSpans{r0},
+			b:    []*tracepb.ResourceSpans{r1, r2},
+			ok:   true,
+		},
+		{
+			name: "Scopes",
+			rs:   resourceSpans(s0, s1),
+			a:    resourceSpans(s0),
+			b:    resourceSpans(s1),
+			ok:   true,
+		},
+		{
+			name: "Spans",
+			rs:   resourceSpans(s2),
+			a:    resourceSpans(scopeSpans(s2.Spans[:1])),
+			b:    resourceSpans(scopeSpans(s2.Spans[1:])),
+			ok:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, b, ok := SplitResourceSpans(tc.rs)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.a, a)
+			assert.Equal(t, tc.b, b)
+		})
+	}
+}
+
+func TestSplitResourceSpansDoesNotAlias(t *testing.T) {
+	r0 := &tracepb.ResourceSpans{SchemaUrl: "0"}
+	r1 := &tracepb.ResourceSpans{SchemaUrl: "1"}
+	rs := []*tracepb.ResourceSpans{r0, r1}
+	a, _, ok := SplitResourceSpans(rs)
+	assert.True(t, ok)
+
+	// Appending to the first half must not overwrite the second one.
+	_ = append(a, &tracepb.ResourceSpans{SchemaUrl: "new"})
+	assert.Same(t, r1, rs[1])
+}
+
+func TestRequestSizeError(t *testing.T) {
+	err := RequestSizeError(10, 5)
+	assert.EqualError(t, err, "request size of 10 bytes exceeds the maximum request size of 5 bytes")
+}
@@ -0,0 +1,222 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/tlsreload/tlsreload.go.tmpl
+
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tlsreload provides a TLS client configuration that loads its
+// certificates from files and reloads them when they change on disk.
+package tlsreload // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/tlsreload"
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+)
+
+// Files are the paths to the PEM encoded files used to configure TLS.
+type Files struct {
+	// CAFile is the path to the CA bundle used to verify the server
+	// certificate. If empty, the server certificate is verified with the
+	// root CAs of the base configuration or, if those are not set, the host's
+	// root CAs.
+	CAFile string
+	// CertFile is the path to the client certificate used for mTLS. It is
+	// only used if KeyFile is also set.
+	CertFile string
+	// KeyFile is the path to the private key of the client certificate used
+	// for mTLS. It is only used if CertFile is also set.
+	KeyFile string
+}
+
+// Empty returns true if no file is set in f.
+func (f Files) Empty() bool {
+	return f == Files{}
+}
+
+// Config returns a copy of base that loads the files in f when a connection
+// is established. The files are reloaded, without restarting the exporter,
+// when their modification time or size changes. If a changed file cannot be
+// loaded, e.g. when only the certificate of a key pair has been rotated yet,
+// the error is passed to the global ErrorHandler and the previously loaded
+// files are used until the files can be loaded again.
+//
+// The server certificate is verified for serverName if base does not set a
+// ServerName. If base is nil, an empty configuration is used.
+func Config(base *tls.Config, serverName string, f Files) *tls.Config {
+	var cfg *tls.Config
+	if base == nil {
+		cfg = &tls.Config{}
+	} else {
+		cfg = base.Clone()
+	}
+	if cfg.ServerName == "" {
+		cfg.ServerName = serverName
+	}
+
+	r := &reloader{files: f}
+	if f.CertFile != "" && f.KeyFile != "" {
+		cfg.Certificates = nil
+		cfg.GetClientCertificate = r.clientCertificate
+	}
+	if f.CAFile != "" && !cfg.InsecureSkipVerify {
+		// The standard verification only uses the static RootCAs. Skip it and
+		// verify the server certificate against the reloaded CA bundle
+		// instead.
+		cfg.InsecureSkipVerify = true
+		cfg.VerifyConnection = r.verifyConnection(cfg.ServerName, cfg.Time, cfg.VerifyConnection)
+	}
+	return cfg
+}
+
+// Host returns the host part of endpoint. It is used as the server name to
+// verify when endpoint is passed to Config.
+func Host(endpoint string) string {
+	host, _, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return endpoint
+	}
+	return host
+}
+
+// stamp identifies the version of a file on disk.
+type stamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func stat(path string) (stamp, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return stamp{}, err
+	}
+	return stamp{modTime: fi.ModTime(), size: fi.Size()}, nil
+}
+
+type reloader struct {
+	files Files
+
+	mu       sync.Mutex
+	caStamp  stamp
+	rootCAs  *x509.CertPool
+	crtStamp [2]stamp
+	crt      *tls.Certificate
+}
+
+// certPool returns the CA bundle, reloading it if it changed on disk.
+func (r *reloader) certPool() (*x509.CertPool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, err := stat(r.files.CAFile)
+	if err == nil && r.rootCAs != nil && s == r.caStamp {
+		return r.rootCAs, nil
+	}
+	if err == nil {
+		var pool *x509.CertPool
+		pool, err = loadCertPool(r.files.CAFile)
+		if err == nil {
+			r.caStamp, r.rootCAs = s, pool
+			return pool, nil
+		}
+	}
+	return fallback(r.rootCAs, err)
+}
+
+// certificate returns the client certificate, reloading it if its
+// certificate or key file changed on disk.
+func (r *reloader) certificate() (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var s [2]stamp
+	var err error
+	s[0], err = stat(r.files.CertFile)
+	if err == nil {
+		s[1], err = stat(r.files.KeyFile)
+	}
+	if err == nil && r.crt != nil && s == r.crtStamp {
+		return r.crt, nil
+	}
+	if err == nil {
+		var crt tls.Certificate
+		crt, err = tls.LoadX509KeyPair(r.files.CertFile, r.files.KeyFile)
+		if err == nil {
+			r.crtStamp, r.crt = s, &crt
+			return r.crt, nil
+		}
+	}
+	return fallback(r.crt, err)
+}
+
+// fallback returns last if it is not nil. Otherwise, err is returned.
+func fallback[T any](last *T, err error) (*T, error) {
+	err = fmt.Errorf("failed to load TLS files: %w", err)
+	if last == nil {
+		return nil, err
+	}
+	otel.Handle(err)
+	return last, nil
+}
+
+func (r *reloader) clientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return r.certificate()
+}
+
+func (r *reloader) verifyConnection(serverName string, now func() time.Time, next func(tls.ConnectionState) error) func(tls.ConnectionState) error {
+	return func(cs tls.ConnectionState) error {
+		if len(cs.PeerCertificates) == 0 {
+			return errors.New("server did not provide a certificate")
+		}
+		name := serverName
+		if name == "" {
+			name = cs.ServerName
+		}
+		if name == "" {
+			return errors.New("no server name to verify the server certificate")
+		}
+
+		roots, err := r.certPool()
+		if err != nil {
+			return err
+		}
+		opts := x509.VerifyOptions{
+			Roots:         roots,
+			DNSName:       name,
+			Intermediates: x509.NewCertPool(),
+		}
+		if now != nil {
+			opts.CurrentTime = now()
+		}
+		for _, crt := range cs.PeerCertificates[1:] {
+			opts.Intermediates.AddCert(crt)
+		}
+		if _, err := cs.PeerCertificates[0].Verify(opts); err != nil {
+			return &tls.CertificateVerificationError{UnverifiedCertificates: cs.PeerCertificates, Err: err}
+		}
+
+		if next != nil {
+			return next(cs)
+		}
+		return nil
+	}
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cp := x509.NewCertPool()
+	if ok := cp.AppendCertsFromPEM(b); !ok {
+		return nil, errors.New("failed to append certificate to the cert pool")
+	}
+	return cp, nil
+}
@@ -4,15 +4,20 @@
 package otlptracegrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/encoding"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/failover"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/otlpconfig"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/tlsreload"
 )
 
 // Option applies an option to the gRPC driver.
@@ -35,6 +40,39 @@ func asGRPCOptions(opts []Option) []otlpconfig.GRPCOption {
 // entirely handled by the gRPC ClientConn.
 type RetryConfig retry.Config
 
+// TLSFiles are the paths to the PEM encoded files used to configure TLS.
+//
+// CAFile is the path to the CA bundle used to verify the server certificate.
+// CertFile and KeyFile are the paths to the client certificate and its
+// private key used for mTLS. They are only used if both are set.
+type TLSFiles tlsreload.Files
+
+// FailoverConfig defines the endpoints the exporter fails over between.
+type FailoverConfig struct {
+	// Endpoints are the endpoints in the order they are preferred.
+	Endpoints []FailoverEndpoint
+	// InitialBackoff is the time an endpoint is not used after it failed.
+	// It is doubled for every consecutive failure of the endpoint up to
+	// MaxBackoff. If zero, 5 seconds is used.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum time an endpoint is not used after it
+	// failed. If zero, 1 minute is used.
+	MaxBackoff time.Duration
+}
+
+// FailoverEndpoint is an endpoint the exporter fails over to.
+type FailoverEndpoint struct {
+	// Endpoint is the host and port of the endpoint. It should resemble
+	// "example.com:4317".
+	Endpoint string
+	// Weight is the relative share of the batches sent to the endpoint
+	// while it is healthy. Batches are distributed between all the healthy
+	// endpoints with a non-zero weight. Endpoints with a zero weight are
+	// backups that are only used, in the order they are configured, when
+	// no endpoint with a weight is healthy.
+	Weight int
+}
+
 type wrappedOption struct {
 	otlpconfig.GRPCOption
 }
@@ -108,19 +146,19 @@ func WithReconnectionPeriod(rp time.Duration) Option {
 	})}
 }
 
-func compressorToCompression(compressor string) otlpconfig.Compression {
-	if compressor == "gzip" {
-		return otlpconfig.GzipCompression
-	}
-
-	otel.Handle(fmt.Errorf("invalid compression type: '%s', using no compression as default", compressor))
-	return otlpconfig.NoCompression
-}
-
 // WithCompressor sets the compressor for the gRPC client to use when sending
-// requests. Supported compressor values: "gzip".
+// requests. The compressor needs to be registered with
+// google.golang.org/grpc/encoding. The "gzip" compressor is registered by
+// default, "zstd" and "snappy" compressors are registered by importing
+// go.opentelemetry.io/otel/exporters/otlp/otlpcompress/zstd and
+// go.opentelemetry.io/otel/exporters/otlp/otlpcompress/snappy respectively.
+// If compressor is not registered, no compressor will be used.
 func WithCompressor(compressor string) Option {
-	return wrappedOption{otlpconfig.WithCompression(compressorToCompression(compressor))}
+	if encoding.GetCompressor(compressor) == nil {
+		otel.Handle(fmt.Errorf("invalid compression type: '%s', using no compression as default", compressor))
+		return wrappedOption{otlpconfig.WithCompression(otlpconfig.NoCompression)}
+	}
+	return wrappedOption{otlpconfig.WithCompressor(compressor)}
 }
 
 // WithHeaders will send the provided headers with each gRPC requests.
@@ -128,6 +166,20 @@ func WithHeaders(headers map[string]string) Option {
 	return wrappedOption{otlpconfig.WithHeaders(headers)}
 }
 
+// HeaderProvider returns headers to send with a gRPC request. The returned
+// headers are sent in addition to, and take precedence over, the headers set
+// with WithHeaders. If an error is returned, the request is not sent and the
+// export fails with the error.
+type HeaderProvider func(context.Context) (map[string]string, error)
+
+// WithHeaderProvider sets a HeaderProvider that is called before every export
+// attempt, including retries, to get the headers sent with the gRPC request.
+// This can be used to send headers whose values change over time, e.g.
+// short-lived bearer tokens.
+func WithHeaderProvider(provider HeaderProvider) Option {
+	return wrappedOption{otlpconfig.WithHeaderProvider(provider)}
+}
+
 // WithTLSCredentials allows the connection to use TLS credentials when
 // talking to the server. It takes in grpc.TransportCredentials instead of say
 // a Certificate file or a tls.Certificate, because the retrieving of these
@@ -138,10 +190,32 @@ func WithHeaders(headers map[string]string) Option {
 func WithTLSCredentials(creds credentials.TransportCredentials) Option {
 	return wrappedOption{otlpconfig.NewGRPCOption(func(cfg otlpconfig.Config) otlpconfig.Config {
 		cfg.Traces.GRPCCredentials = creds
+		cfg.Traces.TLSFiles = tlsreload.Files{}
 		return cfg
 	})}
 }
 
+// WithTLSFiles sets the files the CA bundle, client certificate and client
+// key used for TLS are loaded from. The files are loaded when a connection to
+// the collector is established and are reloaded when their modification time
+// or size changes, so rotated certificates are used without restarting the
+// exporter. If a changed file cannot be loaded, the error is passed to the
+// global ErrorHandler and the previously loaded certificates are used.
+//
+// If the OTEL_EXPORTER_OTLP_CERTIFICATE, OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE
+// and OTEL_EXPORTER_OTLP_CLIENT_KEY environment variables, or their signal
+// specific counterparts, are set, the files they define are also reloaded
+// when changed. The files passed with this option take precedence
+// over those environment variables.
+//
+// Credentials passed with WithTLSCredentials after this option take precedence
+// over the files.
+//
+// This option has no effect if WithGRPCConn is used.
+func WithTLSFiles(files TLSFiles) Option {
+	return wrappedOption{otlpconfig.WithTLSFiles(tlsreload.Files(files))}
+}
+
 // WithServiceConfig defines the default gRPC service config used.
 //
 // This option has no effect if WithGRPCConn is used.
@@ -192,6 +266,64 @@ func WithTimeout(duration time.Duration) Option {
 	return wrappedOption{otlpconfig.WithTimeout(duration)}
 }
 
+// WithMaxRequestSize sets the maximum size, in bytes, of the serialized and
+// uncompressed export request message. A batch of spans that exceeds this size
+// is split into smaller requests before it is sent. If a single span exceeds
+// this size on its own, it is dropped and an error is returned.
+//
+// Regardless of this option, a batch is split and the smaller requests are sent
+// if the target endpoint rejects the batch with a ResourceExhausted status code
+// because the message is larger than its maximum message size.
+//
+// By default, the size of export requests is not limited.
+func WithMaxRequestSize(n int) Option {
+	return wrappedOption{otlpconfig.WithMaxRequestSize(n)}
+}
+
+// WithFailover sets the endpoints the exporter sends batches of spans to
+// instead of the endpoint set with WithEndpoint, WithEndpointURL, or
+// environment variables. A batch is sent to the first healthy endpoint. If
+// that endpoint cannot be reached, or rejects the batch with a status code
+// that is not retryable, it is marked as unhealthy and the batch is sent to
+// the next endpoint. An unhealthy endpoint is used again once its backoff has
+// elapsed, so the exporter returns to a preferred endpoint when it recovers.
+//
+// The headers, TLS, and other settings of the exporter are used for all the
+// endpoints. This option has no effect if WithGRPCConn is used.
+//
+// By default, the exporter does not fail over.
+func WithFailover(settings FailoverConfig) Option {
+	c := failover.Config{
+		Endpoints:      make([]failover.Endpoint, len(settings.Endpoints)),
+		InitialBackoff: settings.InitialBackoff,
+		MaxBackoff:     settings.MaxBackoff,
+	}
+	for i, e := range settings.Endpoints {
+		c.Endpoints[i] = failover.Endpoint(e)
+	}
+	return wrappedOption{otlpconfig.WithFailover(c)}
+}
+
+// WithExportResultHandler sets a handler that is called with the result of the
+// export of every batch of spans. The result reports how many spans the
+// endpoint rejected in partial success responses and why, how many export
+// requests were sent, and whether the batch was dropped. It can be used to
+// measure data loss or to drive a circuit breaker.
+//
+// The handler is called synchronously once the export of a batch, including its
+// retries and split requests, has completed. It needs to return promptly to not
+// block the exporter. Partial success responses are still reported to the
+// global error handler.
+//
+// By default, no handler is called.
+func WithExportResultHandler(h ExportResultHandler) Option {
+	var fn func(internal.ExportResult)
+	if h != nil {
+		fn = func(r internal.ExportResult) { h(newExportResult(r)) }
+	}
+	return wrappedOption{otlpconfig.WithResultHandler(fn)}
+}
+
 // WithRetry sets the retry policy for transient retryable errors that may be
 // returned by the target endpoint when exporting a batch of spans.
 //
@@ -18,18 +18,25 @@ import (
 	"sync"
 	"time"
 
+	"google.golang.org/grpc/encoding"
 	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/failover"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/otlpconfig"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/otlpjson"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/tlsreload"
 	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
 	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
 )
 
-const contentTypeProto = "application/x-protobuf"
+const (
+	contentTypeProto = "application/x-protobuf"
+	contentTypeJSON  = "application/json"
+)
 
 var gzPool = sync.Pool{
 	New: func() interface{} {
@@ -60,6 +67,7 @@ type client struct {
 	cfg         otlpconfig.SignalConfig
 	generalCfg  otlpconfig.Config
 	requestFunc retry.RequestFunc
+	failover    *failover.Set
 	client      *http.Client
 	stopCh      chan struct{}
 	stopOnce    sync.Once
@@ -76,12 +84,22 @@ func NewClient(opts ...Option) otlptrace.Client {
 		Timeout:   cfg.Traces.Timeout,
 	}
 
-	if cfg.Traces.TLSCfg != nil || cfg.Traces.Proxy != nil {
+	tlsCfg := cfg.Traces.TLSCfg
+	if !cfg.Traces.TLSFiles.Empty() {
+		serverName := tlsreload.Host(cfg.Traces.Endpoint)
+		if len(cfg.Traces.Failover.Endpoints) > 0 {
+			// Verify the server certificate for the endpoint connected to.
+			serverName = ""
+		}
+		tlsCfg = tlsreload.Config(tlsCfg, serverName, cfg.Traces.TLSFiles)
+	}
+
+	if tlsCfg != nil || cfg.Traces.Proxy != nil {
 		clonedTransport := ourTransport.Clone()
 		httpClient.Transport = clonedTransport
 
-		if cfg.Traces.TLSCfg != nil {
-			clonedTransport.TLSClientConfig = cfg.Traces.TLSCfg
+		if tlsCfg != nil {
+			clonedTransport.TLSClientConfig = tlsCfg
 		}
 		if cfg.Traces.Proxy != nil {
 			clonedTransport.Proxy = cfg.Traces.Proxy
@@ -94,6 +112,7 @@ func NewClient(opts ...Option) otlptrace.Client {
 		cfg:         cfg.Traces,
 		generalCfg:  cfg,
 		requestFunc: cfg.RetryConfig.RequestFunc(evaluate),
+		failover:    failover.NewSet(cfg.Traces.Failover),
 		stopCh:      stopCh,
 		client:      httpClient,
 	}
@@ -125,23 +144,41 @@ func (d *client) Stop(ctx context.Context) error {
 
 // UploadTraces sends a batch of spans to the collector.
 func (d *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	ctx, cancel := d.contextWithStop(ctx)
+	defer cancel()
+
+	res := new(internal.ExportResult)
+	err := d.upload(ctx, protoSpans, res)
+	if d.cfg.ResultHandler != nil {
+		res.Err = err
+		d.cfg.ResultHandler(*res)
+	}
+	return err
+}
+
+// upload sends protoSpans in a single request. If the request exceeds the
+// maximum request size or is rejected by the server as too large, protoSpans
+// are split and sent in multiple requests. The outcome of the requests is
+// recorded in res.
+func (d *client) upload(ctx context.Context, protoSpans []*tracepb.ResourceSpans, res *internal.ExportResult) error {
 	pbRequest := &coltracepb.ExportTraceServiceRequest{
 		ResourceSpans: protoSpans,
 	}
-	rawRequest, err := proto.Marshal(pbRequest)
+	rawRequest, err := d.marshal(pbRequest)
 	if err != nil {
 		return err
 	}
-
-	ctx, cancel := d.contextWithStop(ctx)
-	defer cancel()
+	if limit := d.cfg.MaxRequestSize; limit > 0 && len(rawRequest) > limit {
+		return d.uploadSplit(ctx, protoSpans, res, internal.RequestSizeError(len(rawRequest), limit))
+	}
 
 	request, err := d.newRequest(rawRequest)
 	if err != nil {
 		return err
 	}
+	header := request.Header
 
-	return d.requestFunc(ctx, func(ctx context.Context) error {
+	send := func(ctx context.Context) error {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
@@ -149,6 +186,16 @@ func (d *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.Resourc
 		}
 
 		request.reset(ctx)
+		if d.cfg.HTTPHeaderProvider != nil {
+			// Do not pass headers provided for a previous attempt.
+			request.Header = header
+			h, err := d.providedHeader(request, header)
+			if err != nil {
+				return err
+			}
+			request.Header = h
+		}
+		res.Attempt()
 		resp, err := d.client.Do(request.Request)
 		var urlErr *url.Error
 		if errors.As(err, &urlErr) && urlErr.Temporary() {
@@ -178,9 +225,16 @@ func (d *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.Resourc
 				return nil
 			}
 
-			if resp.Header.Get("Content-Type") == "application/x-protobuf" {
+			var unmarshal func([]byte, proto.Message) error
+			switch resp.Header.Get("Content-Type") {
+			case contentTypeProto:
+				unmarshal = proto.Unmarshal
+			case contentTypeJSON:
+				unmarshal = otlpjson.Unmarshal
+			}
+			if unmarshal != nil {
 				var respProto coltracepb.ExportTraceServiceResponse
-				if err := proto.Unmarshal(respData.Bytes(), &respProto); err != nil {
+				if err := unmarshal(respData.Bytes(), &respProto); err != nil {
 					return err
 				}
 
@@ -188,6 +242,7 @@ func (d *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.Resourc
 					msg := respProto.PartialSuccess.GetErrorMessage()
 					n := respProto.PartialSuccess.GetRejectedSpans()
 					if n != 0 || msg != "" {
+						res.PartialSuccess(n, msg)
 						err := internal.TracePartialSuccessError(n, msg)
 						otel.Handle(err)
 					}
@@ -220,10 +275,38 @@ func (d *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.Resourc
 				rErr = newResponseError(resp.Header, e)
 			}
 			return rErr
+		case sc == http.StatusRequestEntityTooLarge:
+			// The request is split and sent again by upload.
+			return tooLargeError{err: fmt.Errorf("failed to send to %s: %s", request.URL, resp.Status)}
 		default:
-			return fmt.Errorf("failed to send to %s: %s", request.URL, resp.Status)
+			return statusError{err: fmt.Errorf("failed to send to %s: %s", request.URL, resp.Status)}
 		}
+	}
+	err = d.requestFunc(ctx, func(ctx context.Context) error {
+		if d.failover == nil {
+			return send(ctx)
+		}
+		return d.failover.Do(ctx, func(endpoint string) error {
+			u := *request.URL
+			u.Host = endpoint
+			request.URL, request.Host = &u, endpoint
+			return send(ctx)
+		}, shouldFailover)
 	})
+	if errors.As(err, new(tooLargeError)) {
+		return d.uploadSplit(ctx, protoSpans, res, err)
+	}
+	return err
+}
+
+// uploadSplit splits protoSpans in two and uploads both halves. If
+// protoSpans cannot be split, err is returned.
+func (d *client) uploadSplit(ctx context.Context, protoSpans []*tracepb.ResourceSpans, res *internal.ExportResult, err error) error {
+	a, b, ok := internal.SplitResourceSpans(protoSpans)
+	if !ok {
+		return err
+	}
+	return errors.Join(d.upload(ctx, a, res), d.upload(ctx, b, res))
 }
 
 func (d *client) newRequest(body []byte) (request, error) {
@@ -239,14 +322,15 @@ func (d *client) newRequest(body []byte) (request, error) {
 	for k, v := range d.cfg.Headers {
 		r.Header.Set(k, v)
 	}
-	r.Header.Set("Content-Type", contentTypeProto)
+	r.Header.Set("Content-Type", d.contentType())
 
 	req := request{Request: r}
-	switch Compression(d.cfg.Compression) {
-	case NoCompression:
+	switch name := d.cfg.CompressorName(); name {
+	case "":
 		r.ContentLength = (int64)(len(body))
+		req.body = body
 		req.bodyReader = bodyReader(body)
-	case GzipCompression:
+	case "gzip":
 		// Ensure the content length is not used.
 		r.ContentLength = -1
 		r.Header.Set("Content-Encoding", "gzip")
@@ -265,12 +349,77 @@ func (d *client) newRequest(body []byte) (request, error) {
 			return req, err
 		}
 
-		req.bodyReader = bodyReader(b.Bytes())
+		req.body = b.Bytes()
+		req.bodyReader = bodyReader(req.body)
+	default:
+		b, err := compress(name, body)
+		if err != nil {
+			return req, err
+		}
+
+		// Ensure the content length is not used.
+		r.ContentLength = -1
+		r.Header.Set("Content-Encoding", name)
+		req.body = b
+		req.bodyReader = bodyReader(b)
 	}
 
 	return req, nil
 }
 
+// compress returns body compressed with the compressor registered with
+// google.golang.org/grpc/encoding under name.
+func compress(name string, body []byte) ([]byte, error) {
+	c := encoding.GetCompressor(name)
+	if c == nil {
+		return nil, fmt.Errorf("compressor not registered: %s", name)
+	}
+
+	var b bytes.Buffer
+	w, err := c.Compress(&b)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(body); err != nil {
+		return nil, err
+	}
+	// Close needs to be called to ensure body is fully written.
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// providedHeader returns a copy of header updated with the headers returned
+// by the configured header provider for r.
+func (d *client) providedHeader(r request, header http.Header) (http.Header, error) {
+	headers, err := d.cfg.HTTPHeaderProvider(r.Request, r.body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get headers: %w", err)
+	}
+	h := header.Clone()
+	for k, v := range headers {
+		h.Set(k, v)
+	}
+	return h, nil
+}
+
+// marshal returns the encoding of m with the configured encoding.
+func (d *client) marshal(m proto.Message) ([]byte, error) {
+	if d.cfg.Encoding == otlpconfig.JSONEncoding {
+		return otlpjson.Marshal(m)
+	}
+	return proto.Marshal(m)
+}
+
+// contentType returns the content type of the configured encoding.
+func (d *client) contentType() string {
+	if d.cfg.Encoding == otlpconfig.JSONEncoding {
+		return contentTypeJSON
+	}
+	return contentTypeProto
+}
+
 // MarshalLog is the marshaling function used by the logging system to represent this Client.
 func (d *client) MarshalLog() interface{} {
 	return struct {
@@ -295,6 +444,8 @@ func bodyReader(buf []byte) func() io.ReadCloser {
 type request struct {
 	*http.Request
 
+	// body is the content of the request Body.
+	body []byte
 	// bodyReader allows the same body to be used for multiple requests.
 	bodyReader func() io.ReadCloser
 }
@@ -352,6 +503,45 @@ func (e retryableError) As(target interface{}) bool {
 	}
 }
 
+// tooLargeError represents a request rejected by the server because it is
+// too large.
+type tooLargeError struct {
+	err error
+}
+
+func (e tooLargeError) Error() string {
+	return e.err.Error()
+}
+
+func (e tooLargeError) Unwrap() error {
+	return e.err
+}
+
+// statusError represents a request rejected by the server with a status
+// code that is not retryable.
+type statusError struct {
+	err error
+}
+
+func (e statusError) Error() string {
+	return e.err.Error()
+}
+
+func (e statusError) Unwrap() error {
+	return e.err
+}
+
+// shouldFailover returns if err identifies a request that should be sent to
+// the next failover endpoint: the endpoint could not be connected to or it
+// rejected the request with a status code that is not retryable.
+func shouldFailover(err error) bool {
+	if errors.As(err, new(statusError)) {
+		return true
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
 // evaluate returns if err is retry-able. If it is and it includes an explicit
 // throttling delay, that delay is also returned.
 func evaluate(err error) (bool, time.Duration) {
@@ -4,22 +4,36 @@
 package otlptracehttp_test
 
 import (
+	"compress/flate"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/otlptracetest"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
 )
 
@@ -28,6 +42,27 @@ const (
 	otherTracesPath    = "/post/traces/here"
 )
 
+// testCompressorName is the name of the compressor registered to test custom
+// compression.
+const testCompressorName = "otlp-test-deflate"
+
+func init() {
+	encoding.RegisterCompressor(deflateCompressor{})
+}
+
+// deflateCompressor is a custom compressor using the DEFLATE format.
+type deflateCompressor struct{}
+
+func (deflateCompressor) Name() string { return testCompressorName }
+
+func (deflateCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return flate.NewWriter(w, flate.DefaultCompression)
+}
+
+func (deflateCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	return flate.NewReader(r), nil
+}
+
 var (
 	testHeaders = map[string]string{
 		"Otel-Go-Key-1": "somevalue",
@@ -65,6 +100,25 @@ func TestEndToEnd(t *testing.T) {
 				otlptracehttp.WithCompression(otlptracehttp.GzipCompression),
 			},
 		},
+		{
+			name: "with JSON encoding",
+			opts: []otlptracehttp.Option{
+				otlptracehttp.WithEncoding(otlptracehttp.JSONEncoding),
+			},
+		},
+		{
+			name: "with JSON encoding and gzip compression",
+			opts: []otlptracehttp.Option{
+				otlptracehttp.WithEncoding(otlptracehttp.JSONEncoding),
+				otlptracehttp.WithCompression(otlptracehttp.GzipCompression),
+			},
+		},
+		{
+			name: "with custom compressor",
+			opts: []otlptracehttp.Option{
+				otlptracehttp.WithCompressor(testCompressorName),
+			},
+		},
 		{
 			name: "retry",
 			opts: []otlptracehttp.Option{
@@ -203,6 +257,142 @@ func TestEndToEnd(t *testing.T) {
 	}
 }
 
+// sign returns a signature of the method, URL path, and body of a request.
+func sign(method, path string, body []byte) string {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s %s\n", method, path)
+	_, _ = h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func TestHeaderProvider(t *testing.T) {
+	var (
+		mu  sync.Mutex
+		got []http.Header
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, sign(r.Method, r.URL.Path, body), r.Header.Get("Signature"), "invalid signature")
+
+		mu.Lock()
+		got = append(got, r.Header.Clone())
+		n := len(got)
+		mu.Unlock()
+		if n == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	var calls int
+	provider := func(r *http.Request, body []byte) (map[string]string, error) {
+		calls++
+		assert.Equal(t, "static", r.Header.Get("Authorization"), "provided headers passed to provider")
+		return map[string]string{
+			"Authorization": fmt.Sprintf("Bearer token-%d", calls),
+			"Signature":     sign(r.Method, r.URL.Path, body),
+		}, nil
+	}
+	client := otlptracehttp.NewClient(
+		otlptracehttp.WithEndpointURL(srv.URL),
+		otlptracehttp.WithHeaders(map[string]string{"Authorization": "static", "Static": "value"}),
+		otlptracehttp.WithCompression(otlptracehttp.GzipCompression),
+		otlptracehttp.WithHeaderProvider(provider),
+		otlptracehttp.WithRetry(otlptracehttp.RetryConfig{
+			Enabled:         true,
+			InitialInterval: time.Nanosecond,
+			MaxInterval:     time.Nanosecond,
+			MaxElapsedTime:  time.Minute,
+		}),
+	)
+	ctx := context.Background()
+	exporter, err := otlptrace.New(ctx, client)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, exporter.Shutdown(ctx)) })
+	require.NoError(t, exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, got, 2)
+	for i, h := range got {
+		assert.Equal(t, fmt.Sprintf("Bearer token-%d", i+1), h.Get("Authorization"))
+		assert.Equal(t, "value", h.Get("Static"))
+	}
+}
+
+func TestHeaderProviderError(t *testing.T) {
+	mc := runMockCollector(t, mockCollectorConfig{})
+	defer mc.MustStop(t)
+	client := otlptracehttp.NewClient(
+		otlptracehttp.WithEndpoint(mc.Endpoint()),
+		otlptracehttp.WithInsecure(),
+		otlptracehttp.WithHeaderProvider(func(*http.Request, []byte) (map[string]string, error) {
+			return nil, errors.New("token unavailable")
+		}),
+	)
+	ctx := context.Background()
+	exporter, err := otlptrace.New(ctx, client)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, exporter.Shutdown(ctx))
+	}()
+	err = exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan())
+	assert.ErrorContains(t, err, "token unavailable")
+	assert.Empty(t, mc.GetSpans())
+}
+
+func TestTLSFiles(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte("not yet written"), 0o600))
+
+	client := otlptracehttp.NewClient(
+		otlptracehttp.WithEndpoint(srv.Listener.Addr().String()),
+		otlptracehttp.WithTLSFiles(otlptracehttp.TLSFiles{CAFile: caFile}),
+		otlptracehttp.WithRetry(otlptracehttp.RetryConfig{Enabled: false}),
+	)
+	ctx := context.Background()
+	exporter, err := otlptrace.New(ctx, client)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, exporter.Shutdown(ctx)) })
+
+	err = exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan())
+	assert.ErrorContains(t, err, "failed to load TLS files")
+
+	// The CA bundle is written after the exporter was created.
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caFile, ca, 0o600))
+	assert.NoError(t, exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+}
+
+func TestUnregisteredCompressor(t *testing.T) {
+	mc := runMockCollector(t, mockCollectorConfig{})
+	defer mc.MustStop(t)
+	client := otlptracehttp.NewClient(
+		otlptracehttp.WithEndpoint(mc.Endpoint()),
+		otlptracehttp.WithInsecure(),
+		otlptracehttp.WithCompression(otlptracehttp.ZstdCompression),
+		otlptracehttp.WithCompressor("unregistered"),
+	)
+	ctx := context.Background()
+	exporter, err := otlptrace.New(ctx, client)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, exporter.Shutdown(ctx))
+	}()
+	// Payloads are sent uncompressed.
+	err = exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan())
+	assert.NoError(t, err)
+	assert.Len(t, mc.GetSpans(), 1)
+}
+
 func TestExporterShutdown(t *testing.T) {
 	mc := runMockCollector(t, mockCollectorConfig{})
 	defer func() {
@@ -271,6 +461,155 @@ func TestNoRetry(t *testing.T) {
 	assert.Empty(t, mc.GetSpans())
 }
 
+func TestSplitRequestTooLarge(t *testing.T) {
+	mc := runMockCollector(t, mockCollectorConfig{
+		InjectHTTPStatus: []int{http.StatusRequestEntityTooLarge},
+	})
+	defer mc.MustStop(t)
+	driver := otlptracehttp.NewClient(
+		otlptracehttp.WithEndpoint(mc.Endpoint()),
+		otlptracehttp.WithInsecure(),
+	)
+	ctx := context.Background()
+	exporter, err := otlptrace.New(ctx, driver)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, exporter.Shutdown(ctx))
+	}()
+
+	spans := tracetest.SpanStubs{{Name: "0"}, {Name: "1"}, {Name: "2"}}.Snapshots()
+	require.NoError(t, exporter.ExportSpans(ctx, spans))
+	assert.Len(t, mc.GetSpans(), 3)
+}
+
+func TestSplitRequestTooLargeUnsplittable(t *testing.T) {
+	mc := runMockCollector(t, mockCollectorConfig{
+		InjectHTTPStatus: []int{http.StatusRequestEntityTooLarge},
+	})
+	defer mc.MustStop(t)
+	driver := otlptracehttp.NewClient(
+		otlptracehttp.WithEndpoint(mc.Endpoint()),
+		otlptracehttp.WithInsecure(),
+	)
+	ctx := context.Background()
+	exporter, err := otlptrace.New(ctx, driver)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, exporter.Shutdown(ctx))
+	}()
+
+	err = exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan())
+	want := fmt.Sprintf("failed to send to http://%s/v1/traces: 413 Request Entity Too Large", mc.endpoint)
+	assert.EqualError(t, errors.Unwrap(err), want)
+	assert.Empty(t, mc.GetSpans())
+}
+
+func TestMaxRequestSize(t *testing.T) {
+	mc := runMockCollector(t, mockCollectorConfig{})
+	defer mc.MustStop(t)
+	driver := otlptracehttp.NewClient(
+		otlptracehttp.WithEndpoint(mc.Endpoint()),
+		otlptracehttp.WithInsecure(),
+		otlptracehttp.WithMaxRequestSize(512),
+	)
+	ctx := context.Background()
+	exporter, err := otlptrace.New(ctx, driver)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, exporter.Shutdown(ctx))
+	}()
+
+	large := attribute.String("large", strings.Repeat("x", 1024))
+	spans := tracetest.SpanStubs{
+		{Name: "0", Attributes: []attribute.KeyValue{large}},
+		{Name: "1"},
+		{Name: "2"},
+	}.Snapshots()
+	err = exporter.ExportSpans(ctx, spans)
+	assert.ErrorContains(t, err, "exceeds the maximum request size of 512 bytes")
+
+	got := mc.GetSpans()
+	require.Len(t, got, 2, "spans within the maximum request size not exported")
+	assert.Equal(t, "1", got[0].Name)
+	assert.Equal(t, "2", got[1].Name)
+}
+
+func TestFailover(t *testing.T) {
+	primary := runMockCollector(t, mockCollectorConfig{})
+	backup := runMockCollector(t, mockCollectorConfig{})
+	defer backup.MustStop(t)
+	driver := otlptracehttp.NewClient(
+		otlptracehttp.WithInsecure(),
+		otlptracehttp.WithFailover(otlptracehttp.FailoverConfig{
+			Endpoints: []otlptracehttp.FailoverEndpoint{
+				{Endpoint: primary.Endpoint()},
+				{Endpoint: backup.Endpoint()},
+			},
+			InitialBackoff: 10 * time.Millisecond,
+			MaxBackoff:     10 * time.Millisecond,
+		}),
+	)
+	ctx := context.Background()
+	exporter, err := otlptrace.New(ctx, driver)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, exporter.Shutdown(ctx))
+	}()
+
+	require.NoError(t, exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+	assert.Len(t, primary.GetSpans(), 1, "primary endpoint not used")
+	assert.Empty(t, backup.GetSpans())
+
+	primary.MustStop(t)
+	require.NoError(t, exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+	assert.Len(t, backup.GetSpans(), 1, "no failover to backup endpoint")
+
+	// Restart the primary endpoint and wait for the exporter to return to it.
+	_, port, err := net.SplitHostPort(primary.Endpoint())
+	require.NoError(t, err)
+	p, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	primary = runMockCollector(t, mockCollectorConfig{Port: p})
+	defer primary.MustStop(t)
+	assert.Eventually(t, func() bool {
+		require.NoError(t, exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+		return len(primary.GetSpans()) > 0
+	}, 10*time.Second, 50*time.Millisecond, "no return to recovered primary endpoint")
+}
+
+func TestFailoverNonRetryable(t *testing.T) {
+	primary := runMockCollector(t, mockCollectorConfig{
+		InjectHTTPStatus: []int{http.StatusBadRequest},
+	})
+	defer primary.MustStop(t)
+	backup := runMockCollector(t, mockCollectorConfig{})
+	defer backup.MustStop(t)
+	driver := otlptracehttp.NewClient(
+		otlptracehttp.WithInsecure(),
+		otlptracehttp.WithFailover(otlptracehttp.FailoverConfig{
+			Endpoints: []otlptracehttp.FailoverEndpoint{
+				{Endpoint: primary.Endpoint()},
+				{Endpoint: backup.Endpoint()},
+			},
+		}),
+	)
+	ctx := context.Background()
+	exporter, err := otlptrace.New(ctx, driver)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, exporter.Shutdown(ctx))
+	}()
+
+	require.NoError(t, exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+	assert.Empty(t, primary.GetSpans())
+	assert.Len(t, backup.GetSpans(), 1, "no failover to backup endpoint")
+
+	// The primary endpoint is unhealthy until its backoff elapses.
+	require.NoError(t, exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+	assert.Empty(t, primary.GetSpans())
+	assert.Len(t, backup.GetSpans(), 2)
+}
+
 func TestEmptyData(t *testing.T) {
 	mcCfg := mockCollectorConfig{}
 	mc := runMockCollector(t, mcCfg)
@@ -386,35 +725,105 @@ func TestStopWhileExportingConcurrentSafe(t *testing.T) {
 }
 
 func TestPartialSuccess(t *testing.T) {
-	mcCfg := mockCollectorConfig{
-		Partial: &coltracepb.ExportTracePartialSuccess{
-			RejectedSpans: 2,
-			ErrorMessage:  "partially successful",
-		},
+	for _, encoding := range []otlptracehttp.Encoding{
+		otlptracehttp.ProtobufEncoding,
+		otlptracehttp.JSONEncoding,
+	} {
+		t.Run(fmt.Sprintf("encoding_%d", encoding), func(t *testing.T) {
+			mcCfg := mockCollectorConfig{
+				Partial: &coltracepb.ExportTracePartialSuccess{
+					RejectedSpans: 2,
+					ErrorMessage:  "partially successful",
+				},
+			}
+			mc := runMockCollector(t, mcCfg)
+			defer mc.MustStop(t)
+			driver := otlptracehttp.NewClient(
+				otlptracehttp.WithEndpoint(mc.Endpoint()),
+				otlptracehttp.WithInsecure(),
+				otlptracehttp.WithEncoding(encoding),
+			)
+			ctx := context.Background()
+			exporter, err := otlptrace.New(ctx, driver)
+			require.NoError(t, err)
+			defer func() {
+				assert.NoError(t, exporter.Shutdown(context.Background()))
+			}()
+
+			errs := []error{}
+			otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+				errs = append(errs, err)
+			}))
+			err = exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan())
+			assert.NoError(t, err)
+
+			require.Equal(t, 1, len(errs))
+			require.Contains(t, errs[0].Error(), "partially successful")
+			require.Contains(t, errs[0].Error(), "2 spans rejected")
+		})
 	}
-	mc := runMockCollector(t, mcCfg)
-	defer mc.MustStop(t)
-	driver := otlptracehttp.NewClient(
-		otlptracehttp.WithEndpoint(mc.Endpoint()),
-		otlptracehttp.WithInsecure(),
-	)
-	ctx := context.Background()
-	exporter, err := otlptrace.New(ctx, driver)
-	require.NoError(t, err)
-	defer func() {
-		assert.NoError(t, exporter.Shutdown(context.Background()))
-	}()
+}
 
-	errs := []error{}
-	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
-		errs = append(errs, err)
-	}))
-	err = exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan())
-	assert.NoError(t, err)
+func TestExportResultHandler(t *testing.T) {
+	newExporter := func(t *testing.T, mc *mockCollector, h otlptracehttp.ExportResultHandler) *otlptrace.Exporter {
+		driver := otlptracehttp.NewClient(
+			otlptracehttp.WithEndpoint(mc.Endpoint()),
+			otlptracehttp.WithInsecure(),
+			otlptracehttp.WithRetry(otlptracehttp.RetryConfig{
+				Enabled:         true,
+				InitialInterval: time.Nanosecond,
+				MaxInterval:     time.Nanosecond,
+				MaxElapsedTime:  time.Minute,
+			}),
+			otlptracehttp.WithExportResultHandler(h),
+		)
+		exporter, err := otlptrace.New(context.Background(), driver)
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, exporter.Shutdown(context.Background())) })
+		return exporter
+	}
+
+	t.Run("PartialSuccess", func(t *testing.T) {
+		mc := runMockCollector(t, mockCollectorConfig{
+			InjectHTTPStatus: []int{http.StatusServiceUnavailable},
+			Partial: &coltracepb.ExportTracePartialSuccess{
+				RejectedSpans: 2,
+				ErrorMessage:  "partially successful",
+			},
+		})
+		defer mc.MustStop(t)
+
+		var results []otlptracehttp.ExportResult
+		exporter := newExporter(t, mc, func(r otlptracehttp.ExportResult) {
+			results = append(results, r)
+		})
+		require.NoError(t, exporter.ExportSpans(context.Background(), otlptracetest.SingleReadOnlySpan()))
 
-	require.Equal(t, 1, len(errs))
-	require.Contains(t, errs[0].Error(), "partially successful")
-	require.Contains(t, errs[0].Error(), "2 spans rejected")
+		assert.Equal(t, []otlptracehttp.ExportResult{{
+			Disposition:      otlptracehttp.ExportPartiallyAccepted,
+			Attempts:         2,
+			Rejected:         2,
+			RejectedMessages: []string{"partially successful"},
+		}}, results)
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		mc := runMockCollector(t, mockCollectorConfig{
+			InjectHTTPStatus: []int{http.StatusBadRequest},
+		})
+		defer mc.MustStop(t)
+
+		var results []otlptracehttp.ExportResult
+		exporter := newExporter(t, mc, func(r otlptracehttp.ExportResult) {
+			results = append(results, r)
+		})
+		require.Error(t, exporter.ExportSpans(context.Background(), otlptracetest.SingleReadOnlySpan()))
+
+		require.Len(t, results, 1)
+		assert.Equal(t, otlptracehttp.ExportFailed, results[0].Disposition)
+		assert.Equal(t, 1, results[0].Attempts)
+		assert.ErrorContains(t, results[0].Err, "400 Bad Request")
+	})
 }
 
 func TestOtherHTTPSuccess(t *testing.T) {
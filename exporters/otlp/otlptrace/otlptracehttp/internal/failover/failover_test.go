@@ -0,0 +1,221 @@
+// Code created by gotmpl. DO NOT MODIFY.
+// source: internal/shared/otlp/failover/failover_test.go.tmpl
+
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package failover
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	errConn     = errors.New("connection refused")
+	errRejected = errors.New("rejected")
+)
+
+func isConnErr(err error) bool { return errors.Is(err, errConn) }
+
+// endpoints returns unweighted endpoints with the addresses.
+func endpoints(addresses ...string) []Endpoint {
+	out := make([]Endpoint, len(addresses))
+	for i, a := range addresses {
+		out[i].Endpoint = a
+	}
+	return out
+}
+
+// newTestSet returns a Set of c with a clock that is advanced by calling the
+// returned function.
+func newTestSet(c Config) (*Set, func(time.Duration)) {
+	s := NewSet(c)
+	now := time.Unix(0, 0)
+	s.now = func() time.Time { return now }
+	return s, func(d time.Duration) { now = now.Add(d) }
+}
+
+// export returns the endpoints tried by s until one that is not down
+// succeeds.
+func export(s *Set, down ...string) ([]string, error) {
+	var tried []string
+	err := s.Do(context.Background(), func(endpoint string) error {
+		tried = append(tried, endpoint)
+		for _, d := range down {
+			if d == endpoint {
+				return errConn
+			}
+		}
+		return nil
+	}, isConnErr)
+	return tried, err
+}
+
+func TestNewSetEmpty(t *testing.T) {
+	assert.Nil(t, NewSet(Config{}))
+}
+
+func TestNewSetDefaults(t *testing.T) {
+	s := NewSet(Config{Endpoints: endpoints("a", "b")})
+	assert.Equal(t, DefaultInitialBackoff, s.initialBackoff)
+	assert.Equal(t, DefaultMaxBackoff, s.maxBackoff)
+	assert.Equal(t, []string{"a", "b"}, s.Endpoints())
+}
+
+func TestSetOrdered(t *testing.T) {
+	s, advance := newTestSet(Config{
+		Endpoints:      endpoints("a", "b", "c"),
+		InitialBackoff: time.Second,
+		MaxBackoff:     4 * time.Second,
+	})
+
+	tried, err := export(s)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a"}, tried, "primary")
+
+	tried, err = export(s, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, tried, "failover")
+
+	tried, err = export(s, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b"}, tried, "unhealthy primary is skipped")
+
+	advance(time.Second)
+	tried, err = export(s, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, tried, "primary is retried after backoff")
+
+	advance(time.Second)
+	tried, err = export(s)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b"}, tried, "backoff is doubled")
+
+	advance(time.Second)
+	tried, err = export(s)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a"}, tried, "return to recovered primary")
+
+	tried, err = export(s)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a"}, tried, "recovered primary is healthy")
+}
+
+func TestSetAllUnhealthy(t *testing.T) {
+	s, _ := newTestSet(Config{
+		Endpoints: endpoints("a", "b"),
+	})
+
+	tried, err := export(s, "a", "b")
+	assert.ErrorIs(t, err, errConn)
+	assert.Equal(t, []string{"a", "b"}, tried)
+
+	tried, err = export(s, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, tried, "unhealthy endpoints are still tried")
+}
+
+func TestSetNoFailover(t *testing.T) {
+	s, _ := newTestSet(Config{
+		Endpoints: endpoints("a", "b"),
+	})
+
+	var tried []string
+	err := s.Do(context.Background(), func(endpoint string) error {
+		tried = append(tried, endpoint)
+		return errRejected
+	}, isConnErr)
+	assert.ErrorIs(t, err, errRejected)
+	assert.Equal(t, []string{"a"}, tried)
+
+	tried, err = export(s)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a"}, tried, "endpoint is still healthy")
+}
+
+func TestSetContextDone(t *testing.T) {
+	s, _ := newTestSet(Config{
+		Endpoints: endpoints("a", "b"),
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var tried []string
+	err := s.Do(ctx, func(endpoint string) error {
+		tried = append(tried, endpoint)
+		cancel()
+		return errConn
+	}, isConnErr)
+	assert.ErrorIs(t, err, errConn)
+	assert.Equal(t, []string{"a"}, tried)
+
+	tried, err = export(s)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a"}, tried, "endpoint is still healthy")
+}
+
+func TestSetMaxBackoff(t *testing.T) {
+	s, advance := newTestSet(Config{
+		Endpoints:      endpoints("a", "b"),
+		InitialBackoff: time.Second,
+		MaxBackoff:     3 * time.Second,
+	})
+
+	for i := 0; i < 4; i++ {
+		_, _ = export(s, "a")
+		advance(3 * time.Second)
+	}
+	tried, err := export(s)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a"}, tried)
+}
+
+func TestSetWeighted(t *testing.T) {
+	s, _ := newTestSet(Config{
+		Endpoints: []Endpoint{
+			{Endpoint: "a", Weight: 1},
+			{Endpoint: "b", Weight: 3},
+			{Endpoint: "c"},
+		},
+	})
+
+	var n int
+	s.intn = func(int) int { return n }
+
+	tests := []struct {
+		n    int
+		want []string
+	}{
+		{n: 0, want: []string{"a", "b", "c"}},
+		{n: 1, want: []string{"b", "a", "c"}},
+		{n: 3, want: []string{"b", "a", "c"}},
+	}
+	for _, tt := range tests {
+		n = tt.n
+		tried, err := export(s, "a", "b")
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, tried, "n=%d", tt.n)
+
+		// Recover the weighted endpoints.
+		for _, e := range s.endpoints {
+			s.succeeded(e)
+		}
+	}
+
+	// The backup is only used while no weighted endpoint is healthy.
+	tried, err := export(s, "b")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b", "a"}, tried)
+
+	tried, err = export(s, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "c"}, tried)
+
+	tried, err = export(s)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c"}, tried)
+}
@@ -7,6 +7,7 @@
 package otlpconfig // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/otlpconfig"
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"net/http"
@@ -19,10 +20,15 @@ import (
 	"google.golang.org/grpc/backoff"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding"
 	"google.golang.org/grpc/encoding/gzip"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/failover"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/tlsreload"
 	"go.opentelemetry.io/otel/internal/global"
 )
 
@@ -49,6 +55,41 @@ type (
 		Timeout     time.Duration
 		URLPath     string
 
+		// Compressor is the name of a compressor registered with
+		// google.golang.org/grpc/encoding. If set, it takes precedence over
+		// Compression.
+		Compressor string
+
+		// HeaderProvider returns headers sent with each export request,
+		// including retries, in addition to Headers.
+		HeaderProvider func(context.Context) (map[string]string, error)
+
+		// TLSFiles are the TLS files loaded, and reloaded when changed, on
+		// every connection. If set, they take precedence over the
+		// certificates of TLSCfg.
+		TLSFiles tlsreload.Files
+
+		// MaxRequestSize is the maximum size in bytes of a serialized export
+		// request. Larger requests are split before they are sent. If zero,
+		// the size is not limited.
+		MaxRequestSize int
+
+		// Failover are the endpoints the exporter fails over between. If
+		// set, they are used instead of Endpoint.
+		Failover failover.Config
+
+		// ResultHandler is called with the result of the export of every
+		// batch, including its retries and split requests.
+		ResultHandler func(internal.ExportResult)
+
+		// HTTP configurations
+		Encoding Encoding
+
+		// HTTPHeaderProvider returns headers sent with each HTTP export
+		// request, including retries, in addition to Headers. It is passed
+		// the request about to be sent and the request body.
+		HTTPHeaderProvider func(*http.Request, []byte) (map[string]string, error)
+
 		// gRPC configurations
 		GRPCCredentials credentials.TransportCredentials
 
@@ -69,6 +110,38 @@ type (
 	}
 )
 
+// CompressorName returns the name of the compressor registered with
+// google.golang.org/grpc/encoding used to compress payloads. An empty string
+// is returned if payloads are not compressed.
+func (c SignalConfig) CompressorName() string {
+	if c.Compressor != "" {
+		return c.Compressor
+	}
+	switch c.Compression {
+	case GzipCompression:
+		return gzip.Name
+	case ZstdCompression:
+		return "zstd"
+	case SnappyCompression:
+		return "snappy"
+	}
+	return ""
+}
+
+// withRegisteredCompressor returns c with compression disabled if the
+// compressor it uses is not registered with google.golang.org/grpc/encoding.
+// This is reported with otel.Handle.
+func (c SignalConfig) withRegisteredCompressor() SignalConfig {
+	name := c.CompressorName()
+	if name == "" || encoding.GetCompressor(name) != nil {
+		return c
+	}
+	otel.Handle(fmt.Errorf("compressor not registered: %s, using no compression", name))
+	c.Compression = NoCompression
+	c.Compressor = ""
+	return c
+}
+
 // NewHTTPConfig returns a new Config with all settings applied from opts and
 // any unset setting using the default HTTP config values.
 func NewHTTPConfig(opts ...HTTPOption) Config {
@@ -85,6 +158,7 @@ func NewHTTPConfig(opts ...HTTPOption) Config {
 	for _, opt := range opts {
 		cfg = opt.ApplyHTTPOption(cfg)
 	}
+	cfg.Traces = cfg.Traces.withRegisteredCompressor()
 	cfg.Traces.URLPath = cleanPath(cfg.Traces.URLPath, DefaultTracesPath)
 	return cfg
 }
@@ -121,10 +195,20 @@ func NewGRPCConfig(opts ...GRPCOption) Config {
 	for _, opt := range opts {
 		cfg = opt.ApplyGRPCOption(cfg)
 	}
+	cfg.Traces = cfg.Traces.withRegisteredCompressor()
 
 	if cfg.ServiceConfig != "" {
 		cfg.DialOptions = append(cfg.DialOptions, grpc.WithDefaultServiceConfig(cfg.ServiceConfig))
 	}
+	if !cfg.Traces.TLSFiles.Empty() {
+		serverName := tlsreload.Host(cfg.Traces.Endpoint)
+		if len(cfg.Traces.Failover.Endpoints) > 0 {
+			// Verify the server certificate for the endpoint connected to.
+			serverName = ""
+		}
+		tlsCfg := tlsreload.Config(cfg.Traces.TLSCfg, serverName, cfg.Traces.TLSFiles)
+		cfg.Traces.GRPCCredentials = credentials.NewTLS(tlsCfg)
+	}
 	// Priroritize GRPCCredentials over Insecure (passing both is an error).
 	if cfg.Traces.GRPCCredentials != nil {
 		cfg.DialOptions = append(cfg.DialOptions, grpc.WithTransportCredentials(cfg.Traces.GRPCCredentials))
@@ -136,8 +220,8 @@ func NewGRPCConfig(opts ...GRPCOption) Config {
 		cfg.Traces.GRPCCredentials = creds
 		cfg.DialOptions = append(cfg.DialOptions, grpc.WithTransportCredentials(creds))
 	}
-	if cfg.Traces.Compression == GzipCompression {
-		cfg.DialOptions = append(cfg.DialOptions, grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
+	if name := cfg.Traces.CompressorName(); name != "" {
+		cfg.DialOptions = append(cfg.DialOptions, grpc.WithDefaultCallOptions(grpc.UseCompressor(name)))
 	}
 	if cfg.ReconnectionPeriod != 0 {
 		p := grpc.ConnectParams{
@@ -289,6 +373,21 @@ func WithEndpointURL(v string) GenericOption {
 func WithCompression(compression Compression) GenericOption {
 	return newGenericOption(func(cfg Config) Config {
 		cfg.Traces.Compression = compression
+		cfg.Traces.Compressor = ""
+		return cfg
+	})
+}
+
+func WithCompressor(name string) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.Compressor = name
+		return cfg
+	})
+}
+
+func WithEncoding(encoding Encoding) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.Encoding = encoding
 		return cfg
 	})
 }
@@ -310,9 +409,20 @@ func WithRetry(rc retry.Config) GenericOption {
 func WithTLSClientConfig(tlsCfg *tls.Config) GenericOption {
 	return newSplitOption(func(cfg Config) Config {
 		cfg.Traces.TLSCfg = tlsCfg.Clone()
+		cfg.Traces.TLSFiles = tlsreload.Files{}
 		return cfg
 	}, func(cfg Config) Config {
 		cfg.Traces.GRPCCredentials = credentials.NewTLS(tlsCfg)
+		cfg.Traces.TLSFiles = tlsreload.Files{}
+		return cfg
+	})
+}
+
+// WithTLSFiles sets the TLS files to load on every connection. The
+// certificates are reloaded when the files change on disk.
+func WithTLSFiles(files tlsreload.Files) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.TLSFiles = files
 		return cfg
 	})
 }
@@ -338,6 +448,20 @@ func WithHeaders(headers map[string]string) GenericOption {
 	})
 }
 
+func WithHeaderProvider(fn func(context.Context) (map[string]string, error)) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.HeaderProvider = fn
+		return cfg
+	})
+}
+
+func WithHTTPHeaderProvider(fn func(*http.Request, []byte) (map[string]string, error)) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.HTTPHeaderProvider = fn
+		return cfg
+	})
+}
+
 func WithTimeout(duration time.Duration) GenericOption {
 	return newGenericOption(func(cfg Config) Config {
 		cfg.Traces.Timeout = duration
@@ -345,6 +469,27 @@ func WithTimeout(duration time.Duration) GenericOption {
 	})
 }
 
+func WithMaxRequestSize(n int) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.MaxRequestSize = n
+		return cfg
+	})
+}
+
+func WithFailover(c failover.Config) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.Failover = c
+		return cfg
+	})
+}
+
+func WithResultHandler(h func(internal.ExportResult)) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.ResultHandler = h
+		return cfg
+	})
+}
+
 func WithProxy(pf HTTPTransportProxyFunc) GenericOption {
 	return newGenericOption(func(cfg Config) Config {
 		cfg.Traces.Proxy = pf
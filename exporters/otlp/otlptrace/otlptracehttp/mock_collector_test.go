@@ -18,9 +18,11 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
 	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/otlpconfig"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/otlpjson"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/otlptracetest"
 	collectortracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
 	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
@@ -84,17 +86,22 @@ func (c *mockCollector) serveTraces(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
+	// Reply with the encoding of the request.
+	contentType := r.Header.Get("Content-Type")
 	response := collectortracepb.ExportTraceServiceResponse{
 		PartialSuccess: c.partial,
 	}
-	rawResponse, err := proto.Marshal(&response)
+	rawResponse, err := marshalTraceResponse(&response, contentType)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
+	if c.injectContentType != "" {
+		contentType = c.injectContentType
+	}
 	h := c.getInjectResponseHeader()
 	if injectedStatus := c.getInjectHTTPStatus(); injectedStatus != 0 {
-		writeReply(w, rawResponse, injectedStatus, c.injectContentType, h)
+		writeReply(w, rawResponse, injectedStatus, contentType, h)
 		return
 	}
 	rawRequest, err := readRequest(r)
@@ -108,19 +115,29 @@ func (c *mockCollector) serveTraces(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
-	writeReply(w, rawResponse, 0, c.injectContentType, h)
+	writeReply(w, rawResponse, 0, contentType, h)
 	c.spanLock.Lock()
 	defer c.spanLock.Unlock()
 	c.spansStorage.AddSpans(request)
 }
 
+func marshalTraceResponse(response *collectortracepb.ExportTraceServiceResponse, contentType string) ([]byte, error) {
+	if contentType == "application/json" {
+		return otlpjson.Marshal(response)
+	}
+	return proto.Marshal(response)
+}
+
 func unmarshalTraceRequest(rawRequest []byte, contentType string) (*collectortracepb.ExportTraceServiceRequest, error) {
 	request := &collectortracepb.ExportTraceServiceRequest{}
-	if contentType != "application/x-protobuf" {
-		return request, fmt.Errorf("invalid content-type: %s, only application/x-protobuf is supported", contentType)
+	switch contentType {
+	case "application/x-protobuf":
+		return request, proto.Unmarshal(rawRequest, request)
+	case "application/json":
+		return request, otlpjson.Unmarshal(rawRequest, request)
+	default:
+		return request, fmt.Errorf("invalid content-type: %s, only application/x-protobuf and application/json are supported", contentType)
 	}
-	err := proto.Unmarshal(rawRequest, request)
-	return request, err
 }
 
 func (c *mockCollector) checkHeaders(r *http.Request) bool {
@@ -157,10 +174,22 @@ func (c *mockCollector) getInjectResponseHeader() (h map[string]string) {
 }
 
 func readRequest(r *http.Request) ([]byte, error) {
-	if r.Header.Get("Content-Encoding") == "gzip" {
+	switch name := r.Header.Get("Content-Encoding"); name {
+	case "":
+		return io.ReadAll(r.Body)
+	case "gzip":
 		return readGzipBody(r.Body)
+	default:
+		c := encoding.GetCompressor(name)
+		if c == nil {
+			return nil, fmt.Errorf("unsupported content-encoding: %s", name)
+		}
+		body, err := c.Decompress(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		return io.ReadAll(body)
 	}
-	return io.ReadAll(r.Body)
 }
 
 func readGzipBody(body io.Reader) ([]byte, error) {
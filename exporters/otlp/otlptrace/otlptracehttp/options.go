@@ -9,14 +9,20 @@ import (
 	"net/url"
 	"time"
 
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/failover"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/otlpconfig"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/tlsreload"
 )
 
 // Compression describes the compression used for payloads sent to the
 // collector.
 type Compression otlpconfig.Compression
 
+// Encoding describes the encoding of payloads sent to the collector.
+type Encoding otlpconfig.Encoding
+
 // HTTPTransportProxyFunc is a function that resolves which URL to use as proxy for a given request.
 // This type is compatible with http.Transport.Proxy and can be used to set a custom proxy function
 // to the OTLP HTTP client.
@@ -29,6 +35,31 @@ const (
 	// GzipCompression tells the driver to send payloads after
 	// compressing them with gzip.
 	GzipCompression = Compression(otlpconfig.GzipCompression)
+	// ZstdCompression tells the driver to send payloads after
+	// compressing them with zstd.
+	//
+	// A compressor named "zstd" needs to be registered with
+	// google.golang.org/grpc/encoding, e.g. by importing
+	// go.opentelemetry.io/otel/exporters/otlp/otlpcompress/zstd.
+	// Otherwise, payloads are sent uncompressed.
+	ZstdCompression = Compression(otlpconfig.ZstdCompression)
+	// SnappyCompression tells the driver to send payloads after
+	// compressing them with snappy.
+	//
+	// A compressor named "snappy" needs to be registered with
+	// google.golang.org/grpc/encoding, e.g. by importing
+	// go.opentelemetry.io/otel/exporters/otlp/otlpcompress/snappy.
+	// Otherwise, payloads are sent uncompressed.
+	SnappyCompression = Compression(otlpconfig.SnappyCompression)
+)
+
+const (
+	// ProtobufEncoding tells the driver to send payloads encoded with the
+	// protobuf binary format ("application/x-protobuf").
+	ProtobufEncoding = Encoding(otlpconfig.ProtobufEncoding)
+	// JSONEncoding tells the driver to send payloads encoded with the
+	// OTLP/JSON format ("application/json").
+	JSONEncoding = Encoding(otlpconfig.JSONEncoding)
 )
 
 // Option applies an option to the HTTP client.
@@ -48,6 +79,39 @@ func asHTTPOptions(opts []Option) []otlpconfig.HTTPOption {
 // failure using an exponential backoff.
 type RetryConfig retry.Config
 
+// TLSFiles are the paths to the PEM encoded files used to configure TLS.
+//
+// CAFile is the path to the CA bundle used to verify the server certificate.
+// CertFile and KeyFile are the paths to the client certificate and its
+// private key used for mTLS. They are only used if both are set.
+type TLSFiles tlsreload.Files
+
+// FailoverConfig defines the endpoints the exporter fails over between.
+type FailoverConfig struct {
+	// Endpoints are the endpoints in the order they are preferred.
+	Endpoints []FailoverEndpoint
+	// InitialBackoff is the time an endpoint is not used after it failed.
+	// It is doubled for every consecutive failure of the endpoint up to
+	// MaxBackoff. If zero, 5 seconds is used.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum time an endpoint is not used after it
+	// failed. If zero, 1 minute is used.
+	MaxBackoff time.Duration
+}
+
+// FailoverEndpoint is an endpoint the exporter fails over to.
+type FailoverEndpoint struct {
+	// Endpoint is the host and port of the endpoint. It should resemble
+	// "example.com:4318".
+	Endpoint string
+	// Weight is the relative share of the batches sent to the endpoint
+	// while it is healthy. Batches are distributed between all the healthy
+	// endpoints with a non-zero weight. Endpoints with a zero weight are
+	// backups that are only used, in the order they are configured, when
+	// no endpoint with a weight is healthy.
+	Weight int
+}
+
 type wrappedOption struct {
 	otlpconfig.HTTPOption
 }
@@ -106,6 +170,37 @@ func WithCompression(compression Compression) Option {
 	return wrappedOption{otlpconfig.WithCompression(otlpconfig.Compression(compression))}
 }
 
+// WithCompressor tells the driver to compress the sent data with the
+// compressor registered with google.golang.org/grpc/encoding under name. The
+// name is used as the value of the Content-Encoding header. This can be used
+// to send payloads compressed with a custom encoder.
+//
+// If no compressor is registered under name, payloads are sent uncompressed
+// and this is reported to the global error handler (see otel.Handle).
+//
+// This option overrides the compression set by the WithCompression option
+// and the OTEL_EXPORTER_OTLP_COMPRESSION or
+// OTEL_EXPORTER_OTLP_TRACES_COMPRESSION environment variables. Passing
+// WithCompression after this option overrides it in turn.
+func WithCompressor(name string) Option {
+	return wrappedOption{otlpconfig.WithCompressor(name)}
+}
+
+// WithEncoding sets the encoding of the sent data.
+//
+// If the OTEL_EXPORTER_OTLP_PROTOCOL or OTEL_EXPORTER_OTLP_TRACES_PROTOCOL
+// environment variable is set to "http/protobuf" or "http/json", and this
+// option is not passed, that variable value will be used. If both environment
+// variables are set, OTEL_EXPORTER_OTLP_TRACES_PROTOCOL will take precedence.
+// If an environment variable is set, and this option is passed, this option
+// will take precedence.
+//
+// By default, if an environment variable is not set, and this option is not
+// passed, ProtobufEncoding will be used.
+func WithEncoding(encoding Encoding) Option {
+	return wrappedOption{otlpconfig.WithEncoding(otlpconfig.Encoding(encoding))}
+}
+
 // WithURLPath allows one to override the default URL path used
 // for sending traces. If unset, default ("/v1/traces") will be used.
 func WithURLPath(urlPath string) Option {
@@ -119,6 +214,25 @@ func WithTLSClientConfig(tlsCfg *tls.Config) Option {
 	return wrappedOption{otlpconfig.WithTLSClientConfig(tlsCfg)}
 }
 
+// WithTLSFiles sets the files the CA bundle, client certificate and client
+// key used for TLS are loaded from. The files are loaded when a connection to
+// the collector is established and are reloaded when their modification time
+// or size changes, so rotated certificates are used without restarting the
+// exporter. If a changed file cannot be loaded, the error is passed to the
+// global ErrorHandler and the previously loaded certificates are used.
+//
+// If the OTEL_EXPORTER_OTLP_CERTIFICATE, OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE
+// and OTEL_EXPORTER_OTLP_CLIENT_KEY environment variables, or their signal
+// specific counterparts, are set, the files they define are also reloaded
+// when changed. The files passed with this option take precedence
+// over those environment variables.
+//
+// The other settings of a tls.Config passed with WithTLSClientConfig are kept.
+// If WithTLSClientConfig is passed after this option, the files are not used.
+func WithTLSFiles(files TLSFiles) Option {
+	return wrappedOption{otlpconfig.WithTLSFiles(tlsreload.Files(files))}
+}
+
 // WithInsecure tells the driver to connect to the collector using the
 // HTTP scheme, instead of HTTPS.
 func WithInsecure() Option {
@@ -132,12 +246,90 @@ func WithHeaders(headers map[string]string) Option {
 	return wrappedOption{otlpconfig.WithHeaders(headers)}
 }
 
+// HeaderProvider returns headers to send with an HTTP request. req is the
+// request about to be sent, with the headers set by the exporter and with
+// WithHeaders, and body is the content of its Body as sent, i.e. encoded and
+// compressed. req and body must not be modified, and the Body of req must not
+// be read.
+//
+// The returned headers are sent in addition to, and take precedence over, the
+// headers of req. If an error is returned, the request is not sent and the
+// export fails with the error.
+type HeaderProvider func(req *http.Request, body []byte) (map[string]string, error)
+
+// WithHeaderProvider sets a HeaderProvider that is called before every export
+// attempt, including retries, to get the headers sent with the HTTP request.
+// This can be used to send headers whose values change over time, e.g.
+// short-lived bearer tokens, or that are computed from the request, e.g.
+// request signatures.
+func WithHeaderProvider(provider HeaderProvider) Option {
+	return wrappedOption{otlpconfig.WithHTTPHeaderProvider(provider)}
+}
+
 // WithTimeout tells the driver the max waiting time for the backend to process
 // each spans batch.  If unset, the default will be 10 seconds.
 func WithTimeout(duration time.Duration) Option {
 	return wrappedOption{otlpconfig.WithTimeout(duration)}
 }
 
+// WithMaxRequestSize sets the maximum size, in bytes, of the serialized and
+// uncompressed export request body. A batch of spans that exceeds this size is
+// split into smaller requests before it is sent. If a single span exceeds this
+// size on its own, it is dropped and an error is returned.
+//
+// Regardless of this option, a batch is split and the smaller requests are sent
+// if the target endpoint rejects the batch with a 413 (Payload Too Large)
+// status code.
+//
+// By default, the size of export requests is not limited.
+func WithMaxRequestSize(n int) Option {
+	return wrappedOption{otlpconfig.WithMaxRequestSize(n)}
+}
+
+// WithFailover sets the endpoints the exporter sends batches of spans to
+// instead of the endpoint set with WithEndpoint, WithEndpointURL, or
+// environment variables. A batch is sent to the first healthy endpoint. If
+// that endpoint cannot be reached, or rejects the batch with a status code
+// that is not retryable, it is marked as unhealthy and the batch is sent to
+// the next endpoint. An unhealthy endpoint is used again once its backoff has
+// elapsed, so the exporter returns to a preferred endpoint when it recovers.
+//
+// The scheme, URL path, headers, TLS, and other settings of the exporter are
+// used for all the endpoints.
+//
+// By default, the exporter does not fail over.
+func WithFailover(settings FailoverConfig) Option {
+	c := failover.Config{
+		Endpoints:      make([]failover.Endpoint, len(settings.Endpoints)),
+		InitialBackoff: settings.InitialBackoff,
+		MaxBackoff:     settings.MaxBackoff,
+	}
+	for i, e := range settings.Endpoints {
+		c.Endpoints[i] = failover.Endpoint(e)
+	}
+	return wrappedOption{otlpconfig.WithFailover(c)}
+}
+
+// WithExportResultHandler sets a handler that is called with the result of the
+// export of every batch of spans. The result reports how many spans the
+// endpoint rejected in partial success responses and why, how many export
+// requests were sent, and whether the batch was dropped. It can be used to
+// measure data loss or to drive a circuit breaker.
+//
+// The handler is called synchronously once the export of a batch, including its
+// retries and split requests, has completed. It needs to return promptly to not
+// block the exporter. Partial success responses are still reported to the
+// global error handler.
+//
+// By default, no handler is called.
+func WithExportResultHandler(h ExportResultHandler) Option {
+	var fn func(internal.ExportResult)
+	if h != nil {
+		fn = func(r internal.ExportResult) { h(newExportResult(r)) }
+	}
+	return wrappedOption{otlpconfig.WithResultHandler(fn)}
+}
+
 // WithRetry configures the retry policy for transient errors that may occurs
 // when exporting traces. An exponential back-off algorithm is used to ensure
 // endpoints are not overwhelmed with retries. If unset, the default retry
@@ -274,6 +274,16 @@ func getPrettyJSONs(now *time.Time) string {
 	return getPrettyJSON(now) + getPrettyJSON(now)
 }
 
+func TestExporterExportEventName(t *testing.T) {
+	var buf bytes.Buffer
+	exporter, err := New(WithWriter(&buf), WithoutTimestamps())
+	require.NoError(t, err)
+
+	r := logtest.RecordFactory{EventName: "checkout.completed"}.NewRecord()
+	require.NoError(t, exporter.Export(context.Background(), []sdklog.Record{r}))
+	assert.Contains(t, buf.String(), `"EventName":"checkout.completed"`)
+}
+
 func TestExporterShutdown(t *testing.T) {
 	exporter, err := New()
 	assert.NoError(t, err)
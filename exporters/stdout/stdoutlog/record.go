@@ -77,6 +77,7 @@ type keyValue struct {
 
 // recordJSON is a JSON-serializable representation of a Record.
 type recordJSON struct {
+	EventName         string     `json:",omitempty"`
 	Timestamp         *time.Time `json:",omitempty"`
 	ObservedTimestamp *time.Time `json:",omitempty"`
 	Severity          log.Severity
@@ -94,6 +95,7 @@ type recordJSON struct {
 func (e *Exporter) newRecordJSON(r sdklog.Record) recordJSON {
 	res := r.Resource()
 	newRecord := recordJSON{
+		EventName:    r.EventName(),
 		Severity:     r.Severity(),
 		SeverityText: r.SeverityText(),
 		Body:         newValue(r.Body()),
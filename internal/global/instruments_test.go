@@ -7,6 +7,7 @@ import (
 	"context"
 	"testing"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
 	"go.opentelemetry.io/otel/metric/noop"
@@ -154,14 +155,78 @@ func TestSyncInstrumentSetDelegateConcurrentSafe(t *testing.T) {
 	})
 }
 
+func TestBoundInstrumentSetDelegateConcurrentSafe(t *testing.T) {
+	attrs := attribute.NewSet(attribute.String("key", "value"))
+
+	// Float64 Instruments
+	t.Run("Float64", func(t *testing.T) {
+		t.Run("Counter", func(t *testing.T) {
+			delegate := &sfCounter{}
+			bound := delegate.Bind(attrs)
+			f := func(v float64) { bound.Add(context.Background(), v) }
+			testFloat64ConcurrentSafe(f, delegate.setDelegate)
+		})
+
+		t.Run("UpDownCounter", func(t *testing.T) {
+			delegate := &sfUpDownCounter{}
+			bound := delegate.Bind(attrs)
+			f := func(v float64) { bound.Add(context.Background(), v) }
+			testFloat64ConcurrentSafe(f, delegate.setDelegate)
+		})
+
+		t.Run("Histogram", func(t *testing.T) {
+			delegate := &sfHistogram{}
+			bound := delegate.Bind(attrs)
+			f := func(v float64) { bound.Record(context.Background(), v) }
+			testFloat64ConcurrentSafe(f, delegate.setDelegate)
+		})
+
+		t.Run("Gauge", func(t *testing.T) {
+			delegate := &sfGauge{}
+			bound := delegate.Bind(attrs)
+			f := func(v float64) { bound.Record(context.Background(), v) }
+			testFloat64ConcurrentSafe(f, delegate.setDelegate)
+		})
+	})
+
+	// Int64 Instruments
+
+	t.Run("Int64", func(t *testing.T) {
+		t.Run("Counter", func(t *testing.T) {
+			delegate := &siCounter{}
+			bound := delegate.Bind(attrs)
+			f := func(v int64) { bound.Add(context.Background(), v) }
+			testInt64ConcurrentSafe(f, delegate.setDelegate)
+		})
+
+		t.Run("UpDownCounter", func(t *testing.T) {
+			delegate := &siUpDownCounter{}
+			bound := delegate.Bind(attrs)
+			f := func(v int64) { bound.Add(context.Background(), v) }
+			testInt64ConcurrentSafe(f, delegate.setDelegate)
+		})
+
+		t.Run("Histogram", func(t *testing.T) {
+			delegate := &siHistogram{}
+			bound := delegate.Bind(attrs)
+			f := func(v int64) { bound.Record(context.Background(), v) }
+			testInt64ConcurrentSafe(f, delegate.setDelegate)
+		})
+
+		t.Run("Gauge", func(t *testing.T) {
+			delegate := &siGauge{}
+			bound := delegate.Bind(attrs)
+			f := func(v int64) { bound.Record(context.Background(), v) }
+			testInt64ConcurrentSafe(f, delegate.setDelegate)
+		})
+	})
+}
+
 type testCountingFloatInstrument struct {
 	count int
 
 	metric.Float64Observable
 	embedded.Float64Counter
-	embedded.Float64UpDownCounter
-	embedded.Float64Histogram
-	embedded.Float64Gauge
 	embedded.Float64ObservableCounter
 	embedded.Float64ObservableUpDownCounter
 	embedded.Float64ObservableGauge
@@ -179,14 +244,59 @@ func (i *testCountingFloatInstrument) Record(context.Context, float64, ...metric
 	i.count++
 }
 
+func (i *testCountingFloatInstrument) Bind(attribute.Set) metric.BoundFloat64Counter {
+	return &testCountingFloatBound{inst: i}
+}
+
+type testCountingFloatUpDownCounter struct {
+	*testCountingFloatInstrument
+	embedded.Float64UpDownCounter
+}
+
+func (i testCountingFloatUpDownCounter) Bind(attribute.Set) metric.BoundFloat64UpDownCounter {
+	return &testCountingFloatBound{inst: i.testCountingFloatInstrument}
+}
+
+type testCountingFloatHistogram struct {
+	*testCountingFloatInstrument
+	embedded.Float64Histogram
+}
+
+func (i testCountingFloatHistogram) Bind(attribute.Set) metric.BoundFloat64Histogram {
+	return &testCountingFloatBound{inst: i.testCountingFloatInstrument}
+}
+
+type testCountingFloatGauge struct {
+	*testCountingFloatInstrument
+	embedded.Float64Gauge
+}
+
+func (i testCountingFloatGauge) Bind(attribute.Set) metric.BoundFloat64Gauge {
+	return &testCountingFloatBound{inst: i.testCountingFloatInstrument}
+}
+
+type testCountingFloatBound struct {
+	inst *testCountingFloatInstrument
+
+	embedded.BoundFloat64Counter
+	embedded.BoundFloat64UpDownCounter
+	embedded.BoundFloat64Histogram
+	embedded.BoundFloat64Gauge
+}
+
+func (b *testCountingFloatBound) Add(context.Context, float64) {
+	b.inst.count++
+}
+
+func (b *testCountingFloatBound) Record(context.Context, float64) {
+	b.inst.count++
+}
+
 type testCountingIntInstrument struct {
 	count int
 
 	metric.Int64Observable
 	embedded.Int64Counter
-	embedded.Int64UpDownCounter
-	embedded.Int64Histogram
-	embedded.Int64Gauge
 	embedded.Int64ObservableCounter
 	embedded.Int64ObservableUpDownCounter
 	embedded.Int64ObservableGauge
@@ -203,3 +313,51 @@ func (i *testCountingIntInstrument) Add(context.Context, int64, ...metric.AddOpt
 func (i *testCountingIntInstrument) Record(context.Context, int64, ...metric.RecordOption) {
 	i.count++
 }
+
+func (i *testCountingIntInstrument) Bind(attribute.Set) metric.BoundInt64Counter {
+	return &testCountingIntBound{inst: i}
+}
+
+type testCountingIntUpDownCounter struct {
+	*testCountingIntInstrument
+	embedded.Int64UpDownCounter
+}
+
+func (i testCountingIntUpDownCounter) Bind(attribute.Set) metric.BoundInt64UpDownCounter {
+	return &testCountingIntBound{inst: i.testCountingIntInstrument}
+}
+
+type testCountingIntHistogram struct {
+	*testCountingIntInstrument
+	embedded.Int64Histogram
+}
+
+func (i testCountingIntHistogram) Bind(attribute.Set) metric.BoundInt64Histogram {
+	return &testCountingIntBound{inst: i.testCountingIntInstrument}
+}
+
+type testCountingIntGauge struct {
+	*testCountingIntInstrument
+	embedded.Int64Gauge
+}
+
+func (i testCountingIntGauge) Bind(attribute.Set) metric.BoundInt64Gauge {
+	return &testCountingIntBound{inst: i.testCountingIntInstrument}
+}
+
+type testCountingIntBound struct {
+	inst *testCountingIntInstrument
+
+	embedded.BoundInt64Counter
+	embedded.BoundInt64UpDownCounter
+	embedded.BoundInt64Histogram
+	embedded.BoundInt64Gauge
+}
+
+func (b *testCountingIntBound) Add(context.Context, int64) {
+	b.inst.count++
+}
+
+func (b *testCountingIntBound) Record(context.Context, int64) {
+	b.inst.count++
+}
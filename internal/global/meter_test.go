@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/noop"
 )
@@ -319,6 +320,39 @@ func TestMeterDefersDelegations(t *testing.T) {
 	assert.Equal(t, 1, mp.count)
 }
 
+func TestMeterDefersBoundDelegations(t *testing.T) {
+	globalMeterProvider := &meterProvider{}
+	m := globalMeterProvider.Meter("go.opentelemetry.io/otel/metric/internal/global/meter_test")
+
+	ctr, err := m.Float64Counter("test_Float64_counter")
+	require.NoError(t, err)
+	hist, err := m.Int64Histogram("test_Int64_histogram")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	attrs := attribute.NewSet(attribute.String("key", "value"))
+	bCtr, bHist := ctr.Bind(attrs), hist.Bind(attrs)
+
+	// Measurements made before the delegate is set are dropped.
+	bCtr.Add(ctx, 1)
+	bHist.Record(ctx, 1)
+
+	globalMeterProvider.setDelegate(&testMeterProvider{})
+
+	bCtr.Add(ctx, 1)
+	bCtr.Add(ctx, 1)
+	bHist.Record(ctx, 1)
+
+	ctrImpl := ctr.(*sfCounter).delegate.Load().(*testCountingFloatInstrument)
+	assert.Equal(t, 2, ctrImpl.count)
+	histImpl := hist.(*siHistogram).delegate.Load().(testCountingIntHistogram)
+	assert.Equal(t, 1, histImpl.count)
+
+	// Bindings made after the delegate is set are the delegate bindings.
+	assert.IsType(t, &testCountingFloatBound{}, ctr.Bind(attrs))
+	assert.IsType(t, &testCountingIntBound{}, hist.Bind(attrs))
+}
+
 func TestRegistrationDelegation(t *testing.T) {
 	// globalMeterProvider := otel.GetMeterProvider
 	globalMeterProvider := &meterProvider{}
@@ -53,17 +53,17 @@ func (m *testMeter) Int64Counter(name string, options ...metric.Int64CounterOpti
 
 func (m *testMeter) Int64UpDownCounter(name string, options ...metric.Int64UpDownCounterOption) (metric.Int64UpDownCounter, error) {
 	m.siUDCount++
-	return &testCountingIntInstrument{}, nil
+	return testCountingIntUpDownCounter{testCountingIntInstrument: &testCountingIntInstrument{}}, nil
 }
 
 func (m *testMeter) Int64Histogram(name string, options ...metric.Int64HistogramOption) (metric.Int64Histogram, error) {
 	m.siHist++
-	return &testCountingIntInstrument{}, nil
+	return testCountingIntHistogram{testCountingIntInstrument: &testCountingIntInstrument{}}, nil
 }
 
 func (m *testMeter) Int64Gauge(name string, options ...metric.Int64GaugeOption) (metric.Int64Gauge, error) {
 	m.siGauge++
-	return &testCountingIntInstrument{}, nil
+	return testCountingIntGauge{testCountingIntInstrument: &testCountingIntInstrument{}}, nil
 }
 
 func (m *testMeter) Int64ObservableCounter(name string, options ...metric.Int64ObservableCounterOption) (metric.Int64ObservableCounter, error) {
@@ -88,17 +88,17 @@ func (m *testMeter) Float64Counter(name string, options ...metric.Float64Counter
 
 func (m *testMeter) Float64UpDownCounter(name string, options ...metric.Float64UpDownCounterOption) (metric.Float64UpDownCounter, error) {
 	m.sfUDCount++
-	return &testCountingFloatInstrument{}, nil
+	return testCountingFloatUpDownCounter{testCountingFloatInstrument: &testCountingFloatInstrument{}}, nil
 }
 
 func (m *testMeter) Float64Histogram(name string, options ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
 	m.sfHist++
-	return &testCountingFloatInstrument{}, nil
+	return testCountingFloatHistogram{testCountingFloatInstrument: &testCountingFloatInstrument{}}, nil
 }
 
 func (m *testMeter) Float64Gauge(name string, options ...metric.Float64GaugeOption) (metric.Float64Gauge, error) {
 	m.sfGauge++
-	return &testCountingFloatInstrument{}, nil
+	return testCountingFloatGauge{testCountingFloatInstrument: &testCountingFloatInstrument{}}, nil
 }
 
 func (m *testMeter) Float64ObservableCounter(name string, options ...metric.Float64ObservableCounterOption) (metric.Float64ObservableCounter, error) {
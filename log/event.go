@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/log"
+
+import (
+	"context"
+	"time"
+)
+
+// Event is a named occurrence with a structured payload, e.g. a domain event
+// such as "checkout.completed". It is emitted as a [Record] with an event
+// name by an [EventLogger].
+type Event struct {
+	// Name identifies the class of the event. It is required.
+	Name string
+
+	// Timestamp is the time when the event occurred. If it is zero, the time
+	// the event is emitted is used.
+	Timestamp time.Time
+
+	// Severity is the severity of the event. If it is
+	// [SeverityUndefined], [SeverityInfo] is used.
+	Severity Severity
+
+	// Body is the payload of the event.
+	Body Value
+
+	// Attributes describe the event.
+	Attributes []KeyValue
+}
+
+// EventLogger emits events with a [Logger].
+//
+// An EventLogger is a convenience. Events are log records with an event name,
+// they can also be emitted by setting the event name of a [Record] passed to
+// [Logger.Emit].
+type EventLogger struct {
+	logger Logger
+}
+
+// NewEventLogger returns an [EventLogger] that emits events with logger.
+func NewEventLogger(logger Logger) EventLogger {
+	return EventLogger{logger: logger}
+}
+
+// Emit emits e as a [Record]. If the name of e is empty, or the
+// EventLogger has no [Logger], nothing is emitted.
+//
+// This method is safe to call concurrently.
+func (l EventLogger) Emit(ctx context.Context, e Event) {
+	if l.logger == nil || e.Name == "" {
+		return
+	}
+	l.logger.Emit(ctx, e.record())
+}
+
+// Enabled returns whether the [Logger] of l emits events with the passed
+// name and severity.
+//
+// This method is safe to call concurrently.
+func (l EventLogger) Enabled(ctx context.Context, name string, severity Severity) bool {
+	if l.logger == nil || name == "" {
+		return false
+	}
+
+	var r Record
+	r.SetEventName(name)
+	r.SetSeverity(severity)
+	return l.logger.Enabled(ctx, r)
+}
+
+func (e Event) record() Record {
+	var r Record
+	r.SetEventName(e.Name)
+
+	ts := e.Timestamp
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	r.SetTimestamp(ts)
+
+	sev := e.Severity
+	if sev == SeverityUndefined {
+		sev = SeverityInfo
+	}
+	r.SetSeverity(sev)
+
+	r.SetBody(e.Body)
+	r.AddAttributes(e.Attributes...)
+	return r
+}
@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/logtest"
+)
+
+func TestEventLoggerEmit(t *testing.T) {
+	ctx := context.Background()
+	r := logtest.NewRecorder()
+	l := log.NewEventLogger(r.Logger("TestEventLoggerEmit"))
+
+	ts := time.Unix(1000, 0)
+	l.Emit(ctx, log.Event{
+		Name:       "checkout.completed",
+		Timestamp:  ts,
+		Severity:   log.SeverityWarn,
+		Body:       log.MapValue(log.Int("items", 2)),
+		Attributes: []log.KeyValue{log.String("user", "alice")},
+	})
+	l.Emit(ctx, log.Event{Name: "feature.toggled"})
+	l.Emit(ctx, log.Event{})
+
+	require.Len(t, r.Result(), 1)
+	records := r.Result()[0].Records
+	require.Len(t, records, 2, "event without name emitted")
+
+	got := records[0]
+	assert.Equal(t, "checkout.completed", got.EventName())
+	assert.Equal(t, ts, got.Timestamp())
+	assert.Equal(t, log.SeverityWarn, got.Severity())
+	assert.True(t, log.MapValue(log.Int("items", 2)).Equal(got.Body()), "body")
+	assert.Equal(t, 1, got.AttributesLen())
+
+	got = records[1]
+	assert.Equal(t, "feature.toggled", got.EventName())
+	assert.False(t, got.Timestamp().IsZero(), "timestamp not set")
+	assert.Equal(t, log.SeverityInfo, got.Severity(), "default severity")
+}
+
+func TestEventLoggerEnabled(t *testing.T) {
+	ctx := context.Background()
+	r := logtest.NewRecorder(logtest.WithEnabledFunc(func(_ context.Context, r log.Record) bool {
+		return r.EventName() == "enabled"
+	}))
+	l := log.NewEventLogger(r.Logger("TestEventLoggerEnabled"))
+
+	assert.True(t, l.Enabled(ctx, "enabled", log.SeverityInfo))
+	assert.False(t, l.Enabled(ctx, "disabled", log.SeverityInfo))
+	assert.False(t, l.Enabled(ctx, "", log.SeverityInfo), "event without name enabled")
+}
+
+func TestEventLoggerZero(t *testing.T) {
+	var l log.EventLogger
+	assert.NotPanics(t, func() {
+		l.Emit(context.Background(), log.Event{Name: "name"})
+		assert.False(t, l.Enabled(context.Background(), "name", log.SeverityInfo))
+	})
+}
@@ -17,6 +17,8 @@ func TestAssertRecord(t *testing.T) {
 
 	AssertRecordEqual(t, r1, r2)
 
+	r1.SetEventName("event")
+	r2.SetEventName("event")
 	r1.SetTimestamp(now)
 	r2.SetTimestamp(now)
 	r1.SetObservedTimestamp(now)
@@ -13,6 +13,7 @@ import (
 )
 
 func TestRecordFactory(t *testing.T) {
+	eventName := "event"
 	now := time.Now()
 	observed := now.Add(time.Second)
 	severity := log.SeverityDebug
@@ -25,6 +26,7 @@ func TestRecordFactory(t *testing.T) {
 	}
 
 	got := RecordFactory{
+		EventName:         eventName,
 		Timestamp:         now,
 		ObservedTimestamp: observed,
 		Severity:          severity,
@@ -33,6 +35,7 @@ func TestRecordFactory(t *testing.T) {
 		Attributes:        attrs,
 	}.NewRecord()
 
+	assert.Equal(t, eventName, got.EventName())
 	assert.Equal(t, now, got.Timestamp())
 	assert.Equal(t, observed, got.ObservedTimestamp())
 	assert.Equal(t, severity, got.Severity())
@@ -0,0 +1,313 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package otelslog provides a [slog.Handler] that bridges the [log/slog] package
+to the OpenTelemetry Logs Bridge API.
+
+The [Handler] converts each [slog.Record] into a [log.Record] and emits it
+with a [log.Logger]:
+
+  - The time of the slog.Record is used as the timestamp. A zero time is not
+    set.
+  - The message is used as the body, with a [log.KindString] value.
+  - The level is converted to a [log.Severity] so that [slog.LevelDebug],
+    [slog.LevelInfo], [slog.LevelWarn], and [slog.LevelError] are
+    [log.SeverityDebug], [log.SeverityInfo], [log.SeverityWarn], and
+    [log.SeverityError]. The level name is used as the severity text.
+  - The attributes are converted to [log.KeyValue]. [slog.LogValuer] values
+    are resolved. Groups are converted to [log.MapValue], and empty groups
+    are dropped. Attributes of groups without a key are added to the
+    enclosing group.
+
+The context passed to [Handler.Handle] is passed to [log.Logger.Emit]. The
+trace context it holds, e.g. set with [slog.Logger.InfoContext], is used by
+the Logs SDK to correlate the record with the active span.
+
+This package is experimental. It will be deprecated and removed when the [log]
+package becomes stable.
+*/
+package otelslog // import "go.opentelemetry.io/otel/log/otelslog"
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+)
+
+// sevOffset is the offset between a slog.Level and the equivalent
+// log.Severity.
+const sevOffset = slog.Level(log.SeverityInfo) - slog.LevelInfo
+
+type config struct {
+	provider  log.LoggerProvider
+	version   string
+	schemaURL string
+}
+
+func newConfig(options []Option) config {
+	var c config
+	for _, opt := range options {
+		c = opt.apply(c)
+	}
+
+	if c.provider == nil {
+		c.provider = global.GetLoggerProvider()
+	}
+	return c
+}
+
+func (c config) logger(name string) log.Logger {
+	var opts []log.LoggerOption
+	if c.version != "" {
+		opts = append(opts, log.WithInstrumentationVersion(c.version))
+	}
+	if c.schemaURL != "" {
+		opts = append(opts, log.WithSchemaURL(c.schemaURL))
+	}
+	return c.provider.Logger(name, opts...)
+}
+
+// Option configures a [Handler].
+type Option interface {
+	apply(config) config
+}
+
+type optFunc func(config) config
+
+func (f optFunc) apply(c config) config { return f(c) }
+
+// WithLoggerProvider returns an [Option] that configures the
+// [log.LoggerProvider] used by a [Handler] to create its [log.Logger].
+//
+// By default, the global LoggerProvider is used.
+func WithLoggerProvider(provider log.LoggerProvider) Option {
+	return optFunc(func(c config) config {
+		c.provider = provider
+		return c
+	})
+}
+
+// WithVersion returns an [Option] that configures the version of the
+// [log.Logger] used by a [Handler]. The version should be the version of the
+// package that is being logged.
+func WithVersion(version string) Option {
+	return optFunc(func(c config) config {
+		c.version = version
+		return c
+	})
+}
+
+// WithSchemaURL returns an [Option] that configures the semantic convention
+// schema URL of the [log.Logger] used by a [Handler].
+func WithSchemaURL(schemaURL string) Option {
+	return optFunc(func(c config) config {
+		c.schemaURL = schemaURL
+		return c
+	})
+}
+
+// NewLogger returns a new [slog.Logger] backed by a new [Handler]. See
+// [NewHandler] for details on how the backing Handler is created.
+func NewLogger(name string, options ...Option) *slog.Logger {
+	return slog.New(NewHandler(name, options...))
+}
+
+// Compile-time check Handler implements slog.Handler.
+var _ slog.Handler = (*Handler)(nil)
+
+// Handler is a [slog.Handler] that emits the records it handles with a
+// [log.Logger].
+//
+// Use [NewHandler] to create a Handler.
+type Handler struct {
+	logger log.Logger
+
+	// attrs are the attributes added at the top level.
+	attrs []log.KeyValue
+	// groups are the open groups, from the outermost to the innermost.
+	groups []group
+}
+
+// group is an open group and the attributes added to it.
+type group struct {
+	name  string
+	attrs []log.KeyValue
+}
+
+// NewHandler returns a new [Handler] that emits records with the
+// [log.Logger] created by the configured [log.LoggerProvider] with name and
+// the passed options.
+//
+// If [WithLoggerProvider] is not passed, the returned Handler uses the global
+// LoggerProvider.
+func NewHandler(name string, options ...Option) *Handler {
+	cfg := newConfig(options)
+	return &Handler{logger: cfg.logger(name)}
+}
+
+// Enabled returns whether the [log.Logger] of h is enabled for records with
+// level, i.e. the [log.Severity] level is converted to.
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	var r log.Record
+	r.SetSeverity(convertLevel(level))
+	return h.logger.Enabled(ctx, r)
+}
+
+// Handle converts record into a [log.Record] and emits it with the
+// [log.Logger] of h. The passed ctx is passed to the Logger.
+func (h *Handler) Handle(ctx context.Context, record slog.Record) error {
+	h.logger.Emit(ctx, h.convertRecord(record))
+	return nil
+}
+
+func (h *Handler) convertRecord(r slog.Record) log.Record {
+	var record log.Record
+	if !r.Time.IsZero() {
+		record.SetTimestamp(r.Time)
+	}
+	record.SetBody(log.StringValue(r.Message))
+	record.SetSeverity(convertLevel(r.Level))
+	record.SetSeverityText(r.Level.String())
+
+	// The attributes of the record are added to the innermost group.
+	var attrs []log.KeyValue
+	if r.NumAttrs() > 0 {
+		attrs = make([]log.KeyValue, 0, r.NumAttrs())
+		r.Attrs(func(a slog.Attr) bool {
+			attrs = appendAttr(attrs, a)
+			return true
+		})
+	}
+	for i := len(h.groups) - 1; i >= 0; i-- {
+		g := h.groups[i]
+		kvs := append(slices.Clip(g.attrs), attrs...)
+		attrs = nil
+		if len(kvs) > 0 {
+			attrs = []log.KeyValue{log.Map(g.name, kvs...)}
+		}
+	}
+
+	record.AddAttributes(h.attrs...)
+	record.AddAttributes(attrs...)
+	return record
+}
+
+// WithAttrs returns a new [Handler] whose attributes consist of both the
+// attributes of h and attrs. The attributes are added to the innermost open
+// group, if any.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	var kvs []log.KeyValue
+	for _, a := range attrs {
+		kvs = appendAttr(kvs, a)
+	}
+	if len(kvs) == 0 {
+		return h
+	}
+
+	h2 := h.clone()
+	if n := len(h2.groups); n > 0 {
+		g := &h2.groups[n-1]
+		g.attrs = append(slices.Clip(g.attrs), kvs...)
+	} else {
+		h2.attrs = append(slices.Clip(h2.attrs), kvs...)
+	}
+	return h2
+}
+
+// WithGroup returns a new [Handler] with the group name appended to the
+// groups of h. The attributes added thereafter are nested in the group. If
+// name is empty, h is returned.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	h2 := h.clone()
+	h2.groups = append(slices.Clip(h2.groups), group{name: name})
+	return h2
+}
+
+func (h *Handler) clone() *Handler {
+	h2 := *h
+	return &h2
+}
+
+// convertLevel returns the log.Severity equivalent to level.
+func convertLevel(level slog.Level) log.Severity {
+	return log.Severity(level + sevOffset)
+}
+
+// appendAttr appends the conversion of a to kvs, following the rules of
+// [slog.Handler].
+func appendAttr(kvs []log.KeyValue, a slog.Attr) []log.KeyValue {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		// Empty attributes are ignored.
+		return kvs
+	}
+
+	if a.Value.Kind() == slog.KindGroup {
+		var group []log.KeyValue
+		for _, ga := range a.Value.Group() {
+			group = appendAttr(group, ga)
+		}
+		switch {
+		case len(group) == 0:
+			// Empty groups are ignored.
+			return kvs
+		case a.Key == "":
+			// Groups without a key are inlined.
+			return append(kvs, group...)
+		}
+		return append(kvs, log.Map(a.Key, group...))
+	}
+	return append(kvs, log.KeyValue{Key: a.Key, Value: convertValue(a.Value)})
+}
+
+// convertValue returns the log.Value equivalent to the resolved, non-group
+// value v.
+func convertValue(v slog.Value) log.Value {
+	switch v.Kind() {
+	case slog.KindBool:
+		return log.BoolValue(v.Bool())
+	case slog.KindDuration:
+		return log.Int64Value(v.Duration().Nanoseconds())
+	case slog.KindFloat64:
+		return log.Float64Value(v.Float64())
+	case slog.KindInt64:
+		return log.Int64Value(v.Int64())
+	case slog.KindString:
+		return log.StringValue(v.String())
+	case slog.KindTime:
+		return log.Int64Value(v.Time().UnixNano())
+	case slog.KindUint64:
+		if u := v.Uint64(); u <= math.MaxInt64 {
+			return log.Int64Value(int64(u))
+		}
+		// The value would overflow an int64.
+		return log.StringValue(v.String())
+	case slog.KindAny:
+		return convertAny(v.Any())
+	}
+	return log.StringValue(v.String())
+}
+
+func convertAny(v any) log.Value {
+	switch val := v.(type) {
+	case nil:
+		return log.Value{}
+	case []byte:
+		return log.BytesValue(val)
+	case error:
+		return log.StringValue(val.Error())
+	case fmt.Stringer:
+		return log.StringValue(val.String())
+	}
+	return log.StringValue(fmt.Sprintf("%+v", v))
+}
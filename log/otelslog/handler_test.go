@@ -0,0 +1,205 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelslog
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"testing"
+	"testing/slogtest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/logtest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// records returns the records emitted to r by the logger named name.
+func records(t *testing.T, r *logtest.Recorder, name string) []logtest.EmittedRecord {
+	t.Helper()
+	for _, sr := range r.Result() {
+		if sr.Name == name {
+			return sr.Records
+		}
+	}
+	t.Fatalf("no logger named %q", name)
+	return nil
+}
+
+// attrMap returns the attributes of r as slogtest expects them.
+func attrMap(r log.Record) map[string]any {
+	m := make(map[string]any)
+	if !r.Timestamp().IsZero() {
+		m[slog.TimeKey] = r.Timestamp()
+	}
+	m[slog.LevelKey] = r.Severity()
+	m[slog.MessageKey] = r.Body().AsString()
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		m[kv.Key] = value(kv.Value)
+		return true
+	})
+	return m
+}
+
+func value(v log.Value) any {
+	switch v.Kind() {
+	case log.KindMap:
+		m := make(map[string]any)
+		for _, kv := range v.AsMap() {
+			m[kv.Key] = value(kv.Value)
+		}
+		return m
+	case log.KindString:
+		return v.AsString()
+	case log.KindInt64:
+		return v.AsInt64()
+	}
+	return v.String()
+}
+
+func TestSlogtest(t *testing.T) {
+	r := logtest.NewRecorder()
+	h := NewHandler("TestSlogtest", WithLoggerProvider(r))
+
+	err := slogtest.TestHandler(h, func() []map[string]any {
+		var got []map[string]any
+		for _, rec := range records(t, r, "TestSlogtest") {
+			got = append(got, attrMap(rec.Record))
+		}
+		return got
+	})
+	require.NoError(t, err)
+}
+
+func TestNewHandlerScope(t *testing.T) {
+	r := logtest.NewRecorder()
+	l := NewLogger("name", WithLoggerProvider(r), WithVersion("v1"), WithSchemaURL("url"))
+	l.Info("msg")
+
+	require.Len(t, r.Result(), 1)
+	sr := r.Result()[0]
+	assert.Equal(t, "name", sr.Name)
+	assert.Equal(t, "v1", sr.Version)
+	assert.Equal(t, "url", sr.SchemaURL)
+	assert.Len(t, sr.Records, 1)
+}
+
+func TestHandlerLevels(t *testing.T) {
+	for level, want := range map[slog.Level]log.Severity{
+		slog.LevelDebug:     log.SeverityDebug,
+		slog.LevelInfo:      log.SeverityInfo,
+		slog.LevelWarn:      log.SeverityWarn,
+		slog.LevelError:     log.SeverityError,
+		slog.LevelInfo + 1:  log.SeverityInfo2,
+		slog.LevelError + 3: log.SeverityError4,
+	} {
+		r := logtest.NewRecorder()
+		NewLogger("TestHandlerLevels", WithLoggerProvider(r)).Log(context.Background(), level, "msg")
+
+		got := records(t, r, "TestHandlerLevels")
+		require.Len(t, got, 1, level)
+		assert.Equal(t, want, got[0].Severity(), level)
+		assert.Equal(t, level.String(), got[0].SeverityText(), level)
+	}
+}
+
+func TestHandlerEnabled(t *testing.T) {
+	ctx := context.Background()
+	r := logtest.NewRecorder(logtest.WithEnabledFunc(func(_ context.Context, r log.Record) bool {
+		return r.Severity() >= log.SeverityWarn
+	}))
+	h := NewHandler("TestHandlerEnabled", WithLoggerProvider(r))
+
+	assert.False(t, h.Enabled(ctx, slog.LevelInfo))
+	assert.True(t, h.Enabled(ctx, slog.LevelWarn))
+	assert.True(t, h.Enabled(ctx, slog.LevelError))
+}
+
+func TestHandlerContext(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	r := logtest.NewRecorder()
+	NewLogger("TestHandlerContext", WithLoggerProvider(r)).InfoContext(ctx, "msg")
+
+	got := records(t, r, "TestHandlerContext")
+	require.Len(t, got, 1)
+	assert.Equal(t, sc, trace.SpanContextFromContext(got[0].Context()))
+}
+
+type valuer struct{ v slog.Value }
+
+func (v valuer) LogValue() slog.Value { return v.v }
+
+type stringer struct{}
+
+func (stringer) String() string { return "stringer" }
+
+func TestHandlerValues(t *testing.T) {
+	now := time.Now()
+
+	r := logtest.NewRecorder()
+	l := NewLogger("TestHandlerValues", WithLoggerProvider(r))
+	l.With("base", 1).WithGroup("g").With("in", true).Info(
+		"msg",
+		slog.Bool("bool", true),
+		slog.Duration("duration", time.Second),
+		slog.Float64("float64", 1.5),
+		slog.Int64("int64", -1),
+		slog.String("string", "str"),
+		slog.Time("time", now),
+		slog.Uint64("uint64", 2),
+		slog.Uint64("uint64max", math.MaxUint64),
+		slog.Any("bytes", []byte("b")),
+		slog.Any("error", errors.New("err")),
+		slog.Any("stringer", stringer{}),
+		slog.Any("any", struct{ A int }{1}),
+		slog.Any("nil", nil),
+		slog.Any("valuer", valuer{slog.GroupValue(slog.Int("a", 1))}),
+		slog.Group("empty"),
+	)
+
+	got := records(t, r, "TestHandlerValues")
+	require.Len(t, got, 1)
+
+	want := []log.KeyValue{
+		log.Int("base", 1),
+		log.Map(
+			"g",
+			log.Bool("in", true),
+			log.Bool("bool", true),
+			log.Int64("duration", time.Second.Nanoseconds()),
+			log.Float64("float64", 1.5),
+			log.Int64("int64", -1),
+			log.String("string", "str"),
+			log.Int64("time", now.UnixNano()),
+			log.Int64("uint64", 2),
+			log.String("uint64max", "18446744073709551615"),
+			log.Bytes("bytes", []byte("b")),
+			log.String("error", "err"),
+			log.String("stringer", "stringer"),
+			log.String("any", "{A:1}"),
+			log.Empty("nil"),
+			log.Map("valuer", log.Int("a", 1)),
+		),
+	}
+	var attrs []log.KeyValue
+	got[0].WalkAttributes(func(kv log.KeyValue) bool {
+		attrs = append(attrs, kv)
+		return true
+	})
+	require.Len(t, attrs, len(want))
+	for i := range want {
+		assert.Truef(t, want[i].Equal(attrs[i]), "want %v, got %v", want[i], attrs[i])
+	}
+}
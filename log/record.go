@@ -16,6 +16,7 @@ const attributesInlineCount = 5
 
 // Record represents a log record.
 type Record struct {
+	eventName         string
 	timestamp         time.Time
 	observedTimestamp time.Time
 	severity          Severity
@@ -41,6 +42,18 @@ type Record struct {
 	back []KeyValue
 }
 
+// EventName returns the event name. A log record with an event name is an
+// event.
+func (r *Record) EventName() string {
+	return r.eventName
+}
+
+// SetEventName sets the event name. The name identifies the class of the
+// event, e.g. "checkout.completed". Setting it makes the log record an event.
+func (r *Record) SetEventName(name string) {
+	r.eventName = name
+}
+
 // Timestamp returns the time when the log record occurred.
 func (r *Record) Timestamp() time.Time {
 	return r.timestamp
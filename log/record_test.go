@@ -15,6 +15,14 @@ import (
 
 var y2k = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
 
+func TestRecordEventName(t *testing.T) {
+	const name = "checkout.completed"
+
+	var r log.Record
+	r.SetEventName(name)
+	assert.Equal(t, name, r.EventName())
+}
+
 func TestRecordTimestamp(t *testing.T) {
 	var r log.Record
 	r.SetTimestamp(y2k)
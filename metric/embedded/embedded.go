@@ -241,3 +241,99 @@ type Int64ObservableUpDownCounter interface{ int64ObservableUpDownCounter() }
 // extended (which is something that can happen without a major version bump of
 // the API package).
 type Int64UpDownCounter interface{ int64UpDownCounter() }
+
+// BoundFloat64Counter is embedded in
+// [go.opentelemetry.io/otel/metric.BoundFloat64Counter].
+//
+// Embed this interface in your implementation of the
+// [go.opentelemetry.io/otel/metric.BoundFloat64Counter] if you want users
+// to experience a compilation error, signaling they need to update to your
+// latest implementation, when the
+// [go.opentelemetry.io/otel/metric.BoundFloat64Counter] interface is
+// extended (which is something that can happen without a major version bump of
+// the API package).
+type BoundFloat64Counter interface{ boundFloat64Counter() }
+
+// BoundFloat64Gauge is embedded in
+// [go.opentelemetry.io/otel/metric.BoundFloat64Gauge].
+//
+// Embed this interface in your implementation of the
+// [go.opentelemetry.io/otel/metric.BoundFloat64Gauge] if you want users
+// to experience a compilation error, signaling they need to update to your
+// latest implementation, when the
+// [go.opentelemetry.io/otel/metric.BoundFloat64Gauge] interface is
+// extended (which is something that can happen without a major version bump of
+// the API package).
+type BoundFloat64Gauge interface{ boundFloat64Gauge() }
+
+// BoundFloat64Histogram is embedded in
+// [go.opentelemetry.io/otel/metric.BoundFloat64Histogram].
+//
+// Embed this interface in your implementation of the
+// [go.opentelemetry.io/otel/metric.BoundFloat64Histogram] if you want users
+// to experience a compilation error, signaling they need to update to your
+// latest implementation, when the
+// [go.opentelemetry.io/otel/metric.BoundFloat64Histogram] interface is
+// extended (which is something that can happen without a major version bump of
+// the API package).
+type BoundFloat64Histogram interface{ boundFloat64Histogram() }
+
+// BoundFloat64UpDownCounter is embedded in
+// [go.opentelemetry.io/otel/metric.BoundFloat64UpDownCounter].
+//
+// Embed this interface in your implementation of the
+// [go.opentelemetry.io/otel/metric.BoundFloat64UpDownCounter] if you want users
+// to experience a compilation error, signaling they need to update to your
+// latest implementation, when the
+// [go.opentelemetry.io/otel/metric.BoundFloat64UpDownCounter] interface is
+// extended (which is something that can happen without a major version bump of
+// the API package).
+type BoundFloat64UpDownCounter interface{ boundFloat64UpDownCounter() }
+
+// BoundInt64Counter is embedded in
+// [go.opentelemetry.io/otel/metric.BoundInt64Counter].
+//
+// Embed this interface in your implementation of the
+// [go.opentelemetry.io/otel/metric.BoundInt64Counter] if you want users
+// to experience a compilation error, signaling they need to update to your
+// latest implementation, when the
+// [go.opentelemetry.io/otel/metric.BoundInt64Counter] interface is
+// extended (which is something that can happen without a major version bump of
+// the API package).
+type BoundInt64Counter interface{ boundInt64Counter() }
+
+// BoundInt64Gauge is embedded in
+// [go.opentelemetry.io/otel/metric.BoundInt64Gauge].
+//
+// Embed this interface in your implementation of the
+// [go.opentelemetry.io/otel/metric.BoundInt64Gauge] if you want users
+// to experience a compilation error, signaling they need to update to your
+// latest implementation, when the
+// [go.opentelemetry.io/otel/metric.BoundInt64Gauge] interface is
+// extended (which is something that can happen without a major version bump of
+// the API package).
+type BoundInt64Gauge interface{ boundInt64Gauge() }
+
+// BoundInt64Histogram is embedded in
+// [go.opentelemetry.io/otel/metric.BoundInt64Histogram].
+//
+// Embed this interface in your implementation of the
+// [go.opentelemetry.io/otel/metric.BoundInt64Histogram] if you want users
+// to experience a compilation error, signaling they need to update to your
+// latest implementation, when the
+// [go.opentelemetry.io/otel/metric.BoundInt64Histogram] interface is
+// extended (which is something that can happen without a major version bump of
+// the API package).
+type BoundInt64Histogram interface{ boundInt64Histogram() }
+
+// BoundInt64UpDownCounter is embedded in
+// [go.opentelemetry.io/otel/metric.BoundInt64UpDownCounter].
+//
+// Embed this interface in your implementation of the
+// [go.opentelemetry.io/otel/metric.BoundInt64UpDownCounter] if you want users
+// to experience a compilation error, signaling they need to update to your
+// latest implementation, when the
+// [go.opentelemetry.io/otel/metric.BoundInt64UpDownCounter] interface is
+// extended (which is something that can happen without a major version bump of
+// the API package).
+type BoundInt64UpDownCounter interface{ boundInt64UpDownCounter() }
@@ -205,6 +205,93 @@ func (o bucketOpt) applyInt64Histogram(c Int64HistogramConfig) Int64HistogramCon
 	return c
 }
 
+// WithAttributeKeys sets the instrument advisory attribute keys. These are the
+// keys of the attributes recommended to be kept for the measurements made by
+// the instrument. Measurement attributes with other keys are recommended to
+// be dropped, unless configured otherwise by the user of the API
+// implementation.
+//
+// Passing no keys advises that all attributes be dropped.
+//
+// This option is considered "advisory", and may be ignored by API implementations.
+func WithAttributeKeys(keys ...attribute.Key) InstrumentOption {
+	cp := make([]attribute.Key, len(keys))
+	copy(cp, keys)
+	return attrKeysOpt(cp)
+}
+
+type attrKeysOpt []attribute.Key
+
+func (o attrKeysOpt) applyFloat64Counter(c Float64CounterConfig) Float64CounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyFloat64UpDownCounter(c Float64UpDownCounterConfig) Float64UpDownCounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyFloat64Histogram(c Float64HistogramConfig) Float64HistogramConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyFloat64Gauge(c Float64GaugeConfig) Float64GaugeConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyFloat64ObservableCounter(c Float64ObservableCounterConfig) Float64ObservableCounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyFloat64ObservableUpDownCounter(c Float64ObservableUpDownCounterConfig) Float64ObservableUpDownCounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyFloat64ObservableGauge(c Float64ObservableGaugeConfig) Float64ObservableGaugeConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64Counter(c Int64CounterConfig) Int64CounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64UpDownCounter(c Int64UpDownCounterConfig) Int64UpDownCounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64Histogram(c Int64HistogramConfig) Int64HistogramConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64Gauge(c Int64GaugeConfig) Int64GaugeConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64ObservableCounter(c Int64ObservableCounterConfig) Int64ObservableCounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64ObservableUpDownCounter(c Int64ObservableUpDownCounterConfig) Int64ObservableUpDownCounterConfig {
+	c.attributeKeys = o
+	return c
+}
+
+func (o attrKeysOpt) applyInt64ObservableGauge(c Int64ObservableGaugeConfig) Int64ObservableGaugeConfig {
+	c.attributeKeys = o
+	return c
+}
+
 // AddOption applies options to an addition measurement. See
 // [MeasurementOption] for other options that can be used as an AddOption.
 type AddOption interface {
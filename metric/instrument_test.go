@@ -139,3 +139,15 @@ func TestWithAttributesConcurrentSafe(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestWithAttributeKeys(t *testing.T) {
+	keys := []attribute.Key{"a", "b"}
+	opt := WithAttributeKeys(keys...)
+	keys[0] = "c"
+	assert.Equal(t, []attribute.Key{"a", "b"}, NewInt64CounterConfig(opt).AttributeKeys(), "keys not copied")
+
+	assert.Nil(t, NewInt64CounterConfig().AttributeKeys(), "unset")
+	got := NewFloat64HistogramConfig(WithAttributeKeys()).AttributeKeys()
+	assert.NotNil(t, got, "empty keys")
+	assert.Empty(t, got, "empty keys")
+}
@@ -15,6 +15,7 @@ package noop // import "go.opentelemetry.io/otel/metric/noop"
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
 )
@@ -34,6 +35,14 @@ var (
 	_ metric.Float64Histogram               = Float64Histogram{}
 	_ metric.Int64Gauge                     = Int64Gauge{}
 	_ metric.Float64Gauge                   = Float64Gauge{}
+	_ metric.BoundInt64Counter              = BoundInt64Counter{}
+	_ metric.BoundFloat64Counter            = BoundFloat64Counter{}
+	_ metric.BoundInt64UpDownCounter        = BoundInt64UpDownCounter{}
+	_ metric.BoundFloat64UpDownCounter      = BoundFloat64UpDownCounter{}
+	_ metric.BoundInt64Histogram            = BoundInt64Histogram{}
+	_ metric.BoundFloat64Histogram          = BoundFloat64Histogram{}
+	_ metric.BoundInt64Gauge                = BoundInt64Gauge{}
+	_ metric.BoundFloat64Gauge              = BoundFloat64Gauge{}
 	_ metric.Int64ObservableCounter         = Int64ObservableCounter{}
 	_ metric.Float64ObservableCounter       = Float64ObservableCounter{}
 	_ metric.Int64ObservableGauge           = Int64ObservableGauge{}
@@ -176,6 +185,18 @@ type Int64Counter struct{ embedded.Int64Counter }
 // Add performs no operation.
 func (Int64Counter) Add(context.Context, int64, ...metric.AddOption) {}
 
+// Bind returns a BoundInt64Counter that produces no telemetry.
+func (Int64Counter) Bind(attribute.Set) metric.BoundInt64Counter {
+	return BoundInt64Counter{}
+}
+
+// BoundInt64Counter is an OpenTelemetry Int64Counter bound to a fixed set of
+// attributes. It produces no telemetry.
+type BoundInt64Counter struct{ embedded.BoundInt64Counter }
+
+// Add performs no operation.
+func (BoundInt64Counter) Add(context.Context, int64) {}
+
 // Float64Counter is an OpenTelemetry Counter used to record float64
 // measurements. It produces no telemetry.
 type Float64Counter struct{ embedded.Float64Counter }
@@ -183,6 +204,18 @@ type Float64Counter struct{ embedded.Float64Counter }
 // Add performs no operation.
 func (Float64Counter) Add(context.Context, float64, ...metric.AddOption) {}
 
+// Bind returns a BoundFloat64Counter that produces no telemetry.
+func (Float64Counter) Bind(attribute.Set) metric.BoundFloat64Counter {
+	return BoundFloat64Counter{}
+}
+
+// BoundFloat64Counter is a OpenTelemetry Float64Counter bound to a fixed set of
+// attributes. It produces no telemetry.
+type BoundFloat64Counter struct{ embedded.BoundFloat64Counter }
+
+// Add performs no operation.
+func (BoundFloat64Counter) Add(context.Context, float64) {}
+
 // Int64UpDownCounter is an OpenTelemetry UpDownCounter used to record int64
 // measurements. It produces no telemetry.
 type Int64UpDownCounter struct{ embedded.Int64UpDownCounter }
@@ -190,6 +223,20 @@ type Int64UpDownCounter struct{ embedded.Int64UpDownCounter }
 // Add performs no operation.
 func (Int64UpDownCounter) Add(context.Context, int64, ...metric.AddOption) {}
 
+// Bind returns a BoundInt64UpDownCounter that produces no telemetry.
+func (Int64UpDownCounter) Bind(attribute.Set) metric.BoundInt64UpDownCounter {
+	return BoundInt64UpDownCounter{}
+}
+
+// BoundInt64UpDownCounter is an OpenTelemetry Int64UpDownCounter bound to a fixed set of
+// attributes. It produces no telemetry.
+type BoundInt64UpDownCounter struct {
+	embedded.BoundInt64UpDownCounter
+}
+
+// Add performs no operation.
+func (BoundInt64UpDownCounter) Add(context.Context, int64) {}
+
 // Float64UpDownCounter is an OpenTelemetry UpDownCounter used to record
 // float64 measurements. It produces no telemetry.
 type Float64UpDownCounter struct{ embedded.Float64UpDownCounter }
@@ -197,6 +244,20 @@ type Float64UpDownCounter struct{ embedded.Float64UpDownCounter }
 // Add performs no operation.
 func (Float64UpDownCounter) Add(context.Context, float64, ...metric.AddOption) {}
 
+// Bind returns a BoundFloat64UpDownCounter that produces no telemetry.
+func (Float64UpDownCounter) Bind(attribute.Set) metric.BoundFloat64UpDownCounter {
+	return BoundFloat64UpDownCounter{}
+}
+
+// BoundFloat64UpDownCounter is a OpenTelemetry Float64UpDownCounter bound to a fixed set of
+// attributes. It produces no telemetry.
+type BoundFloat64UpDownCounter struct {
+	embedded.BoundFloat64UpDownCounter
+}
+
+// Add performs no operation.
+func (BoundFloat64UpDownCounter) Add(context.Context, float64) {}
+
 // Int64Histogram is an OpenTelemetry Histogram used to record int64
 // measurements. It produces no telemetry.
 type Int64Histogram struct{ embedded.Int64Histogram }
@@ -204,6 +265,18 @@ type Int64Histogram struct{ embedded.Int64Histogram }
 // Record performs no operation.
 func (Int64Histogram) Record(context.Context, int64, ...metric.RecordOption) {}
 
+// Bind returns a BoundInt64Histogram that produces no telemetry.
+func (Int64Histogram) Bind(attribute.Set) metric.BoundInt64Histogram {
+	return BoundInt64Histogram{}
+}
+
+// BoundInt64Histogram is an OpenTelemetry Int64Histogram bound to a fixed set of
+// attributes. It produces no telemetry.
+type BoundInt64Histogram struct{ embedded.BoundInt64Histogram }
+
+// Record performs no operation.
+func (BoundInt64Histogram) Record(context.Context, int64) {}
+
 // Float64Histogram is an OpenTelemetry Histogram used to record float64
 // measurements. It produces no telemetry.
 type Float64Histogram struct{ embedded.Float64Histogram }
@@ -211,6 +284,18 @@ type Float64Histogram struct{ embedded.Float64Histogram }
 // Record performs no operation.
 func (Float64Histogram) Record(context.Context, float64, ...metric.RecordOption) {}
 
+// Bind returns a BoundFloat64Histogram that produces no telemetry.
+func (Float64Histogram) Bind(attribute.Set) metric.BoundFloat64Histogram {
+	return BoundFloat64Histogram{}
+}
+
+// BoundFloat64Histogram is a OpenTelemetry Float64Histogram bound to a fixed set of
+// attributes. It produces no telemetry.
+type BoundFloat64Histogram struct{ embedded.BoundFloat64Histogram }
+
+// Record performs no operation.
+func (BoundFloat64Histogram) Record(context.Context, float64) {}
+
 // Int64Gauge is an OpenTelemetry Gauge used to record instantaneous int64
 // measurements. It produces no telemetry.
 type Int64Gauge struct{ embedded.Int64Gauge }
@@ -218,6 +303,18 @@ type Int64Gauge struct{ embedded.Int64Gauge }
 // Record performs no operation.
 func (Int64Gauge) Record(context.Context, int64, ...metric.RecordOption) {}
 
+// Bind returns a BoundInt64Gauge that produces no telemetry.
+func (Int64Gauge) Bind(attribute.Set) metric.BoundInt64Gauge {
+	return BoundInt64Gauge{}
+}
+
+// BoundInt64Gauge is an OpenTelemetry Int64Gauge bound to a fixed set of
+// attributes. It produces no telemetry.
+type BoundInt64Gauge struct{ embedded.BoundInt64Gauge }
+
+// Record performs no operation.
+func (BoundInt64Gauge) Record(context.Context, int64) {}
+
 // Float64Gauge is an OpenTelemetry Gauge used to record instantaneous float64
 // measurements. It produces no telemetry.
 type Float64Gauge struct{ embedded.Float64Gauge }
@@ -225,6 +322,18 @@ type Float64Gauge struct{ embedded.Float64Gauge }
 // Record performs no operation.
 func (Float64Gauge) Record(context.Context, float64, ...metric.RecordOption) {}
 
+// Bind returns a BoundFloat64Gauge that produces no telemetry.
+func (Float64Gauge) Bind(attribute.Set) metric.BoundFloat64Gauge {
+	return BoundFloat64Gauge{}
+}
+
+// BoundFloat64Gauge is a OpenTelemetry Float64Gauge bound to a fixed set of
+// attributes. It produces no telemetry.
+type BoundFloat64Gauge struct{ embedded.BoundFloat64Gauge }
+
+// Record performs no operation.
+func (BoundFloat64Gauge) Record(context.Context, float64) {}
+
 // Int64ObservableCounter is an OpenTelemetry ObservableCounter used to record
 // int64 measurements. It produces no telemetry.
 type Int64ObservableCounter struct {
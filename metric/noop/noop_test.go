@@ -95,6 +95,38 @@ func TestImplementationNoPanics(t *testing.T) {
 		reflect.ValueOf(Float64Observer{}),
 		reflect.TypeOf((*metric.Float64Observer)(nil)).Elem(),
 	))
+	t.Run("BoundInt64Counter", assertAllExportedMethodNoPanic(
+		reflect.ValueOf(BoundInt64Counter{}),
+		reflect.TypeOf((*metric.BoundInt64Counter)(nil)).Elem(),
+	))
+	t.Run("BoundFloat64Counter", assertAllExportedMethodNoPanic(
+		reflect.ValueOf(BoundFloat64Counter{}),
+		reflect.TypeOf((*metric.BoundFloat64Counter)(nil)).Elem(),
+	))
+	t.Run("BoundInt64UpDownCounter", assertAllExportedMethodNoPanic(
+		reflect.ValueOf(BoundInt64UpDownCounter{}),
+		reflect.TypeOf((*metric.BoundInt64UpDownCounter)(nil)).Elem(),
+	))
+	t.Run("BoundFloat64UpDownCounter", assertAllExportedMethodNoPanic(
+		reflect.ValueOf(BoundFloat64UpDownCounter{}),
+		reflect.TypeOf((*metric.BoundFloat64UpDownCounter)(nil)).Elem(),
+	))
+	t.Run("BoundInt64Histogram", assertAllExportedMethodNoPanic(
+		reflect.ValueOf(BoundInt64Histogram{}),
+		reflect.TypeOf((*metric.BoundInt64Histogram)(nil)).Elem(),
+	))
+	t.Run("BoundFloat64Histogram", assertAllExportedMethodNoPanic(
+		reflect.ValueOf(BoundFloat64Histogram{}),
+		reflect.TypeOf((*metric.BoundFloat64Histogram)(nil)).Elem(),
+	))
+	t.Run("BoundInt64Gauge", assertAllExportedMethodNoPanic(
+		reflect.ValueOf(BoundInt64Gauge{}),
+		reflect.TypeOf((*metric.BoundInt64Gauge)(nil)).Elem(),
+	))
+	t.Run("BoundFloat64Gauge", assertAllExportedMethodNoPanic(
+		reflect.ValueOf(BoundFloat64Gauge{}),
+		reflect.TypeOf((*metric.BoundFloat64Gauge)(nil)).Elem(),
+	))
 }
 
 func assertAllExportedMethodNoPanic(rVal reflect.Value, rType reflect.Type) func(*testing.T) {
@@ -6,6 +6,7 @@ package metric // import "go.opentelemetry.io/otel/metric"
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric/embedded"
 )
 
@@ -25,13 +26,36 @@ type Float64Counter interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Add(ctx context.Context, incr float64, options ...AddOption)
+
+	// Bind returns a BoundFloat64Counter that records measurements for attrs.
+	//
+	// Measurements made with the returned BoundFloat64Counter do not need to
+	// process attributes. Prefer it over the Add method when recording
+	// with a fixed set of attributes on a performance sensitive path.
+	Bind(attrs attribute.Set) BoundFloat64Counter
+}
+
+// BoundFloat64Counter is a [Float64Counter] bound to a fixed set of attributes.
+//
+// Warning: Methods may be added to this interface in minor releases. See
+// package documentation on API implementation for information on how to set
+// default behavior for unimplemented methods.
+type BoundFloat64Counter interface {
+	// Users of the interface can ignore this. This embedded type is only used
+	// by implementations of this interface. See the "API Implementations"
+	// section of the package documentation for more information.
+	embedded.BoundFloat64Counter
+
+	// Add records a change to the counter for the bound attributes.
+	Add(ctx context.Context, incr float64)
 }
 
 // Float64CounterConfig contains options for synchronous counter instruments that
 // record float64 values.
 type Float64CounterConfig struct {
-	description string
-	unit        string
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
 }
 
 // NewFloat64CounterConfig returns a new [Float64CounterConfig] with all opts
@@ -54,6 +78,12 @@ func (c Float64CounterConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys. A nil value
+// means no attribute keys were advised.
+func (c Float64CounterConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Float64CounterOption applies options to a [Float64CounterConfig]. See
 // [InstrumentOption] for other options that can be used as a
 // Float64CounterOption.
@@ -78,13 +108,37 @@ type Float64UpDownCounter interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Add(ctx context.Context, incr float64, options ...AddOption)
+
+	// Bind returns a BoundFloat64UpDownCounter that records measurements for attrs.
+	//
+	// Measurements made with the returned BoundFloat64UpDownCounter do not need to
+	// process attributes. Prefer it over the Add method when recording
+	// with a fixed set of attributes on a performance sensitive path.
+	Bind(attrs attribute.Set) BoundFloat64UpDownCounter
+}
+
+// BoundFloat64UpDownCounter is a [Float64UpDownCounter] bound to a fixed set of
+// attributes.
+//
+// Warning: Methods may be added to this interface in minor releases. See
+// package documentation on API implementation for information on how to set
+// default behavior for unimplemented methods.
+type BoundFloat64UpDownCounter interface {
+	// Users of the interface can ignore this. This embedded type is only used
+	// by implementations of this interface. See the "API Implementations"
+	// section of the package documentation for more information.
+	embedded.BoundFloat64UpDownCounter
+
+	// Add records a change to the counter for the bound attributes.
+	Add(ctx context.Context, incr float64)
 }
 
 // Float64UpDownCounterConfig contains options for synchronous counter
 // instruments that record float64 values.
 type Float64UpDownCounterConfig struct {
-	description string
-	unit        string
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
 }
 
 // NewFloat64UpDownCounterConfig returns a new [Float64UpDownCounterConfig]
@@ -107,6 +161,12 @@ func (c Float64UpDownCounterConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys. A nil value
+// means no attribute keys were advised.
+func (c Float64UpDownCounterConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Float64UpDownCounterOption applies options to a
 // [Float64UpDownCounterConfig]. See [InstrumentOption] for other options that
 // can be used as a Float64UpDownCounterOption.
@@ -131,6 +191,30 @@ type Float64Histogram interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Record(ctx context.Context, incr float64, options ...RecordOption)
+
+	// Bind returns a BoundFloat64Histogram that records measurements for attrs.
+	//
+	// Measurements made with the returned BoundFloat64Histogram do not need to
+	// process attributes. Prefer it over the Record method when recording
+	// with a fixed set of attributes on a performance sensitive path.
+	Bind(attrs attribute.Set) BoundFloat64Histogram
+}
+
+// BoundFloat64Histogram is a [Float64Histogram] bound to a fixed set of
+// attributes.
+//
+// Warning: Methods may be added to this interface in minor releases. See
+// package documentation on API implementation for information on how to set
+// default behavior for unimplemented methods.
+type BoundFloat64Histogram interface {
+	// Users of the interface can ignore this. This embedded type is only used
+	// by implementations of this interface. See the "API Implementations"
+	// section of the package documentation for more information.
+	embedded.BoundFloat64Histogram
+
+	// Record adds an additional value to the distribution for the bound
+	// attributes.
+	Record(ctx context.Context, incr float64)
 }
 
 // Float64HistogramConfig contains options for synchronous histogram
@@ -138,6 +222,7 @@ type Float64Histogram interface {
 type Float64HistogramConfig struct {
 	description              string
 	unit                     string
+	attributeKeys            []attribute.Key
 	explicitBucketBoundaries []float64
 }
 
@@ -161,6 +246,12 @@ func (c Float64HistogramConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys. A nil value
+// means no attribute keys were advised.
+func (c Float64HistogramConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // ExplicitBucketBoundaries returns the configured explicit bucket boundaries.
 func (c Float64HistogramConfig) ExplicitBucketBoundaries() []float64 {
 	return c.explicitBucketBoundaries
@@ -189,13 +280,36 @@ type Float64Gauge interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Record(ctx context.Context, value float64, options ...RecordOption)
+
+	// Bind returns a BoundFloat64Gauge that records measurements for attrs.
+	//
+	// Measurements made with the returned BoundFloat64Gauge do not need to
+	// process attributes. Prefer it over the Record method when recording
+	// with a fixed set of attributes on a performance sensitive path.
+	Bind(attrs attribute.Set) BoundFloat64Gauge
+}
+
+// BoundFloat64Gauge is a [Float64Gauge] bound to a fixed set of attributes.
+//
+// Warning: Methods may be added to this interface in minor releases. See
+// package documentation on API implementation for information on how to set
+// default behavior for unimplemented methods.
+type BoundFloat64Gauge interface {
+	// Users of the interface can ignore this. This embedded type is only used
+	// by implementations of this interface. See the "API Implementations"
+	// section of the package documentation for more information.
+	embedded.BoundFloat64Gauge
+
+	// Record records the instantaneous value for the bound attributes.
+	Record(ctx context.Context, value float64)
 }
 
 // Float64GaugeConfig contains options for synchronous gauge instruments that
 // record float64 values.
 type Float64GaugeConfig struct {
-	description string
-	unit        string
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
 }
 
 // NewFloat64GaugeConfig returns a new [Float64GaugeConfig] with all opts
@@ -218,6 +332,12 @@ func (c Float64GaugeConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys. A nil value
+// means no attribute keys were advised.
+func (c Float64GaugeConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Float64GaugeOption applies options to a [Float64GaugeConfig]. See
 // [InstrumentOption] for other options that can be used as a
 // Float64GaugeOption.
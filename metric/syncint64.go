@@ -6,6 +6,7 @@ package metric // import "go.opentelemetry.io/otel/metric"
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric/embedded"
 )
 
@@ -25,13 +26,36 @@ type Int64Counter interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Add(ctx context.Context, incr int64, options ...AddOption)
+
+	// Bind returns a BoundInt64Counter that records measurements for attrs.
+	//
+	// Measurements made with the returned BoundInt64Counter do not need to
+	// process attributes. Prefer it over the Add method when recording
+	// with a fixed set of attributes on a performance sensitive path.
+	Bind(attrs attribute.Set) BoundInt64Counter
+}
+
+// BoundInt64Counter is an [Int64Counter] bound to a fixed set of attributes.
+//
+// Warning: Methods may be added to this interface in minor releases. See
+// package documentation on API implementation for information on how to set
+// default behavior for unimplemented methods.
+type BoundInt64Counter interface {
+	// Users of the interface can ignore this. This embedded type is only used
+	// by implementations of this interface. See the "API Implementations"
+	// section of the package documentation for more information.
+	embedded.BoundInt64Counter
+
+	// Add records a change to the counter for the bound attributes.
+	Add(ctx context.Context, incr int64)
 }
 
 // Int64CounterConfig contains options for synchronous counter instruments that
 // record int64 values.
 type Int64CounterConfig struct {
-	description string
-	unit        string
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
 }
 
 // NewInt64CounterConfig returns a new [Int64CounterConfig] with all opts
@@ -54,6 +78,12 @@ func (c Int64CounterConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys. A nil value
+// means no attribute keys were advised.
+func (c Int64CounterConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Int64CounterOption applies options to a [Int64CounterConfig]. See
 // [InstrumentOption] for other options that can be used as an
 // Int64CounterOption.
@@ -78,13 +108,37 @@ type Int64UpDownCounter interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Add(ctx context.Context, incr int64, options ...AddOption)
+
+	// Bind returns a BoundInt64UpDownCounter that records measurements for attrs.
+	//
+	// Measurements made with the returned BoundInt64UpDownCounter do not need to
+	// process attributes. Prefer it over the Add method when recording
+	// with a fixed set of attributes on a performance sensitive path.
+	Bind(attrs attribute.Set) BoundInt64UpDownCounter
+}
+
+// BoundInt64UpDownCounter is an [Int64UpDownCounter] bound to a fixed set of
+// attributes.
+//
+// Warning: Methods may be added to this interface in minor releases. See
+// package documentation on API implementation for information on how to set
+// default behavior for unimplemented methods.
+type BoundInt64UpDownCounter interface {
+	// Users of the interface can ignore this. This embedded type is only used
+	// by implementations of this interface. See the "API Implementations"
+	// section of the package documentation for more information.
+	embedded.BoundInt64UpDownCounter
+
+	// Add records a change to the counter for the bound attributes.
+	Add(ctx context.Context, incr int64)
 }
 
 // Int64UpDownCounterConfig contains options for synchronous counter
 // instruments that record int64 values.
 type Int64UpDownCounterConfig struct {
-	description string
-	unit        string
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
 }
 
 // NewInt64UpDownCounterConfig returns a new [Int64UpDownCounterConfig] with
@@ -107,6 +161,12 @@ func (c Int64UpDownCounterConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys. A nil value
+// means no attribute keys were advised.
+func (c Int64UpDownCounterConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Int64UpDownCounterOption applies options to a [Int64UpDownCounterConfig].
 // See [InstrumentOption] for other options that can be used as an
 // Int64UpDownCounterOption.
@@ -131,6 +191,30 @@ type Int64Histogram interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Record(ctx context.Context, incr int64, options ...RecordOption)
+
+	// Bind returns a BoundInt64Histogram that records measurements for attrs.
+	//
+	// Measurements made with the returned BoundInt64Histogram do not need to
+	// process attributes. Prefer it over the Record method when recording
+	// with a fixed set of attributes on a performance sensitive path.
+	Bind(attrs attribute.Set) BoundInt64Histogram
+}
+
+// BoundInt64Histogram is an [Int64Histogram] bound to a fixed set of
+// attributes.
+//
+// Warning: Methods may be added to this interface in minor releases. See
+// package documentation on API implementation for information on how to set
+// default behavior for unimplemented methods.
+type BoundInt64Histogram interface {
+	// Users of the interface can ignore this. This embedded type is only used
+	// by implementations of this interface. See the "API Implementations"
+	// section of the package documentation for more information.
+	embedded.BoundInt64Histogram
+
+	// Record adds an additional value to the distribution for the bound
+	// attributes.
+	Record(ctx context.Context, incr int64)
 }
 
 // Int64HistogramConfig contains options for synchronous histogram instruments
@@ -138,6 +222,7 @@ type Int64Histogram interface {
 type Int64HistogramConfig struct {
 	description              string
 	unit                     string
+	attributeKeys            []attribute.Key
 	explicitBucketBoundaries []float64
 }
 
@@ -161,6 +246,12 @@ func (c Int64HistogramConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys. A nil value
+// means no attribute keys were advised.
+func (c Int64HistogramConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // ExplicitBucketBoundaries returns the configured explicit bucket boundaries.
 func (c Int64HistogramConfig) ExplicitBucketBoundaries() []float64 {
 	return c.explicitBucketBoundaries
@@ -189,13 +280,36 @@ type Int64Gauge interface {
 	// Use the WithAttributeSet (or, if performance is not a concern,
 	// the WithAttributes) option to include measurement attributes.
 	Record(ctx context.Context, value int64, options ...RecordOption)
+
+	// Bind returns a BoundInt64Gauge that records measurements for attrs.
+	//
+	// Measurements made with the returned BoundInt64Gauge do not need to
+	// process attributes. Prefer it over the Record method when recording
+	// with a fixed set of attributes on a performance sensitive path.
+	Bind(attrs attribute.Set) BoundInt64Gauge
+}
+
+// BoundInt64Gauge is an [Int64Gauge] bound to a fixed set of attributes.
+//
+// Warning: Methods may be added to this interface in minor releases. See
+// package documentation on API implementation for information on how to set
+// default behavior for unimplemented methods.
+type BoundInt64Gauge interface {
+	// Users of the interface can ignore this. This embedded type is only used
+	// by implementations of this interface. See the "API Implementations"
+	// section of the package documentation for more information.
+	embedded.BoundInt64Gauge
+
+	// Record records the instantaneous value for the bound attributes.
+	Record(ctx context.Context, value int64)
 }
 
 // Int64GaugeConfig contains options for synchronous gauge instruments that
 // record int64 values.
 type Int64GaugeConfig struct {
-	description string
-	unit        string
+	description   string
+	unit          string
+	attributeKeys []attribute.Key
 }
 
 // NewInt64GaugeConfig returns a new [Int64GaugeConfig] with all opts
@@ -218,6 +332,12 @@ func (c Int64GaugeConfig) Unit() string {
 	return c.unit
 }
 
+// AttributeKeys returns the configured advisory attribute keys. A nil value
+// means no attribute keys were advised.
+func (c Int64GaugeConfig) AttributeKeys() []attribute.Key {
+	return c.attributeKeys
+}
+
 // Int64GaugeOption applies options to a [Int64GaugeConfig]. See
 // [InstrumentOption] for other options that can be used as a
 // Int64GaugeOption.
@@ -114,6 +114,9 @@ func NewBatchProcessor(exporter Exporter, opts ...BatchProcessorOption) *BatchPr
 	// to ensure each export completes in timeout (instead of all chunked
 	// exports).
 	exporter = newTimeoutExporter(exporter, cfg.expTimeout.Value)
+	// Use a sizeExporter to ensure each export does not exceed the maximum
+	// batch size in bytes.
+	exporter = newSizeExporter(exporter, cfg.expMaxBatchBytes.Value, cfg.oversize.Value)
 	// Use a chunkExporter to ensure ForceFlush and Shutdown calls are batched
 	// appropriately on export.
 	exporter = newChunkExporter(exporter, cfg.expMaxBatchSize.Value)
@@ -354,6 +357,9 @@ type batchConfig struct {
 	expInterval     setting[time.Duration]
 	expTimeout      setting[time.Duration]
 	expMaxBatchSize setting[int]
+
+	expMaxBatchBytes setting[int]
+	oversize         setting[OversizePolicy]
 }
 
 func newBatchConfig(options []BatchProcessorOption) batchConfig {
@@ -387,6 +393,9 @@ func newBatchConfig(options []BatchProcessorOption) batchConfig {
 		clampMax[int](c.maxQSize.Value),
 		fallback[int](dfltExpMaxBatchSize),
 	)
+	// Batches are not limited in size, and records are sent alone, by
+	// default.
+	c.expMaxBatchBytes = c.expMaxBatchBytes.Resolve(clearLessThanOne[int]())
 
 	return c
 }
@@ -463,3 +472,31 @@ func WithExportMaxBatchSize(size int) BatchProcessorOption {
 		return cfg
 	})
 }
+
+// WithExportMaxBatchBytes sets the maximum size in bytes of every export. A
+// batch will be split into multiple exports to not exceed this size. The size
+// of a log record is an estimate of its OTLP protobuf encoded size.
+//
+// A log record that exceeds this size on its own is handled according to the
+// [OversizePolicy] set with [WithExportOversizePolicy].
+//
+// By default, if this option is not passed, batches are not limited in size.
+// The default value is also used when the provided value is less than one.
+func WithExportMaxBatchBytes(size int) BatchProcessorOption {
+	return batchOptionFunc(func(cfg batchConfig) batchConfig {
+		cfg.expMaxBatchBytes = newSetting(size)
+		return cfg
+	})
+}
+
+// WithExportOversizePolicy sets how a log record that exceeds the maximum
+// batch size in bytes set with [WithExportMaxBatchBytes] on its own is
+// exported.
+//
+// By default, if this option is not passed, [OversizeSendAlone] is used.
+func WithExportOversizePolicy(policy OversizePolicy) BatchProcessorOption {
+	return batchOptionFunc(func(cfg batchConfig) batchConfig {
+		cfg.oversize = newSetting(policy)
+		return cfg
+	})
+}
@@ -155,6 +155,33 @@ func TestNewBatchConfig(t *testing.T) {
 				expMaxBatchSize: newSetting(2),
 			},
 		},
+		{
+			name: "MaxBatchBytes",
+			options: []BatchProcessorOption{
+				WithExportMaxBatchBytes(1 << 20),
+				WithExportOversizePolicy(OversizeTruncate),
+			},
+			want: batchConfig{
+				maxQSize:         newSetting(dfltMaxQSize),
+				expInterval:      newSetting(dfltExpInterval),
+				expTimeout:       newSetting(dfltExpTimeout),
+				expMaxBatchSize:  newSetting(dfltExpMaxBatchSize),
+				expMaxBatchBytes: newSetting(1 << 20),
+				oversize:         newSetting(OversizeTruncate),
+			},
+		},
+		{
+			name: "InvalidMaxBatchBytes",
+			options: []BatchProcessorOption{
+				WithExportMaxBatchBytes(-1),
+			},
+			want: batchConfig{
+				maxQSize:        newSetting(dfltMaxQSize),
+				expInterval:     newSetting(dfltExpInterval),
+				expTimeout:      newSetting(dfltExpTimeout),
+				expMaxBatchSize: newSetting(dfltExpMaxBatchSize),
+			},
+		},
 		{
 			name: "BatchLessThanOrEqualToQSize",
 			options: []BatchProcessorOption{
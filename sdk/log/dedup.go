@@ -0,0 +1,368 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/log"
+)
+
+// dedupRepeatCountKey is the key of the attribute holding the number of
+// identical records a record passed by a DedupProcessor represents.
+const dedupRepeatCountKey = "log.record.repeat_count"
+
+// Compile-time check DedupProcessor implements Processor.
+var _ Processor = (*DedupProcessor)(nil)
+
+// DedupProcessor is a [Processor] that collapses identical log records before
+// passing them to the Processor it decorates. It protects the decorated
+// Processor, e.g. a [BatchProcessor], from being flooded by records emitted in
+// a tight loop.
+//
+// Records are identical if they have the same body, severity, and
+// instrumentation scope. The first of identical records is passed
+// immediately. The identical records emitted within a window after it (see
+// [WithDedupWindow]) are dropped. When the window ends, the first dropped
+// record is passed with a "log.record.repeat_count" attribute holding the
+// number of records it represents, and a new window starts.
+//
+// The identical records can also be limited to a rate (see
+// [WithDedupRateLimit]). The records over the rate are dropped and counted
+// in the repeat count of the next passed identical record.
+//
+// Dropped records that have not been passed yet are passed on ForceFlush and
+// Shutdown.
+//
+// Use [NewDedupProcessor] to create a DedupProcessor.
+type DedupProcessor struct {
+	processor Processor
+
+	window time.Duration
+	rate   float64
+
+	mu        sync.Mutex
+	keys      map[dedupKey]*dedupState
+	lastSweep time.Time
+	stopped   bool
+
+	// firing is the number of timers passing a record at the end of its
+	// window. idle is closed when it drops to zero.
+	firing int
+	idle   chan struct{}
+
+	noCmp [0]func() //nolint: unused  // This is indeed used.
+}
+
+// dedupKey identifies identical records.
+type dedupKey struct {
+	bodyKind     log.Kind
+	body         string
+	severity     log.Severity
+	scopeName    string
+	scopeVersion string
+}
+
+func newDedupKey(r *Record) dedupKey {
+	scope := r.InstrumentationScope()
+	body := r.Body()
+	return dedupKey{
+		bodyKind:     body.Kind(),
+		body:         body.String(),
+		severity:     r.Severity(),
+		scopeName:    scope.Name,
+		scopeVersion: scope.Version,
+	}
+}
+
+// dedupState is the state of identical records.
+type dedupState struct {
+	// windowEnd is when the current window ends.
+	windowEnd time.Time
+	limiter   *rateLimiter
+
+	// repeated is the number of records dropped since the last passed one.
+	repeated int
+	// first is the first record dropped since the last passed one. Only it
+	// is kept, the records dropped after it are only counted.
+	first Record
+
+	// timer passes the first dropped record when the window ends.
+	timer *time.Timer
+	// gen identifies the last scheduled timer.
+	gen uint64
+}
+
+// NewDedupProcessor returns a [DedupProcessor] that decorates processor.
+func NewDedupProcessor(processor Processor, opts ...DedupProcessorOption) *DedupProcessor {
+	cfg := newDedupConfig(opts)
+	return &DedupProcessor{
+		processor: processor,
+		window:    cfg.window.Value,
+		rate:      cfg.rate.Value,
+		keys:      make(map[dedupKey]*dedupState),
+		lastSweep: now(),
+	}
+}
+
+// OnEmit passes r to the decorated processor unless it is identical to a
+// record recently passed.
+func (p *DedupProcessor) OnEmit(ctx context.Context, r *Record) error {
+	if p.processor == nil {
+		return nil
+	}
+
+	t := now()
+	key := newDedupKey(r)
+
+	p.mu.Lock()
+	if p.keys == nil {
+		// Zero value DedupProcessor.
+		p.mu.Unlock()
+		return p.processor.OnEmit(ctx, r)
+	}
+	p.sweep(t)
+
+	s, ok := p.keys[key]
+	if !ok {
+		s = &dedupState{}
+		if p.rate > 0 {
+			s.limiter = newRateLimiter(p.rate)
+		}
+		p.keys[key] = s
+	}
+
+	if t.Before(s.windowEnd) || (s.limiter != nil && !s.limiter.allow(t)) {
+		if s.repeated == 0 {
+			s.first = r.Clone()
+		}
+		s.repeated++
+		if s.timer == nil && !p.stopped {
+			p.schedule(key, s, t)
+		}
+		p.mu.Unlock()
+		return nil
+	}
+
+	n := s.repeated + 1
+	s.reset()
+	s.windowEnd = t.Add(p.window)
+	p.mu.Unlock()
+
+	if n > 1 {
+		r.AddAttributes(log.Int(dedupRepeatCountKey, n))
+	}
+	return p.processor.OnEmit(ctx, r)
+}
+
+// schedule starts a timer passing the records dropped for key when they are
+// allowed to be. It needs to be called while holding the lock of p.
+func (p *DedupProcessor) schedule(key dedupKey, s *dedupState, t time.Time) {
+	d := s.windowEnd.Sub(t)
+	if s.limiter != nil {
+		d = max(d, s.limiter.wait(t))
+	}
+
+	s.gen++
+	gen := s.gen
+	s.timer = time.AfterFunc(d, func() { p.fire(key, s, gen) })
+}
+
+// fire passes the records dropped for key at the end of their window.
+func (p *DedupProcessor) fire(key dedupKey, s *dedupState, gen uint64) {
+	p.mu.Lock()
+	if p.stopped || p.keys[key] != s || s.gen != gen {
+		p.mu.Unlock()
+		return
+	}
+	s.timer = nil
+
+	t := now()
+	if s.limiter != nil {
+		// The timer was scheduled for when a record is allowed.
+		_ = s.limiter.allow(t)
+	}
+	r, ok := s.take()
+	s.windowEnd = t.Add(p.window)
+	if !ok {
+		p.mu.Unlock()
+		return
+	}
+	if p.firing == 0 {
+		p.idle = make(chan struct{})
+	}
+	p.firing++
+	p.mu.Unlock()
+
+	if err := p.processor.OnEmit(context.Background(), &r); err != nil {
+		otel.Handle(err)
+	}
+
+	p.mu.Lock()
+	p.firing--
+	if p.firing == 0 {
+		close(p.idle)
+		p.idle = nil
+	}
+	p.mu.Unlock()
+}
+
+// take returns the first dropped record, with the number of records it
+// represents, and resets s. It returns false if no record was dropped.
+func (s *dedupState) take() (Record, bool) {
+	n, r := s.repeated, s.first
+	s.reset()
+	if n == 0 {
+		return Record{}, false
+	}
+	r.AddAttributes(log.Int(dedupRepeatCountKey, n))
+	return r, true
+}
+
+// reset stops the timer and forgets the dropped records of s.
+func (s *dedupState) reset() {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	s.repeated = 0
+	s.first = Record{}
+}
+
+// sweep forgets the records that are no longer needed to be identified at t.
+// It needs to be called while holding the lock of p.
+func (p *DedupProcessor) sweep(t time.Time) {
+	if t.Sub(p.lastSweep) < max(p.window, time.Second) {
+		return
+	}
+	p.lastSweep = t
+
+	for key, s := range p.keys {
+		if s.repeated > 0 || t.Before(s.windowEnd) {
+			continue
+		}
+		if s.limiter != nil && !s.limiter.full(t) {
+			continue
+		}
+		delete(p.keys, key)
+	}
+}
+
+// flush passes all the dropped records that have not been passed yet. It
+// returns once the records being passed by timers are passed, or ctx is done.
+func (p *DedupProcessor) flush(ctx context.Context) error {
+	p.mu.Lock()
+	var records []Record
+	for _, s := range p.keys {
+		if r, ok := s.take(); ok {
+			records = append(records, r)
+		}
+	}
+	idle := p.idle
+	p.mu.Unlock()
+
+	var err error
+	for i := range records {
+		err = errors.Join(err, p.processor.OnEmit(ctx, &records[i]))
+	}
+
+	if idle != nil {
+		select {
+		case <-idle:
+		case <-ctx.Done():
+			err = errors.Join(err, ctx.Err())
+		}
+	}
+	return err
+}
+
+// Enabled returns what the decorated processor returns.
+//
+// Whether a record is identical to a recently passed one cannot be determined
+// from the partial record passed to Enabled.
+func (p *DedupProcessor) Enabled(ctx context.Context, r Record) bool {
+	if p.processor == nil {
+		return false
+	}
+	return p.processor.Enabled(ctx, r)
+}
+
+// Shutdown passes the dropped records that have not been passed yet and
+// shuts down the decorated processor.
+func (p *DedupProcessor) Shutdown(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+
+	p.mu.Lock()
+	p.stopped = true
+	p.mu.Unlock()
+
+	return errors.Join(p.flush(ctx), p.processor.Shutdown(ctx))
+}
+
+// ForceFlush passes the dropped records that have not been passed yet and
+// flushes the decorated processor.
+func (p *DedupProcessor) ForceFlush(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+	return errors.Join(p.flush(ctx), p.processor.ForceFlush(ctx))
+}
+
+type dedupConfig struct {
+	window setting[time.Duration]
+	rate   setting[float64]
+}
+
+func newDedupConfig(options []DedupProcessorOption) dedupConfig {
+	var c dedupConfig
+	for _, o := range options {
+		c = o.apply(c)
+	}
+
+	c.window = c.window.Resolve(fallback(time.Second))
+	c.window.Value = max(c.window.Value, 0)
+	return c
+}
+
+// DedupProcessorOption applies a configuration to a [DedupProcessor].
+type DedupProcessorOption interface {
+	apply(dedupConfig) dedupConfig
+}
+
+type dedupOptionFunc func(dedupConfig) dedupConfig
+
+func (fn dedupOptionFunc) apply(c dedupConfig) dedupConfig {
+	return fn(c)
+}
+
+// WithDedupWindow sets the duration identical records are collapsed for after
+// one of them is passed. If window is not positive, records are not collapsed
+// based on time, only on the rate set with [WithDedupRateLimit].
+//
+// By default, if this option is not passed, a window of 1 second is used.
+func WithDedupWindow(window time.Duration) DedupProcessorOption {
+	return dedupOptionFunc(func(c dedupConfig) dedupConfig {
+		c.window = newSetting(window)
+		return c
+	})
+}
+
+// WithDedupRateLimit sets the maximum number of identical records passed per
+// second, including the records passed at the end of a window. Bursts of up
+// to rate records are allowed.
+//
+// By default, if this option is not passed or rate is not positive, identical
+// records are not limited by rate.
+func WithDedupRateLimit(rate float64) DedupProcessorOption {
+	return dedupOptionFunc(func(c dedupConfig) dedupConfig {
+		c.rate = newSetting(rate)
+		return c
+	})
+}
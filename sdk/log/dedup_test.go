@@ -0,0 +1,278 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+// concurrentProcessor is a processor safe for concurrent use.
+type concurrentProcessor struct {
+	mu sync.Mutex
+	*processor
+}
+
+func newConcurrentProcessor(name string) *concurrentProcessor {
+	return &concurrentProcessor{processor: newProcessor(name)}
+}
+
+func (p *concurrentProcessor) OnEmit(ctx context.Context, r *Record) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.processor.OnEmit(ctx, r)
+}
+
+func (p *concurrentProcessor) Records() []Record {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Record(nil), p.records...)
+}
+
+func repeatCount(r Record) int {
+	n := 1
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		if kv.Key == dedupRepeatCountKey {
+			n = int(kv.Value.AsInt64())
+			return false
+		}
+		return true
+	})
+	return n
+}
+
+func setNow(t *testing.T, start time.Time) *time.Time {
+	t.Helper()
+	t.Cleanup(func(orig func() time.Time) func() {
+		return func() { now = orig }
+	}(now))
+
+	current := start
+	now = func() time.Time { return current }
+	return &current
+}
+
+func bodyRecord(body string, sev log.Severity) log.Record {
+	r := severityRecord(sev)
+	r.SetBody(log.StringValue(body))
+	return r
+}
+
+func TestDedupProcessor(t *testing.T) {
+	current := setNow(t, time.Now())
+
+	ctx := context.Background()
+	p := newProcessor("downstream")
+	provider := NewLoggerProvider(WithProcessor(NewDedupProcessor(
+		p,
+		WithDedupWindow(time.Hour),
+	)))
+	l := provider.Logger("TestDedupProcessor")
+
+	for i := 0; i < 5; i++ {
+		l.Emit(ctx, bodyRecord("failed", log.SeverityError))
+	}
+	// Different body, severity, and scope.
+	l.Emit(ctx, bodyRecord("other", log.SeverityError))
+	l.Emit(ctx, bodyRecord("failed", log.SeverityWarn))
+	provider.Logger("other").Emit(ctx, bodyRecord("failed", log.SeverityError))
+
+	require.Len(t, p.records, 4, "identical records not collapsed")
+	for _, r := range p.records {
+		assert.Equal(t, 1, repeatCount(r))
+	}
+
+	// The window ends with the next identical record.
+	*current = current.Add(time.Hour)
+	l.Emit(ctx, bodyRecord("failed", log.SeverityError))
+	require.Len(t, p.records, 5)
+	assert.Equal(t, 5, repeatCount(p.records[4]), "dropped records not counted")
+	assert.Equal(t, "failed", p.records[4].Body().AsString())
+
+	for i := 1; i <= 2; i++ {
+		r := bodyRecord("failed", log.SeverityError)
+		r.SetTimestamp(time.Unix(int64(i), 0))
+		l.Emit(ctx, r)
+	}
+	require.NoError(t, provider.ForceFlush(ctx))
+	require.Len(t, p.records, 6, "dropped records not flushed")
+	assert.Equal(t, 2, repeatCount(p.records[5]))
+	assert.Equal(t, time.Unix(1, 0), p.records[5].Timestamp(), "first dropped record not passed")
+
+	require.NoError(t, provider.ForceFlush(ctx))
+	assert.Len(t, p.records, 6, "records flushed twice")
+}
+
+func TestDedupProcessorWindowEnd(t *testing.T) {
+	ctx := context.Background()
+	p := newConcurrentProcessor("downstream")
+	dp := NewDedupProcessor(p, WithDedupWindow(10*time.Millisecond))
+	l := NewLoggerProvider(WithProcessor(dp)).Logger("TestDedupProcessorWindowEnd")
+
+	for i := 0; i < 3; i++ {
+		l.Emit(ctx, bodyRecord("failed", log.SeverityError))
+	}
+	assert.Eventually(t, func() bool {
+		return len(p.Records()) == 2
+	}, time.Second, time.Millisecond, "dropped records not passed at the end of the window")
+	assert.Equal(t, 2, repeatCount(p.Records()[1]))
+}
+
+// blockingProcessor is a processor blocking OnEmit calls from timers until
+// released.
+type blockingProcessor struct {
+	*concurrentProcessor
+
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (p *blockingProcessor) OnEmit(ctx context.Context, r *Record) error {
+	if repeatCount(*r) > 1 {
+		close(p.entered)
+		<-p.release
+	}
+	return p.concurrentProcessor.OnEmit(ctx, r)
+}
+
+func (p *blockingProcessor) Shutdown(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.processor.Shutdown(ctx)
+}
+
+func TestDedupProcessorShutdownWhileFiring(t *testing.T) {
+	ctx := context.Background()
+	p := &blockingProcessor{
+		concurrentProcessor: newConcurrentProcessor("downstream"),
+		entered:             make(chan struct{}),
+		release:             make(chan struct{}),
+	}
+	dp := NewDedupProcessor(p, WithDedupWindow(10*time.Millisecond))
+	l := NewLoggerProvider(WithProcessor(dp)).Logger("TestDedupProcessorShutdownWhileFiring")
+
+	for i := 0; i < 3; i++ {
+		l.Emit(ctx, bodyRecord("failed", log.SeverityError))
+	}
+	<-p.entered
+
+	done := make(chan error, 1)
+	go func() { done <- dp.Shutdown(ctx) }()
+	select {
+	case <-done:
+		t.Fatal("Shutdown returned while a record was being passed")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	close(p.release)
+	require.NoError(t, <-done)
+	records := p.Records()
+	require.Len(t, records, 2, "dropped record lost")
+	assert.Equal(t, 2, repeatCount(records[1]))
+	assert.Equal(t, 1, p.shutdownCalls)
+}
+
+func TestDedupProcessorRateLimit(t *testing.T) {
+	current := setNow(t, time.Now())
+
+	ctx := context.Background()
+	p := newProcessor("downstream")
+	dp := NewDedupProcessor(p, WithDedupWindow(0), WithDedupRateLimit(2))
+	l := NewLoggerProvider(WithProcessor(dp)).Logger("TestDedupProcessorRateLimit")
+
+	for i := 0; i < 5; i++ {
+		l.Emit(ctx, bodyRecord("failed", log.SeverityError))
+	}
+	// Other records have their own limit.
+	l.Emit(ctx, bodyRecord("other", log.SeverityError))
+	require.Len(t, p.records, 3, "rate not limited")
+	assert.Equal(t, 1, repeatCount(p.records[0]))
+	assert.Equal(t, 1, repeatCount(p.records[1]))
+
+	*current = current.Add(500 * time.Millisecond)
+	l.Emit(ctx, bodyRecord("failed", log.SeverityError))
+	require.Len(t, p.records, 4)
+	assert.Equal(t, 4, repeatCount(p.records[3]), "limited records not counted")
+
+	require.NoError(t, dp.Shutdown(ctx))
+	assert.Len(t, p.records, 4)
+	assert.Equal(t, 1, p.shutdownCalls)
+}
+
+func TestDedupProcessorConcurrentSafe(t *testing.T) {
+	const goRoutines, emits = 10, 100
+
+	ctx := context.Background()
+	p := newConcurrentProcessor("downstream")
+	dp := NewDedupProcessor(p, WithDedupWindow(time.Hour), WithDedupRateLimit(10))
+	l := NewLoggerProvider(WithProcessor(dp)).Logger("TestDedupProcessorConcurrentSafe")
+
+	var wg sync.WaitGroup
+	for i := 0; i < goRoutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < emits; j++ {
+				l.Emit(ctx, bodyRecord("failed", log.SeverityError))
+				_ = dp.Enabled(ctx, Record{})
+			}
+		}()
+	}
+	wg.Wait()
+	require.NoError(t, dp.ForceFlush(ctx))
+
+	var total int
+	for _, r := range p.Records() {
+		total += repeatCount(r)
+	}
+	assert.Equal(t, goRoutines*emits, total, "records lost")
+}
+
+func TestDedupProcessorSweep(t *testing.T) {
+	current := setNow(t, time.Now())
+
+	ctx := context.Background()
+	dp := NewDedupProcessor(newProcessor("downstream"), WithDedupWindow(time.Second))
+	l := NewLoggerProvider(WithProcessor(dp)).Logger("TestDedupProcessorSweep")
+
+	l.Emit(ctx, bodyRecord("a", log.SeverityError))
+	l.Emit(ctx, bodyRecord("b", log.SeverityError))
+	l.Emit(ctx, bodyRecord("b", log.SeverityError))
+	assert.Len(t, dp.keys, 2)
+
+	*current = current.Add(2 * time.Second)
+	l.Emit(ctx, bodyRecord("c", log.SeverityError))
+	assert.Len(t, dp.keys, 2, "records with pending repeats forgotten or idle ones kept")
+	require.NoError(t, dp.Shutdown(ctx))
+}
+
+func TestDedupProcessorDelegates(t *testing.T) {
+	ctx := context.Background()
+	p := newProcessor("downstream")
+	dp := NewDedupProcessor(p)
+
+	var r Record
+	r.SetSeverity(log.SeverityTrace)
+	assert.True(t, dp.Enabled(ctx, r))
+	p.enabled = false
+	assert.False(t, dp.Enabled(ctx, r), "downstream Enabled not used")
+
+	assert.NoError(t, dp.ForceFlush(ctx))
+	assert.NoError(t, dp.Shutdown(ctx))
+	assert.Equal(t, 1, p.forceFlushCalls)
+	assert.Equal(t, 1, p.shutdownCalls)
+
+	var empty DedupProcessor
+	assert.False(t, empty.Enabled(ctx, r))
+	assert.NoError(t, empty.OnEmit(ctx, &r))
+	assert.NoError(t, empty.ForceFlush(ctx))
+	assert.NoError(t, empty.Shutdown(ctx))
+}
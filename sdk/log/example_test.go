@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"go.opentelemetry.io/otel"
 	logapi "go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/log/global"
 	"go.opentelemetry.io/otel/sdk/log"
@@ -146,3 +148,84 @@ func (p *RedactTokensProcessor) Shutdown(ctx context.Context) error {
 func (p *RedactTokensProcessor) ForceFlush(ctx context.Context) error {
 	return nil
 }
+
+// Use a processor that drops records below a minimum severity.
+func ExampleNewMinSeverityProcessor() {
+	// Existing processor that emits telemetry.
+	var processor log.Processor = log.NewBatchProcessor(nil)
+
+	// Only pass records with at least a warning severity, except for the
+	// records of the "github.com/my/pkg" logger and the loggers of its
+	// sub-packages.
+	processor = log.NewMinSeverityProcessor(
+		processor,
+		log.WithMinSeverity(logapi.SeverityWarn),
+		log.WithScopePrefixMinSeverity("github.com/my/pkg", logapi.SeverityDebug),
+	)
+
+	// The created processor can then be registered with
+	// the OpenTelemetry Logs SDK using the WithProcessor option.
+	_ = log.NewLoggerProvider(
+		log.WithProcessor(processor),
+	)
+}
+
+// Use a processor that samples records based on their trace context.
+func ExampleNewSamplingProcessor() {
+	// Existing processor that emits telemetry.
+	var processor log.Processor = log.NewBatchProcessor(nil)
+
+	// Keep the records of sampled traces and the records with at least an
+	// error severity. Keep 10% of the other records, up to 100 per second.
+	processor = log.NewSamplingProcessor(
+		processor,
+		log.WithSamplingSeverity(logapi.SeverityError),
+		log.WithSampleRatio(0.1),
+		log.WithSampleRate(100),
+	)
+
+	// The created processor can then be registered with
+	// the OpenTelemetry Logs SDK using the WithProcessor option.
+	_ = log.NewLoggerProvider(
+		log.WithProcessor(processor),
+	)
+}
+
+// Use a processor that collapses identical records emitted in a tight loop.
+func ExampleNewDedupProcessor() {
+	// Existing processor that emits telemetry.
+	var processor log.Processor = log.NewBatchProcessor(nil)
+
+	// Pass identical records at most once every 10 seconds, and no more than
+	// 5 of them per minute.
+	processor = log.NewDedupProcessor(
+		processor,
+		log.WithDedupWindow(10*time.Second),
+		log.WithDedupRateLimit(5.0/60),
+	)
+
+	// The created processor can then be registered with
+	// the OpenTelemetry Logs SDK using the WithProcessor option.
+	_ = log.NewLoggerProvider(
+		log.WithProcessor(processor),
+	)
+}
+
+func ExampleNewMetricProcessor() {
+	// Existing processor that emits telemetry.
+	var processor log.Processor = log.NewBatchProcessor(nil)
+
+	// Count the records by severity, instrumentation scope, and exception
+	// type with a meter of the global MeterProvider.
+	processor = log.NewMetricProcessor(
+		processor,
+		otel.GetMeterProvider(),
+		log.WithMetricAttributeKeys("exception.type"),
+	)
+
+	// The created processor can then be registered with
+	// the OpenTelemetry Logs SDK using the WithProcessor option.
+	_ = log.NewLoggerProvider(
+		log.WithProcessor(processor),
+	)
+}
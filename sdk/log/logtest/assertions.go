@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest // import "go.opentelemetry.io/otel/sdk/log/logtest"
+
+import (
+	"slices"
+	"testing"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+type assertConfig struct {
+	ignoreTimestamp bool
+}
+
+func newAssertConfig(options []AssertOption) assertConfig {
+	var c assertConfig
+	for _, opt := range options {
+		c = opt.apply(c)
+	}
+	return c
+}
+
+// AssertOption configures how records are compared.
+type AssertOption interface {
+	apply(assertConfig) assertConfig
+}
+
+type assertOptionFunc func(assertConfig) assertConfig
+
+func (f assertOptionFunc) apply(c assertConfig) assertConfig { return f(c) }
+
+// IgnoreTimestamp disables checking the timestamp and the observed timestamp
+// of records for equality.
+func IgnoreTimestamp() AssertOption {
+	return assertOptionFunc(func(c assertConfig) assertConfig {
+		c.ignoreTimestamp = true
+		return c
+	})
+}
+
+// AssertRecordEqual compares two log records, including their resource,
+// instrumentation scope, trace context, and dropped attributes count, and
+// fails the test if they are not equal.
+func AssertRecordEqual(t testing.TB, want, got sdklog.Record, opts ...AssertOption) bool {
+	t.Helper()
+	return assertRecordEqual(t, want, got, newAssertConfig(opts))
+}
+
+// AssertRecordsEqual compares two slices of log records with
+// [AssertRecordEqual], and fails the test if they do not have the same length
+// or if any of their records are not equal.
+func AssertRecordsEqual(t testing.TB, want, got []sdklog.Record, opts ...AssertOption) bool {
+	t.Helper()
+
+	if len(want) != len(got) {
+		t.Errorf("Records length is not equal:\nwant: %d\ngot:  %d", len(want), len(got))
+		return false
+	}
+	cfg := newAssertConfig(opts)
+	for i := range want {
+		if !assertRecordEqual(t, want[i], got[i], cfg) {
+			t.Errorf("Record %d is not equal", i)
+			return false
+		}
+	}
+	return true
+}
+
+func assertRecordEqual(t testing.TB, want, got sdklog.Record, cfg assertConfig) bool {
+	t.Helper()
+
+	if want.EventName() != got.EventName() {
+		t.Errorf("EventName value is not equal:\nwant: %v\ngot:  %v", want.EventName(), got.EventName())
+		return false
+	}
+	if !cfg.ignoreTimestamp {
+		if !want.Timestamp().Equal(got.Timestamp()) {
+			t.Errorf("Timestamp value is not equal:\nwant: %v\ngot:  %v", want.Timestamp(), got.Timestamp())
+			return false
+		}
+		if !want.ObservedTimestamp().Equal(got.ObservedTimestamp()) {
+			t.Errorf("ObservedTimestamp value is not equal:\nwant: %v\ngot:  %v", want.ObservedTimestamp(), got.ObservedTimestamp())
+			return false
+		}
+	}
+	if want.Severity() != got.Severity() {
+		t.Errorf("Severity value is not equal:\nwant: %v\ngot:  %v", want.Severity(), got.Severity())
+		return false
+	}
+	if want.SeverityText() != got.SeverityText() {
+		t.Errorf("SeverityText value is not equal:\nwant: %v\ngot:  %v", want.SeverityText(), got.SeverityText())
+		return false
+	}
+	if !assertBody(t, want.Body(), got) {
+		return false
+	}
+
+	var attrs []log.KeyValue
+	want.WalkAttributes(func(kv log.KeyValue) bool {
+		attrs = append(attrs, kv)
+		return true
+	})
+	if !assertAttributes(t, attrs, got) {
+		return false
+	}
+	if want.DroppedAttributes() != got.DroppedAttributes() {
+		t.Errorf("DroppedAttributes value is not equal:\nwant: %v\ngot:  %v", want.DroppedAttributes(), got.DroppedAttributes())
+		return false
+	}
+
+	if want.TraceID() != got.TraceID() {
+		t.Errorf("TraceID value is not equal:\nwant: %v\ngot:  %v", want.TraceID(), got.TraceID())
+		return false
+	}
+	if want.SpanID() != got.SpanID() {
+		t.Errorf("SpanID value is not equal:\nwant: %v\ngot:  %v", want.SpanID(), got.SpanID())
+		return false
+	}
+	if want.TraceFlags() != got.TraceFlags() {
+		t.Errorf("TraceFlags value is not equal:\nwant: %v\ngot:  %v", want.TraceFlags(), got.TraceFlags())
+		return false
+	}
+
+	wantRes, gotRes := want.Resource(), got.Resource()
+	if !wantRes.Equal(&gotRes) {
+		t.Errorf("Resource value is not equal:\nwant: %v\ngot:  %v", &wantRes, &gotRes)
+		return false
+	}
+	if wantRes.SchemaURL() != gotRes.SchemaURL() {
+		t.Errorf("Resource SchemaURL value is not equal:\nwant: %v\ngot:  %v", wantRes.SchemaURL(), gotRes.SchemaURL())
+		return false
+	}
+	if want.InstrumentationScope() != got.InstrumentationScope() {
+		t.Errorf("InstrumentationScope value is not equal:\nwant: %v\ngot:  %v", want.InstrumentationScope(), got.InstrumentationScope())
+		return false
+	}
+
+	return true
+}
+
+func assertBody(t testing.TB, want log.Value, r sdklog.Record) bool {
+	t.Helper()
+	got := r.Body()
+	if !got.Equal(want) {
+		t.Errorf("Body value is not equal:\nwant: %v\ngot:  %v", want, got)
+		return false
+	}
+
+	return true
+}
+
+func assertAttributes(t testing.TB, want []log.KeyValue, r sdklog.Record) bool {
+	t.Helper()
+	var got []log.KeyValue
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		got = append(got, kv)
+		return true
+	})
+	if !slices.EqualFunc(want, got, log.KeyValue.Equal) {
+		t.Errorf("Attributes are not equal:\nwant: %v\ngot:  %v", want, got)
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// mockT records the failures of an assertion.
+type mockT struct {
+	testing.TB
+
+	errs []string
+}
+
+func (m *mockT) Helper() {}
+
+func (m *mockT) Errorf(format string, args ...any) {
+	m.errs = append(m.errs, fmt.Sprintf(format, args...))
+}
+
+func TestAssertRecordEqual(t *testing.T) {
+	now := time.Now()
+	base := RecordFactory{
+		EventName:            "event",
+		Timestamp:            now,
+		ObservedTimestamp:    now,
+		Severity:             log.SeverityInfo,
+		SeverityText:         "INFO",
+		Body:                 log.StringValue("body"),
+		Attributes:           []log.KeyValue{log.Bool("attr", true)},
+		TraceID:              trace.TraceID{1},
+		SpanID:               trace.SpanID{2},
+		TraceFlags:           trace.FlagsSampled,
+		DroppedAttributes:    1,
+		Resource:             resource.NewSchemaless(attribute.String("service.name", "test")),
+		InstrumentationScope: &instrumentation.Scope{Name: "scope"},
+	}
+
+	assert.True(t, AssertRecordEqual(t, base.NewRecord(), base.NewRecord()))
+	assert.True(t, AssertRecordEqual(t, sdklog.Record{}, sdklog.Record{}))
+
+	tests := []struct {
+		name   string
+		modify func(*RecordFactory)
+	}{
+		{"EventName", func(f *RecordFactory) { f.EventName = "other" }},
+		{"Timestamp", func(f *RecordFactory) { f.Timestamp = now.Add(time.Second) }},
+		{"ObservedTimestamp", func(f *RecordFactory) { f.ObservedTimestamp = now.Add(time.Second) }},
+		{"Severity", func(f *RecordFactory) { f.Severity = log.SeverityWarn }},
+		{"SeverityText", func(f *RecordFactory) { f.SeverityText = "WARN" }},
+		{"Body", func(f *RecordFactory) { f.Body = log.StringValue("other") }},
+		{"Attributes", func(f *RecordFactory) { f.Attributes = []log.KeyValue{log.Bool("attr", false)} }},
+		{"DroppedAttributes", func(f *RecordFactory) { f.DroppedAttributes = 2 }},
+		{"TraceID", func(f *RecordFactory) { f.TraceID = trace.TraceID{3} }},
+		{"SpanID", func(f *RecordFactory) { f.SpanID = trace.SpanID{4} }},
+		{"TraceFlags", func(f *RecordFactory) { f.TraceFlags = 0 }},
+		{"Resource", func(f *RecordFactory) { f.Resource = resource.NewSchemaless(attribute.String("service.name", "other")) }},
+		{"Resource SchemaURL", func(f *RecordFactory) {
+			f.Resource = resource.NewWithAttributes("https://example.com", attribute.String("service.name", "test"))
+		}},
+		{"InstrumentationScope", func(f *RecordFactory) { f.InstrumentationScope = &instrumentation.Scope{Name: "other"} }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := base
+			tc.modify(&f)
+
+			mt := &mockT{TB: t}
+			assert.False(t, AssertRecordEqual(mt, base.NewRecord(), f.NewRecord()))
+			if assert.Len(t, mt.errs, 1) {
+				assert.Contains(t, mt.errs[0], tc.name+" ")
+			}
+		})
+	}
+}
+
+func TestAssertRecordEqualIgnoreTimestamp(t *testing.T) {
+	want := RecordFactory{Timestamp: time.Now(), ObservedTimestamp: time.Now()}.NewRecord()
+	got := RecordFactory{}.NewRecord()
+
+	mt := &mockT{TB: t}
+	assert.False(t, AssertRecordEqual(mt, want, got))
+	assert.True(t, AssertRecordEqual(t, want, got, IgnoreTimestamp()))
+}
+
+func TestAssertRecordsEqual(t *testing.T) {
+	r1 := RecordFactory{Body: log.StringValue("1")}.NewRecord()
+	r2 := RecordFactory{Body: log.StringValue("2")}.NewRecord()
+
+	assert.True(t, AssertRecordsEqual(t, nil, nil))
+	assert.True(t, AssertRecordsEqual(t, []sdklog.Record{r1, r2}, []sdklog.Record{r1, r2}))
+
+	mt := &mockT{TB: t}
+	assert.False(t, AssertRecordsEqual(mt, []sdklog.Record{r1, r2}, []sdklog.Record{r1}))
+	assert.Len(t, mt.errs, 1)
+
+	mt = &mockT{TB: t}
+	assert.False(t, AssertRecordsEqual(mt, []sdklog.Record{r1, r2}, []sdklog.Record{r2, r1}))
+	assert.Len(t, mt.errs, 2)
+}
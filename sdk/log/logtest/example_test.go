@@ -35,6 +35,24 @@ func ExampleRecordFactory() {
 	// scope=myapp msg=bar
 }
 
+func ExampleInMemoryExporter() {
+	exp := logtest.NewInMemoryExporter()
+	provider := log.NewLoggerProvider(
+		log.WithProcessor(log.NewSimpleProcessor(exp)),
+	)
+
+	var r logapi.Record
+	r.SetBody(logapi.StringValue("foo"))
+	provider.Logger("myapp").Emit(context.Background(), r)
+
+	for _, r := range exp.GetRecords() {
+		fmt.Printf("scope=%s msg=%s\n", r.InstrumentationScope().Name, r.Body())
+	}
+
+	// Output:
+	// scope=myapp msg=foo
+}
+
 // Compile time check exporter implements log.Exporter.
 var _ log.Exporter = exporter{}
 
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest // import "go.opentelemetry.io/otel/sdk/log/logtest"
+
+import (
+	"context"
+	"sync"
+
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+// Compile-time check InMemoryExporter implements sdklog.Exporter.
+var _ sdklog.Exporter = (*InMemoryExporter)(nil)
+
+// InMemoryExporter is an [sdklog.Exporter] that stores all the records it
+// exports in memory.
+//
+// Use [NewInMemoryExporter] to create an InMemoryExporter.
+type InMemoryExporter struct {
+	mu      sync.Mutex
+	records []sdklog.Record
+}
+
+// NewInMemoryExporter returns a new [InMemoryExporter].
+func NewInMemoryExporter() *InMemoryExporter {
+	return new(InMemoryExporter)
+}
+
+// Export stores a copy of records in memory.
+//
+// This method is safe to be called concurrently.
+func (e *InMemoryExporter) Export(_ context.Context, records []sdklog.Record) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, r := range records {
+		// The records are not retained by the caller after Export returns.
+		e.records = append(e.records, r.Clone())
+	}
+	return nil
+}
+
+// Shutdown stops the exporter by clearing the records held in memory.
+//
+// This method is safe to be called concurrently.
+func (e *InMemoryExporter) Shutdown(context.Context) error {
+	e.Reset()
+	return nil
+}
+
+// ForceFlush does nothing.
+//
+// This method is safe to be called concurrently.
+func (e *InMemoryExporter) ForceFlush(context.Context) error {
+	return nil
+}
+
+// Reset clears the records held in memory.
+//
+// This method is safe to be called concurrently.
+func (e *InMemoryExporter) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.records = nil
+}
+
+// GetRecords returns a copy of the records held in memory.
+//
+// This method is safe to be called concurrently.
+func (e *InMemoryExporter) GetRecords() []sdklog.Record {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return cloneRecords(e.records)
+}
+
+func cloneRecords(records []sdklog.Record) []sdklog.Record {
+	if records == nil {
+		return nil
+	}
+	out := make([]sdklog.Record, len(records))
+	for i := range records {
+		out[i] = records[i].Clone()
+	}
+	return out
+}
@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+func TestInMemoryExporter(t *testing.T) {
+	ctx := context.Background()
+	e := NewInMemoryExporter()
+	assert.Empty(t, e.GetRecords())
+
+	r1 := RecordFactory{Body: log.StringValue("1")}.NewRecord()
+	r2 := RecordFactory{Body: log.StringValue("2")}.NewRecord()
+	records := []sdklog.Record{r1, r2}
+	require.NoError(t, e.Export(ctx, records))
+
+	// The exported records are copied.
+	records[0].SetBody(log.StringValue("modified"))
+	AssertRecordsEqual(t, []sdklog.Record{r1, r2}, e.GetRecords())
+
+	got := e.GetRecords()
+	got[0].SetBody(log.StringValue("modified"))
+	AssertRecordsEqual(t, []sdklog.Record{r1, r2}, e.GetRecords())
+
+	assert.NoError(t, e.ForceFlush(ctx))
+	assert.Len(t, e.GetRecords(), 2)
+
+	e.Reset()
+	assert.Empty(t, e.GetRecords())
+
+	require.NoError(t, e.Export(ctx, []sdklog.Record{r1}))
+	assert.NoError(t, e.Shutdown(ctx))
+	assert.Empty(t, e.GetRecords())
+}
+
+func TestInMemoryExporterConcurrentSafe(t *testing.T) {
+	ctx := context.Background()
+	e := NewInMemoryExporter()
+	r := RecordFactory{}.NewRecord()
+
+	const goRoutineN = 10
+	var wg sync.WaitGroup
+	wg.Add(goRoutineN)
+	for i := 0; i < goRoutineN; i++ {
+		go func() {
+			defer wg.Done()
+			_ = e.Export(ctx, []sdklog.Record{r})
+			_ = e.GetRecords()
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, e.GetRecords(), goRoutineN)
+}
@@ -4,7 +4,6 @@
 package logtest
 
 import (
-	"slices"
 	"testing"
 	"time"
 
@@ -23,6 +22,7 @@ func TestRecordFactoryEmpty(t *testing.T) {
 }
 
 func TestRecordFactory(t *testing.T) {
+	eventName := "event"
 	now := time.Now()
 	observed := now.Add(time.Second)
 	severity := log.SeverityDebug
@@ -43,6 +43,7 @@ func TestRecordFactory(t *testing.T) {
 	r := resource.NewSchemaless(attribute.Bool("works", true))
 
 	got := RecordFactory{
+		EventName:            eventName,
 		Timestamp:            now,
 		ObservedTimestamp:    observed,
 		Severity:             severity,
@@ -57,6 +58,7 @@ func TestRecordFactory(t *testing.T) {
 		Resource:             r,
 	}.NewRecord()
 
+	assert.Equal(t, eventName, got.EventName())
 	assert.Equal(t, now, got.Timestamp())
 	assert.Equal(t, observed, got.ObservedTimestamp())
 	assert.Equal(t, severity, got.Severity())
@@ -106,23 +108,3 @@ func TestRecordFactoryMultiple(t *testing.T) {
 	assert.Equal(t, 1, record1.DroppedAttributes())
 	assert.Equal(t, scope, record1.InstrumentationScope())
 }
-
-func assertBody(t *testing.T, want log.Value, r sdklog.Record) {
-	t.Helper()
-	got := r.Body()
-	if !got.Equal(want) {
-		t.Errorf("Body value is not equal:\nwant: %v\ngot:  %v", want, got)
-	}
-}
-
-func assertAttributes(t *testing.T, want []log.KeyValue, r sdklog.Record) {
-	t.Helper()
-	var got []log.KeyValue
-	r.WalkAttributes(func(kv log.KeyValue) bool {
-		got = append(got, kv)
-		return true
-	})
-	if !slices.EqualFunc(want, got, log.KeyValue.Equal) {
-		t.Errorf("Attributes are not equal:\nwant: %v\ngot:  %v", want, got)
-	}
-}
@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest // import "go.opentelemetry.io/otel/sdk/log/logtest"
+
+import (
+	"context"
+	"sync"
+
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+// Compile-time check RecordingProcessor implements sdklog.Processor.
+var _ sdklog.Processor = (*RecordingProcessor)(nil)
+
+// RecordingProcessor is an [sdklog.Processor] that records the records
+// emitted, and the calls made to it.
+//
+// Use [NewRecordingProcessor] to create a RecordingProcessor.
+type RecordingProcessor struct {
+	mu              sync.Mutex
+	records         []sdklog.Record
+	shutdownCalls   int
+	forceFlushCalls int
+}
+
+// NewRecordingProcessor returns a new [RecordingProcessor].
+func NewRecordingProcessor() *RecordingProcessor {
+	return new(RecordingProcessor)
+}
+
+// OnEmit records a copy of r.
+//
+// This method is safe to be called concurrently.
+func (p *RecordingProcessor) OnEmit(_ context.Context, r *sdklog.Record) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.records = append(p.records, r.Clone())
+	return nil
+}
+
+// Enabled returns true.
+//
+// This method is safe to be called concurrently.
+func (p *RecordingProcessor) Enabled(context.Context, sdklog.Record) bool {
+	return true
+}
+
+// Shutdown records the call.
+//
+// This method is safe to be called concurrently.
+func (p *RecordingProcessor) Shutdown(context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.shutdownCalls++
+	return nil
+}
+
+// ForceFlush records the call.
+//
+// This method is safe to be called concurrently.
+func (p *RecordingProcessor) ForceFlush(context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.forceFlushCalls++
+	return nil
+}
+
+// Records returns a copy of the records that have been recorded.
+//
+// This method is safe to be called concurrently.
+func (p *RecordingProcessor) Records() []sdklog.Record {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return cloneRecords(p.records)
+}
+
+// ShutdownCalls returns the number of times Shutdown has been called.
+//
+// This method is safe to be called concurrently.
+func (p *RecordingProcessor) ShutdownCalls() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.shutdownCalls
+}
+
+// ForceFlushCalls returns the number of times ForceFlush has been called.
+//
+// This method is safe to be called concurrently.
+func (p *RecordingProcessor) ForceFlushCalls() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.forceFlushCalls
+}
+
+// Reset clears the records that have been recorded, and the number of calls.
+//
+// This method is safe to be called concurrently.
+func (p *RecordingProcessor) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.records = nil
+	p.shutdownCalls = 0
+	p.forceFlushCalls = 0
+}
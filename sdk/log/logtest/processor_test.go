@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logtest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestRecordingProcessor(t *testing.T) {
+	ctx := context.Background()
+	p := NewRecordingProcessor()
+	assert.True(t, p.Enabled(ctx, sdklog.Record{}))
+
+	r := RecordFactory{Body: log.StringValue("1")}.NewRecord()
+	require.NoError(t, p.OnEmit(ctx, &r))
+
+	// The emitted record is copied.
+	r.SetBody(log.StringValue("modified"))
+	AssertRecordsEqual(t, []sdklog.Record{RecordFactory{Body: log.StringValue("1")}.NewRecord()}, p.Records())
+
+	assert.NoError(t, p.ForceFlush(ctx))
+	assert.NoError(t, p.ForceFlush(ctx))
+	assert.NoError(t, p.Shutdown(ctx))
+	assert.Equal(t, 2, p.ForceFlushCalls())
+	assert.Equal(t, 1, p.ShutdownCalls())
+	assert.Len(t, p.Records(), 1, "records are kept on Shutdown")
+
+	p.Reset()
+	assert.Empty(t, p.Records())
+	assert.Equal(t, 0, p.ForceFlushCalls())
+	assert.Equal(t, 0, p.ShutdownCalls())
+}
+
+func TestRecordingProcessorLoggerProvider(t *testing.T) {
+	ctx := context.Background()
+	res := resource.NewSchemaless(attribute.String("service.name", "test"))
+	p := NewRecordingProcessor()
+	provider := sdklog.NewLoggerProvider(
+		sdklog.WithResource(res),
+		sdklog.WithProcessor(p),
+		sdklog.WithAttributeCountLimit(1),
+	)
+
+	traceID, spanID := trace.TraceID{1}, trace.SpanID{2}
+	ctx = trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	var r log.Record
+	r.SetSeverity(log.SeverityError)
+	r.SetBody(log.StringValue("failed"))
+	r.AddAttributes(log.Int("a", 1), log.Int("b", 2))
+	provider.Logger("scope", log.WithInstrumentationVersion("v1")).Emit(ctx, r)
+
+	want := RecordFactory{
+		Severity:             log.SeverityError,
+		Body:                 log.StringValue("failed"),
+		Attributes:           []log.KeyValue{log.Int("a", 1)},
+		DroppedAttributes:    1,
+		TraceID:              traceID,
+		SpanID:               spanID,
+		TraceFlags:           trace.FlagsSampled,
+		Resource:             res,
+		InstrumentationScope: &instrumentation.Scope{Name: "scope", Version: "v1"},
+	}.NewRecord()
+	AssertRecordsEqual(t, []sdklog.Record{want}, p.Records(), IgnoreTimestamp())
+}
@@ -0,0 +1,200 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+const (
+	// metricMeterName is the name of the meter of a MetricProcessor.
+	metricMeterName = "go.opentelemetry.io/otel/sdk/log"
+
+	// metricRecordsName is the name of the counter of a MetricProcessor.
+	metricRecordsName = "log.records"
+
+	// metricSeverityKey is the key of the attribute holding the severity
+	// of the counted records.
+	metricSeverityKey = "log.record.severity"
+	// metricScopeNameKey is the key of the attribute holding the
+	// instrumentation scope name of the counted records.
+	metricScopeNameKey = "otel.scope.name"
+)
+
+// Compile-time check MetricProcessor implements Processor.
+var _ Processor = (*MetricProcessor)(nil)
+
+// MetricProcessor is a [Processor] that counts the log records emitted with a
+// "log.records" counter before passing them unchanged to the Processor it
+// decorates. It allows the rate of records, e.g. the rate of error records,
+// to be monitored without exporting the records.
+//
+// The records are counted with the following attributes:
+//
+//   - "log.record.severity": the severity of the record without its
+//     sub-level, e.g. "ERROR" for both [log.SeverityError] and
+//     [log.SeverityError2], or "UNDEFINED".
+//   - "otel.scope.name": the instrumentation scope name of the record.
+//   - the record attributes with a key selected with
+//     [WithMetricAttributeKeys], if the record has them.
+//
+// Use [NewMetricProcessor] to create a MetricProcessor.
+type MetricProcessor struct {
+	processor Processor
+
+	counter metric.Int64Counter
+	keys    map[string]struct{}
+
+	noCmp [0]func() //nolint: unused  // This is indeed used.
+}
+
+// NewMetricProcessor returns a [MetricProcessor] that records its counter
+// with a meter of provider and decorates processor. If provider is nil, the
+// global MeterProvider is used. If processor is nil, the records are only
+// counted.
+func NewMetricProcessor(processor Processor, provider metric.MeterProvider, opts ...MetricProcessorOption) *MetricProcessor {
+	cfg := newMetricConfig(opts)
+
+	if provider == nil {
+		provider = otel.GetMeterProvider()
+	}
+	counter, err := provider.Meter(metricMeterName).Int64Counter(
+		metricRecordsName,
+		metric.WithUnit("{record}"),
+		metric.WithDescription("The number of log records emitted."),
+	)
+	if err != nil {
+		otel.Handle(err)
+		counter = noop.Int64Counter{}
+	}
+
+	p := &MetricProcessor{processor: processor, counter: counter}
+	if len(cfg.keys) > 0 {
+		p.keys = make(map[string]struct{}, len(cfg.keys))
+		for _, k := range cfg.keys {
+			p.keys[k] = struct{}{}
+		}
+	}
+	return p
+}
+
+// OnEmit counts r and passes it to the decorated processor.
+func (p *MetricProcessor) OnEmit(ctx context.Context, r *Record) error {
+	if p.counter != nil {
+		p.counter.Add(ctx, 1, metric.WithAttributes(p.attributes(r)...))
+	}
+	if p.processor == nil {
+		return nil
+	}
+	return p.processor.OnEmit(ctx, r)
+}
+
+// attributes returns the attributes r is counted with.
+func (p *MetricProcessor) attributes(r *Record) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 2, 2+len(p.keys))
+	attrs[0] = attribute.String(metricSeverityKey, severityName(r.Severity()))
+	attrs[1] = attribute.String(metricScopeNameKey, r.InstrumentationScope().Name)
+	if len(p.keys) == 0 {
+		return attrs
+	}
+
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		if _, ok := p.keys[kv.Key]; ok {
+			attrs = append(attrs, metricAttribute(kv))
+		}
+		return true
+	})
+	return attrs
+}
+
+// severityName returns the name of sev without its sub-level.
+func severityName(sev log.Severity) string {
+	if sev < log.SeverityTrace1 || sev > log.SeverityFatal4 {
+		return log.SeverityUndefined.String()
+	}
+	// Severities are grouped by 4, the first one of a group is named after it.
+	return (sev - (sev-log.SeverityTrace1)%4).String()
+}
+
+// metricAttribute returns kv as a metric attribute. Values that have no
+// attribute equivalent are converted to their string representation.
+func metricAttribute(kv log.KeyValue) attribute.KeyValue {
+	switch kv.Value.Kind() {
+	case log.KindBool:
+		return attribute.Bool(kv.Key, kv.Value.AsBool())
+	case log.KindInt64:
+		return attribute.Int64(kv.Key, kv.Value.AsInt64())
+	case log.KindFloat64:
+		return attribute.Float64(kv.Key, kv.Value.AsFloat64())
+	case log.KindString:
+		return attribute.String(kv.Key, kv.Value.AsString())
+	}
+	return attribute.String(kv.Key, kv.Value.String())
+}
+
+// Enabled returns true. All records are counted, regardless of whether the
+// decorated processor is enabled for them.
+func (p *MetricProcessor) Enabled(context.Context, Record) bool {
+	return true
+}
+
+// Shutdown shuts down the decorated processor.
+func (p *MetricProcessor) Shutdown(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+	return p.processor.Shutdown(ctx)
+}
+
+// ForceFlush flushes the decorated processor.
+func (p *MetricProcessor) ForceFlush(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+	return p.processor.ForceFlush(ctx)
+}
+
+type metricConfig struct {
+	keys []string
+}
+
+func newMetricConfig(options []MetricProcessorOption) metricConfig {
+	var c metricConfig
+	for _, o := range options {
+		c = o.apply(c)
+	}
+	return c
+}
+
+// MetricProcessorOption applies a configuration to a [MetricProcessor].
+type MetricProcessorOption interface {
+	apply(metricConfig) metricConfig
+}
+
+type metricOptionFunc func(metricConfig) metricConfig
+
+func (fn metricOptionFunc) apply(c metricConfig) metricConfig {
+	return fn(c)
+}
+
+// WithMetricAttributeKeys adds the record attributes with keys to the
+// attributes records are counted with, e.g. "exception.type". Records without
+// an attribute with one of the keys are counted without it.
+//
+// Each distinct value of a selected attribute creates a new time series. Only
+// select attributes with a small number of distinct values.
+//
+// By default, if this option is not passed, no record attributes are used.
+func WithMetricAttributeKeys(keys ...string) MetricProcessorOption {
+	return metricOptionFunc(func(c metricConfig) metricConfig {
+		c.keys = append(c.keys, keys...)
+		return c
+	})
+}
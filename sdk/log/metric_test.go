@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+func TestSeverityName(t *testing.T) {
+	tests := []struct {
+		sev  log.Severity
+		want string
+	}{
+		{log.SeverityUndefined, "UNDEFINED"},
+		{log.SeverityTrace1, "TRACE"},
+		{log.SeverityDebug4, "DEBUG"},
+		{log.SeverityInfo2, "INFO"},
+		{log.SeverityWarn3, "WARN"},
+		{log.SeverityError, "ERROR"},
+		{log.SeverityError4, "ERROR"},
+		{log.SeverityFatal4, "FATAL"},
+		{log.SeverityFatal4 + 1, "UNDEFINED"},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.want, severityName(tc.sev), tc.sev.String())
+	}
+}
+
+func TestMetricProcessor(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	next := newProcessor("next")
+	p := NewMetricProcessor(next, mp, WithMetricAttributeKeys("exception.type", "retry"))
+
+	emit := func(scope string, sev log.Severity, attrs ...log.KeyValue) {
+		r := Record{scope: &instrumentation.Scope{Name: scope}, attributeValueLengthLimit: -1}
+		r.SetSeverity(sev)
+		r.AddAttributes(attrs...)
+		require.NoError(t, p.OnEmit(context.Background(), &r))
+	}
+	emit("a", log.SeverityError, log.String("exception.type", "io.EOF"), log.String("other", "ignored"))
+	emit("a", log.SeverityError2, log.String("exception.type", "io.EOF"))
+	emit("a", log.SeverityError)
+	emit("b", log.SeverityInfo, log.Bool("retry", true))
+	emit("b", log.SeverityUndefined, log.Map("retry", log.Int("n", 1)))
+
+	assert.Len(t, next.records, 5, "records passed to the decorated processor")
+	assert.True(t, p.Enabled(context.Background(), Record{}))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	assert.Equal(t, metricMeterName, rm.ScopeMetrics[0].Scope.Name)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+
+	point := func(n int64, attrs ...attribute.KeyValue) metricdata.DataPoint[int64] {
+		return metricdata.DataPoint[int64]{Attributes: attribute.NewSet(attrs...), Value: n}
+	}
+	want := metricdata.Metrics{
+		Name:        "log.records",
+		Description: "The number of log records emitted.",
+		Unit:        "{record}",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[int64]{
+				point(2,
+					attribute.String("log.record.severity", "ERROR"),
+					attribute.String("otel.scope.name", "a"),
+					attribute.String("exception.type", "io.EOF"),
+				),
+				point(1,
+					attribute.String("log.record.severity", "ERROR"),
+					attribute.String("otel.scope.name", "a"),
+				),
+				point(1,
+					attribute.String("log.record.severity", "INFO"),
+					attribute.String("otel.scope.name", "b"),
+					attribute.Bool("retry", true),
+				),
+				point(1,
+					attribute.String("log.record.severity", "UNDEFINED"),
+					attribute.String("otel.scope.name", "b"),
+					attribute.String("retry", "[n:1]"),
+				),
+			},
+		},
+	}
+	metricdatatest.AssertEqual(t, want, rm.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreTimestamp())
+
+	require.NoError(t, p.ForceFlush(context.Background()))
+	assert.Equal(t, 1, next.forceFlushCalls)
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Equal(t, 1, next.shutdownCalls)
+}
+
+func TestMetricProcessorNilProcessor(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	p := NewMetricProcessor(nil, mp)
+
+	var r Record
+	r.SetSeverity(log.SeverityWarn)
+	assert.NoError(t, p.OnEmit(context.Background(), &r))
+	assert.True(t, p.Enabled(context.Background(), r))
+	assert.NoError(t, p.ForceFlush(context.Background()))
+	assert.NoError(t, p.Shutdown(context.Background()))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	sum, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, sum.DataPoints, 1)
+	assert.Equal(t, int64(1), sum.DataPoints[0].Value)
+}
+
+func TestMetricProcessorZeroValue(t *testing.T) {
+	var p MetricProcessor
+	var r Record
+	r.SetSeverity(log.SeverityWarn)
+	assert.NoError(t, p.OnEmit(context.Background(), &r))
+	assert.NoError(t, p.ForceFlush(context.Background()))
+	assert.NoError(t, p.Shutdown(context.Background()))
+}
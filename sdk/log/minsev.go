@@ -0,0 +1,266 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/log"
+)
+
+const envarMinSeverity = "OTEL_GO_LOG_MIN_SEVERITY"
+
+// Compile-time check MinSeverityProcessor implements Processor.
+var _ Processor = (*MinSeverityProcessor)(nil)
+
+// MinSeverityProcessor is a [Processor] that only passes log records with a
+// minimum severity to the Processor it decorates. All other records are
+// dropped.
+//
+// The minimum severity can be configured for all records, and for records
+// emitted by loggers of a specific instrumentation scope name, or of a scope
+// name prefix. A threshold set for a scope name takes precedence over one set
+// for a prefix, the longest matching prefix takes precedence over shorter
+// ones, and both take precedence over the threshold set for all records.
+//
+// Records with an undefined severity ([log.SeverityUndefined]) are never
+// dropped.
+//
+// Use [NewMinSeverityProcessor] to create a MinSeverityProcessor.
+type MinSeverityProcessor struct {
+	processor Processor
+
+	severity log.Severity
+	scopes   map[string]log.Severity
+	// prefixes are ordered from the longest to the shortest prefix.
+	prefixes []scopeSeverity
+
+	noCmp [0]func() //nolint: unused  // This is indeed used.
+}
+
+type scopeSeverity struct {
+	name     string
+	severity log.Severity
+}
+
+// NewMinSeverityProcessor returns a [MinSeverityProcessor] that decorates
+// processor.
+//
+// If the OTEL_GO_LOG_MIN_SEVERITY environment variable is set, it configures
+// the minimum severities that are not configured with the passed options. Its
+// value is a comma-separated list of severities. A severity without a key
+// applies to all records. A severity with a key, i.e. "name=severity", applies
+// to the records of the named instrumentation scope, or, if the name ends
+// with "*", to those of all scopes with the preceding prefix. Severities are
+// names of [log.Severity] values (e.g. "info", "warn2") or their numeric
+// values. For example:
+//
+//	OTEL_GO_LOG_MIN_SEVERITY="warn,github.com/my/pkg=debug,github.com/my/*=info"
+func NewMinSeverityProcessor(processor Processor, opts ...MinSeverityProcessorOption) *MinSeverityProcessor {
+	cfg := newMinSeverityConfig(opts)
+
+	p := &MinSeverityProcessor{
+		processor: processor,
+		severity:  cfg.severity.Value,
+		scopes:    make(map[string]log.Severity, len(cfg.scopes)),
+	}
+	for name, sev := range cfg.scopes {
+		p.scopes[name] = sev
+	}
+	for prefix, sev := range cfg.prefixes {
+		p.prefixes = append(p.prefixes, scopeSeverity{name: prefix, severity: sev})
+	}
+	sort.Slice(p.prefixes, func(i, j int) bool {
+		return len(p.prefixes[i].name) > len(p.prefixes[j].name)
+	})
+	return p
+}
+
+// minSeverity returns the minimum severity of records emitted by loggers of
+// the instrumentation scope name.
+func (p *MinSeverityProcessor) minSeverity(name string) log.Severity {
+	if sev, ok := p.scopes[name]; ok {
+		return sev
+	}
+	for _, s := range p.prefixes {
+		if strings.HasPrefix(name, s.name) {
+			return s.severity
+		}
+	}
+	return p.severity
+}
+
+// drop returns if r is below its minimum severity.
+func (p *MinSeverityProcessor) drop(r *Record) bool {
+	sev := r.Severity()
+	return sev != log.SeverityUndefined && sev < p.minSeverity(r.InstrumentationScope().Name)
+}
+
+// OnEmit passes r to the decorated processor if it has the minimum severity.
+func (p *MinSeverityProcessor) OnEmit(ctx context.Context, r *Record) error {
+	if p.processor == nil || p.drop(r) {
+		return nil
+	}
+	return p.processor.OnEmit(ctx, r)
+}
+
+// Enabled returns false if r is below the minimum severity. Otherwise, it
+// returns what the decorated processor returns.
+func (p *MinSeverityProcessor) Enabled(ctx context.Context, r Record) bool {
+	if p.processor == nil || p.drop(&r) {
+		return false
+	}
+	return p.processor.Enabled(ctx, r)
+}
+
+// Shutdown shuts down the decorated processor.
+func (p *MinSeverityProcessor) Shutdown(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+	return p.processor.Shutdown(ctx)
+}
+
+// ForceFlush flushes the decorated processor.
+func (p *MinSeverityProcessor) ForceFlush(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+	return p.processor.ForceFlush(ctx)
+}
+
+type minSeverityConfig struct {
+	severity setting[log.Severity]
+	scopes   map[string]log.Severity
+	prefixes map[string]log.Severity
+}
+
+func newMinSeverityConfig(options []MinSeverityProcessorOption) minSeverityConfig {
+	c := minSeverityConfig{
+		scopes:   make(map[string]log.Severity),
+		prefixes: make(map[string]log.Severity),
+	}
+	for _, o := range options {
+		c = o.apply(c)
+	}
+
+	if v := os.Getenv(envarMinSeverity); v != "" {
+		c = c.applyEnv(v)
+	}
+	return c
+}
+
+// applyEnv applies the minimum severities defined by the environment variable
+// value v that are not already configured.
+func (c minSeverityConfig) applyEnv(v string) minSeverityConfig {
+	for _, entry := range strings.Split(v, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, value, scoped := strings.Cut(entry, "=")
+		if !scoped {
+			name, value = "", entry
+		}
+		sev, err := parseSeverity(strings.TrimSpace(value))
+		if err != nil {
+			otel.Handle(fmt.Errorf("invalid %s value %s: %w", envarMinSeverity, entry, err))
+			continue
+		}
+
+		name = strings.TrimSpace(name)
+		switch {
+		case !scoped:
+			if !c.severity.Set {
+				c.severity = newSetting(sev)
+			}
+		case strings.HasSuffix(name, "*"):
+			prefix := strings.TrimSuffix(name, "*")
+			if _, ok := c.prefixes[prefix]; !ok {
+				c.prefixes[prefix] = sev
+			}
+		default:
+			if _, ok := c.scopes[name]; !ok {
+				c.scopes[name] = sev
+			}
+		}
+	}
+	return c
+}
+
+// parseSeverity returns the severity named s, case-insensitive, or with the
+// numeric value s.
+func parseSeverity(s string) (log.Severity, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		if n < int(log.SeverityUndefined) || n > int(log.SeverityFatal4) {
+			return 0, fmt.Errorf("severity out of range: %d", n)
+		}
+		return log.Severity(n), nil
+	}
+
+	upper := strings.ToUpper(s)
+	for sev := log.SeverityUndefined; sev <= log.SeverityFatal4; sev++ {
+		if sev.String() == upper {
+			return sev, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown severity: %q", s)
+}
+
+// MinSeverityProcessorOption applies a configuration to a
+// [MinSeverityProcessor].
+type MinSeverityProcessorOption interface {
+	apply(minSeverityConfig) minSeverityConfig
+}
+
+type minSeverityOptionFunc func(minSeverityConfig) minSeverityConfig
+
+func (fn minSeverityOptionFunc) apply(c minSeverityConfig) minSeverityConfig {
+	return fn(c)
+}
+
+// WithMinSeverity sets the minimum severity of all records that do not have
+// a minimum severity set for their instrumentation scope.
+//
+// If this option is not passed, the minimum severity without a key from the
+// OTEL_GO_LOG_MIN_SEVERITY environment variable is used. By default, if the
+// environment variable does not define it, records of all severities are
+// passed.
+func WithMinSeverity(severity log.Severity) MinSeverityProcessorOption {
+	return minSeverityOptionFunc(func(c minSeverityConfig) minSeverityConfig {
+		c.severity = newSetting(severity)
+		return c
+	})
+}
+
+// WithScopeMinSeverity sets the minimum severity of the records emitted by
+// loggers with the instrumentation scope name.
+//
+// This option takes precedence over the value configured for the same name
+// with the OTEL_GO_LOG_MIN_SEVERITY environment variable.
+func WithScopeMinSeverity(name string, severity log.Severity) MinSeverityProcessorOption {
+	return minSeverityOptionFunc(func(c minSeverityConfig) minSeverityConfig {
+		c.scopes[name] = severity
+		return c
+	})
+}
+
+// WithScopePrefixMinSeverity sets the minimum severity of the records emitted
+// by loggers with an instrumentation scope name that starts with prefix.
+//
+// This option takes precedence over the value configured for the same prefix
+// with the OTEL_GO_LOG_MIN_SEVERITY environment variable.
+func WithScopePrefixMinSeverity(prefix string, severity log.Severity) MinSeverityProcessorOption {
+	return minSeverityOptionFunc(func(c minSeverityConfig) minSeverityConfig {
+		c.prefixes[prefix] = severity
+		return c
+	})
+}
@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/log"
+)
+
+func severityRecord(sev log.Severity) log.Record {
+	var r log.Record
+	r.SetSeverity(sev)
+	return r
+}
+
+func TestMinSeverityProcessor(t *testing.T) {
+	ctx := context.Background()
+	p := newProcessor("downstream")
+	provider := NewLoggerProvider(WithProcessor(NewMinSeverityProcessor(
+		p,
+		WithMinSeverity(log.SeverityWarn),
+		WithScopeMinSeverity("debug", log.SeverityDebug),
+		WithScopePrefixMinSeverity("github.com/", log.SeverityInfo),
+		WithScopePrefixMinSeverity("github.com/errors/", log.SeverityError),
+	)))
+
+	tests := []struct {
+		scope string
+		min   log.Severity
+	}{
+		{scope: "", min: log.SeverityWarn},
+		{scope: "debug", min: log.SeverityDebug},
+		{scope: "debug/sub", min: log.SeverityWarn},
+		{scope: "github.com/pkg", min: log.SeverityInfo},
+		{scope: "github.com/errors/pkg", min: log.SeverityError},
+	}
+	for _, test := range tests {
+		l := provider.Logger(test.scope)
+
+		below := severityRecord(test.min - 1)
+		assert.False(t, l.Enabled(ctx, below), "scope %q: enabled below minimum", test.scope)
+		l.Emit(ctx, below)
+
+		at := severityRecord(test.min)
+		assert.True(t, l.Enabled(ctx, at), "scope %q: disabled at minimum", test.scope)
+		l.Emit(ctx, at)
+	}
+
+	require.Len(t, p.records, len(tests))
+	for i, test := range tests {
+		assert.Equal(t, test.min, p.records[i].Severity(), "scope %q", test.scope)
+	}
+}
+
+func TestMinSeverityProcessorUndefined(t *testing.T) {
+	ctx := context.Background()
+	p := newProcessor("downstream")
+	l := NewLoggerProvider(WithProcessor(NewMinSeverityProcessor(
+		p,
+		WithMinSeverity(log.SeverityFatal),
+	))).Logger("TestMinSeverityProcessorUndefined")
+
+	var r log.Record
+	assert.True(t, l.Enabled(ctx, r), "undefined severity disabled")
+	l.Emit(ctx, r)
+	assert.Len(t, p.records, 1, "undefined severity dropped")
+}
+
+func TestMinSeverityProcessorDelegates(t *testing.T) {
+	ctx := context.Background()
+	p := newProcessor("downstream")
+	msp := NewMinSeverityProcessor(p)
+
+	var r Record
+	r.SetSeverity(log.SeverityTrace)
+	assert.True(t, msp.Enabled(ctx, r))
+	p.enabled = false
+	assert.False(t, msp.Enabled(ctx, r), "downstream Enabled not used")
+
+	assert.NoError(t, msp.ForceFlush(ctx))
+	assert.NoError(t, msp.Shutdown(ctx))
+	assert.Equal(t, 1, p.forceFlushCalls)
+	assert.Equal(t, 1, p.shutdownCalls)
+
+	var empty MinSeverityProcessor
+	assert.False(t, empty.Enabled(ctx, r))
+	assert.NoError(t, empty.OnEmit(ctx, &r))
+	assert.NoError(t, empty.ForceFlush(ctx))
+	assert.NoError(t, empty.Shutdown(ctx))
+}
+
+func TestMinSeverityProcessorEnv(t *testing.T) {
+	var errs []error
+	t.Cleanup(func(orig otel.ErrorHandler) func() {
+		otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+			errs = append(errs, err)
+		}))
+		return func() { otel.SetErrorHandler(orig) }
+	}(otel.GetErrorHandler()))
+
+	t.Setenv(envarMinSeverity, "warn, a=debug2,b*=10, c=error,bogus")
+	p := NewMinSeverityProcessor(nil, WithScopeMinSeverity("c", log.SeverityFatal))
+
+	assert.Equal(t, log.SeverityWarn, p.minSeverity(""))
+	assert.Equal(t, log.SeverityDebug2, p.minSeverity("a"))
+	assert.Equal(t, log.SeverityInfo2, p.minSeverity("b/c"))
+	assert.Equal(t, log.SeverityFatal, p.minSeverity("c"), "option not preferred over environment")
+	assert.Len(t, errs, 1, "invalid severity not reported")
+
+	p = NewMinSeverityProcessor(nil, WithMinSeverity(log.SeverityInfo))
+	assert.Equal(t, log.SeverityInfo, p.minSeverity(""), "option not preferred over environment")
+}
+
+func TestParseSeverity(t *testing.T) {
+	for s, want := range map[string]log.Severity{
+		"trace":  log.SeverityTrace,
+		"INFO":   log.SeverityInfo,
+		"Warn3":  log.SeverityWarn3,
+		"fatal4": log.SeverityFatal4,
+		"17":     log.SeverityError,
+	} {
+		got, err := parseSeverity(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, want, got, s)
+	}
+
+	for _, s := range []string{"", "warning", "25", "-1"} {
+		_, err := parseSeverity(s)
+		assert.Error(t, err, s)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket. It allows events at a rate per second with
+// bursts of up to burst events.
+type rateLimiter struct {
+	mu sync.Mutex
+
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter returns a rateLimiter that allows rate events per second. It
+// starts full and allows bursts of rate events, or at least one.
+func newRateLimiter(rate float64) *rateLimiter {
+	burst := max(rate, 1)
+	return &rateLimiter{rate: rate, burst: burst, tokens: burst, last: now()}
+}
+
+// allow returns if an event is allowed at t. If true is returned, a token is
+// consumed.
+func (l *rateLimiter) allow(t time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if t.After(l.last) {
+		l.tokens = l.tokensAt(t)
+		l.last = t
+	}
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
+
+// wait returns how long after t it takes for an event to be allowed.
+func (l *rateLimiter) wait(t time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	tokens := l.tokensAt(t)
+	if tokens >= 1 {
+		return 0
+	}
+	return time.Duration((1 - tokens) / l.rate * float64(time.Second))
+}
+
+// full returns if the bucket is full at t. A full limiter behaves as a newly
+// created one.
+func (l *rateLimiter) full(t time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.tokensAt(t) >= l.burst
+}
+
+func (l *rateLimiter) tokensAt(t time.Time) float64 {
+	if elapsed := t.Sub(l.last); elapsed > 0 {
+		return min(l.burst, l.tokens+elapsed.Seconds()*l.rate)
+	}
+	return l.tokens
+}
@@ -46,6 +46,7 @@ type Record struct {
 	// Do not embed the log.Record. Attributes need to be overwrite-able and
 	// deep-copying needs to be possible.
 
+	eventName         string
 	timestamp         time.Time
 	observedTimestamp time.Time
 	severity          log.Severity
@@ -100,6 +101,17 @@ func (r *Record) setDropped(n int) {
 	r.dropped = n
 }
 
+// EventName returns the event name. A log record with an event name is an
+// event.
+func (r *Record) EventName() string {
+	return r.eventName
+}
+
+// SetEventName sets the event name.
+func (r *Record) SetEventName(name string) {
+	r.eventName = name
+}
+
 // Timestamp returns the time when the log record occurred.
 func (r *Record) Timestamp() time.Time {
 	return r.timestamp
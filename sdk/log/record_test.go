@@ -19,6 +19,13 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
+func TestRecordEventName(t *testing.T) {
+	name := "event"
+	r := new(Record)
+	r.SetEventName(name)
+	assert.Equal(t, name, r.EventName())
+}
+
 func TestRecordTimestamp(t *testing.T) {
 	now := time.Now()
 	r := new(Record)
@@ -0,0 +1,211 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"encoding/binary"
+	"math/rand"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Compile-time check SamplingProcessor implements Processor.
+var _ Processor = (*SamplingProcessor)(nil)
+
+// SamplingProcessor is a [Processor] that samples the log records passed to
+// the Processor it decorates based on their trace context.
+//
+// A record is always kept if it belongs to a sampled trace, i.e. the sampled
+// flag of its [Record.TraceFlags] is set, or if its severity is at least the
+// severity threshold (see [WithSamplingSeverity]). The remaining records,
+// those of unsampled traces or without a trace, are sampled at the ratio of
+// [WithSampleRatio] and limited to the rate of [WithSampleRate].
+//
+// Records of the same trace are sampled consistently: the ratio is applied to
+// their trace ID the same way the trace SDK's TraceIDRatioBased sampler does.
+// Records without a trace ID are sampled randomly.
+//
+// Use [NewSamplingProcessor] to create a SamplingProcessor.
+type SamplingProcessor struct {
+	processor Processor
+
+	severity log.Severity
+	// traceIDUpperBound is the bound a trace ID needs to be below to be
+	// sampled at the ratio.
+	traceIDUpperBound uint64
+	ratio             float64
+	limiter           *rateLimiter
+
+	noCmp [0]func() //nolint: unused  // This is indeed used.
+}
+
+// NewSamplingProcessor returns a [SamplingProcessor] that decorates processor.
+func NewSamplingProcessor(processor Processor, opts ...SamplingProcessorOption) *SamplingProcessor {
+	cfg := newSamplingConfig(opts)
+
+	p := &SamplingProcessor{
+		processor:         processor,
+		severity:          cfg.severity.Value,
+		ratio:             cfg.ratio.Value,
+		traceIDUpperBound: uint64(cfg.ratio.Value * (1 << 63)),
+	}
+	if cfg.rate.Value > 0 {
+		p.limiter = newRateLimiter(cfg.rate.Value)
+	}
+	return p
+}
+
+// keep returns if r is always kept.
+func (p *SamplingProcessor) keep(r *Record) bool {
+	return r.TraceFlags().IsSampled() || (p.severity != log.SeverityUndefined && r.Severity() >= p.severity)
+}
+
+// sampleRatio returns if r is sampled at the ratio of p.
+func (p *SamplingProcessor) sampleRatio(r *Record) bool {
+	switch {
+	case p.ratio >= 1:
+		return true
+	case p.ratio <= 0:
+		return false
+	}
+
+	if id := r.TraceID(); id.IsValid() {
+		return sampleTraceID(id, p.traceIDUpperBound)
+	}
+	return rand.Float64() < p.ratio //nolint:gosec // Sampling does not require a cryptographically secure random number.
+}
+
+func sampleTraceID(id trace.TraceID, upperBound uint64) bool {
+	return binary.BigEndian.Uint64(id[8:16])>>1 < upperBound
+}
+
+// OnEmit passes r to the decorated processor if it is kept or sampled.
+func (p *SamplingProcessor) OnEmit(ctx context.Context, r *Record) error {
+	if p.processor == nil {
+		return nil
+	}
+	if !p.keep(r) {
+		if !p.sampleRatio(r) {
+			return nil
+		}
+		if p.limiter != nil && !p.limiter.allow(now()) {
+			return nil
+		}
+	}
+	return p.processor.OnEmit(ctx, r)
+}
+
+// Enabled returns false if r will not be sampled. Otherwise, it returns what
+// the decorated processor returns.
+//
+// Only decisions that do not depend on chance are made. A record of an
+// unsampled trace can be known to be dropped, a record without a trace
+// sampled at a ratio cannot.
+func (p *SamplingProcessor) Enabled(ctx context.Context, r Record) bool {
+	if p.processor == nil {
+		return false
+	}
+	// A record without severity is a partial record, the severity threshold
+	// can still apply once it is emitted.
+	if !p.keep(&r) && r.Severity() != log.SeverityUndefined {
+		if p.ratio <= 0 {
+			return false
+		}
+		if id := r.TraceID(); id.IsValid() && p.ratio < 1 && !sampleTraceID(id, p.traceIDUpperBound) {
+			return false
+		}
+	}
+	return p.processor.Enabled(ctx, r)
+}
+
+// Shutdown shuts down the decorated processor.
+func (p *SamplingProcessor) Shutdown(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+	return p.processor.Shutdown(ctx)
+}
+
+// ForceFlush flushes the decorated processor.
+func (p *SamplingProcessor) ForceFlush(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+	return p.processor.ForceFlush(ctx)
+}
+
+type samplingConfig struct {
+	severity setting[log.Severity]
+	ratio    setting[float64]
+	rate     setting[float64]
+}
+
+func newSamplingConfig(options []SamplingProcessorOption) samplingConfig {
+	var c samplingConfig
+	for _, o := range options {
+		c = o.apply(c)
+	}
+
+	c.severity = c.severity.Resolve(fallback(log.SeverityError))
+	if !c.ratio.Set && c.rate.Set {
+		// Only limited by rate.
+		c.ratio = newSetting(1.0)
+	}
+	c.ratio = c.ratio.Resolve(fallback(0.0))
+	c.ratio.Value = min(max(c.ratio.Value, 0), 1)
+	return c
+}
+
+// SamplingProcessorOption applies a configuration to a [SamplingProcessor].
+type SamplingProcessorOption interface {
+	apply(samplingConfig) samplingConfig
+}
+
+type samplingOptionFunc func(samplingConfig) samplingConfig
+
+func (fn samplingOptionFunc) apply(c samplingConfig) samplingConfig {
+	return fn(c)
+}
+
+// WithSamplingSeverity sets the severity at and above which records are always
+// kept, regardless of their trace context. Passing
+// [log.SeverityUndefined] keeps no record based on its severity.
+//
+// By default, if this option is not passed, [log.SeverityError] is used.
+func WithSamplingSeverity(severity log.Severity) SamplingProcessorOption {
+	return samplingOptionFunc(func(c samplingConfig) samplingConfig {
+		c.severity = newSetting(severity)
+		return c
+	})
+}
+
+// WithSampleRatio sets the ratio, from 0 to 1, of the records of unsampled
+// traces and without a trace that are kept. Values out of this range are
+// clamped.
+//
+// By default, if this option is not passed, a ratio of 1 is used if
+// [WithSampleRate] is passed. Otherwise, a ratio of 0 is used and none of
+// these records are kept.
+func WithSampleRatio(ratio float64) SamplingProcessorOption {
+	return samplingOptionFunc(func(c samplingConfig) samplingConfig {
+		c.ratio = newSetting(ratio)
+		return c
+	})
+}
+
+// WithSampleRate sets the maximum number of records of unsampled traces and
+// without a trace kept per second. Bursts of up to rate records are allowed.
+// If [WithSampleRatio] is also passed, the rate applies to the records
+// sampled at the ratio.
+//
+// By default, if this option is not passed or rate is not positive, the kept
+// records are not limited by rate.
+func WithSampleRate(rate float64) SamplingProcessorOption {
+	return samplingOptionFunc(func(c samplingConfig) samplingConfig {
+		c.rate = newSetting(rate)
+		return c
+	})
+}
@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func traceContext(traceID trace.TraceID, flags trace.TraceFlags) context.Context {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     trace.SpanID{1},
+		TraceFlags: flags,
+	})
+	return trace.ContextWithSpanContext(context.Background(), sc)
+}
+
+var (
+	// lowTraceID is sampled at ratios above 0.
+	lowTraceID = trace.TraceID{0: 1, 15: 1}
+	// highTraceID is only sampled at a ratio of 1.
+	highTraceID = trace.TraceID{0: 1, 8: 0xff, 9: 0xff, 10: 0xff, 11: 0xff, 12: 0xff, 13: 0xff, 14: 0xff, 15: 0xff}
+)
+
+func TestSamplingProcessorKeep(t *testing.T) {
+	p := newProcessor("downstream")
+	l := NewLoggerProvider(WithProcessor(NewSamplingProcessor(p))).Logger("TestSamplingProcessorKeep")
+
+	sampled := traceContext(highTraceID, trace.FlagsSampled)
+	unsampled := traceContext(lowTraceID, 0)
+
+	info, errRec := severityRecord(log.SeverityInfo), severityRecord(log.SeverityError)
+
+	assert.True(t, l.Enabled(sampled, info), "sampled trace disabled")
+	l.Emit(sampled, info)
+	assert.True(t, l.Enabled(unsampled, errRec), "error disabled")
+	l.Emit(unsampled, errRec)
+	assert.True(t, l.Enabled(context.Background(), errRec), "error without trace disabled")
+	l.Emit(context.Background(), errRec)
+
+	assert.False(t, l.Enabled(unsampled, info), "unsampled trace enabled")
+	l.Emit(unsampled, info)
+	assert.False(t, l.Enabled(context.Background(), info), "record without trace enabled")
+	l.Emit(context.Background(), info)
+
+	require.Len(t, p.records, 3)
+	assert.Equal(t, highTraceID, p.records[0].TraceID())
+	assert.Equal(t, log.SeverityError, p.records[1].Severity())
+	assert.Equal(t, log.SeverityError, p.records[2].Severity())
+}
+
+func TestSamplingProcessorSeverity(t *testing.T) {
+	ctx := context.Background()
+	p := newProcessor("downstream")
+	l := NewLoggerProvider(WithProcessor(NewSamplingProcessor(
+		p,
+		WithSamplingSeverity(log.SeverityWarn),
+	))).Logger("TestSamplingProcessorSeverity")
+
+	l.Emit(ctx, severityRecord(log.SeverityInfo4))
+	l.Emit(ctx, severityRecord(log.SeverityWarn))
+	require.Len(t, p.records, 1)
+	assert.Equal(t, log.SeverityWarn, p.records[0].Severity())
+
+	p = newProcessor("downstream")
+	l = NewLoggerProvider(WithProcessor(NewSamplingProcessor(
+		p,
+		WithSamplingSeverity(log.SeverityUndefined),
+	))).Logger("TestSamplingProcessorSeverity")
+	l.Emit(ctx, severityRecord(log.SeverityFatal4))
+	assert.Empty(t, p.records, "record kept for its severity")
+}
+
+func TestSamplingProcessorRatio(t *testing.T) {
+	p := newProcessor("downstream")
+	l := NewLoggerProvider(WithProcessor(NewSamplingProcessor(
+		p,
+		WithSampleRatio(0.5),
+	))).Logger("TestSamplingProcessorRatio")
+
+	r := severityRecord(log.SeverityInfo)
+	for _, id := range []trace.TraceID{lowTraceID, highTraceID} {
+		ctx := traceContext(id, 0)
+		want := id == lowTraceID
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, want, l.Enabled(ctx, r), "trace %s", id)
+			l.Emit(ctx, r)
+		}
+	}
+	require.Len(t, p.records, 10, "trace not sampled consistently")
+	for _, rec := range p.records {
+		assert.Equal(t, lowTraceID, rec.TraceID())
+	}
+
+	// Records without a trace are sampled randomly.
+	p.records = nil
+	const n = 1000
+	for i := 0; i < n; i++ {
+		assert.True(t, l.Enabled(context.Background(), r))
+		l.Emit(context.Background(), r)
+	}
+	assert.InDelta(t, n/2, len(p.records), n/5)
+}
+
+func TestSamplingProcessorRate(t *testing.T) {
+	t.Cleanup(func(orig func() time.Time) func() {
+		return func() { now = orig }
+	}(now))
+	start := time.Now()
+	now = func() time.Time { return start }
+
+	ctx := context.Background()
+	p := newProcessor("downstream")
+	l := NewLoggerProvider(WithProcessor(NewSamplingProcessor(
+		p,
+		WithSampleRate(2),
+	))).Logger("TestSamplingProcessorRate")
+
+	r := severityRecord(log.SeverityInfo)
+	for i := 0; i < 5; i++ {
+		l.Emit(ctx, r)
+	}
+	assert.Len(t, p.records, 2, "burst not limited")
+
+	now = func() time.Time { return start.Add(500 * time.Millisecond) }
+	for i := 0; i < 5; i++ {
+		l.Emit(ctx, r)
+	}
+	assert.Len(t, p.records, 3, "rate not limited")
+
+	// Kept records are not limited.
+	for i := 0; i < 5; i++ {
+		l.Emit(traceContext(lowTraceID, trace.FlagsSampled), r)
+	}
+	assert.Len(t, p.records, 8, "sampled trace limited")
+}
+
+func TestSamplingProcessorDelegates(t *testing.T) {
+	ctx := context.Background()
+	p := newProcessor("downstream")
+	sp := NewSamplingProcessor(p, WithSampleRatio(1))
+
+	var r Record
+	r.SetSeverity(log.SeverityTrace)
+	assert.True(t, sp.Enabled(ctx, r))
+	p.enabled = false
+	assert.False(t, sp.Enabled(ctx, r), "downstream Enabled not used")
+
+	assert.NoError(t, sp.ForceFlush(ctx))
+	assert.NoError(t, sp.Shutdown(ctx))
+	assert.Equal(t, 1, p.forceFlushCalls)
+	assert.Equal(t, 1, p.shutdownCalls)
+
+	var empty SamplingProcessor
+	assert.False(t, empty.Enabled(ctx, r))
+	assert.NoError(t, empty.OnEmit(ctx, &r))
+	assert.NoError(t, empty.ForceFlush(ctx))
+	assert.NoError(t, empty.Shutdown(ctx))
+}
+
+func TestRateLimiter(t *testing.T) {
+	start := time.Now()
+	l := &rateLimiter{rate: 0.5, burst: 1, tokens: 1, last: start}
+
+	assert.True(t, l.allow(start))
+	assert.False(t, l.allow(start))
+	assert.False(t, l.allow(start.Add(time.Second)))
+	assert.True(t, l.allow(start.Add(2*time.Second)))
+	// Time going backwards does not add tokens.
+	assert.False(t, l.allow(start))
+}
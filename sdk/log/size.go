@@ -0,0 +1,263 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/log"
+)
+
+// OversizePolicy defines how a log record that exceeds the maximum batch size
+// in bytes on its own is exported.
+type OversizePolicy int
+
+const (
+	// OversizeSendAlone exports a log record exceeding the maximum batch
+	// size in bytes in a batch of its own.
+	OversizeSendAlone OversizePolicy = iota
+	// OversizeTruncate truncates the string values of the body and
+	// attributes of a log record exceeding the maximum batch size in bytes
+	// so that it fits within it, and exports it in a batch of its own.
+	OversizeTruncate
+	// OversizeDrop drops a log record exceeding the maximum batch size in
+	// bytes.
+	OversizeDrop
+)
+
+// recordOverhead is the estimated size of the fixed-size fields of an OTLP
+// encoded log record: the timestamps, severity number, flags, trace ID, span
+// ID, and their field tags.
+const recordOverhead = 64
+
+// recordSize returns an estimate of the size in bytes of r when encoded as an
+// OTLP protobuf LogRecord. The resource and instrumentation scope are shared
+// by the records of a batch and are not included.
+func recordSize(r Record) int {
+	n := recordOverhead + sizedLen(len(r.SeverityText())) + sizedLen(len(r.EventName()))
+	n += sizedLen(valueSize(r.Body()))
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		n += sizedLen(keyValueSize(kv))
+		return true
+	})
+	return n
+}
+
+func keyValueSize(kv log.KeyValue) int {
+	return sizedLen(len(kv.Key)) + sizedLen(valueSize(kv.Value))
+}
+
+func valueSize(v log.Value) int {
+	switch v.Kind() {
+	case log.KindString:
+		return sizedLen(len(v.AsString()))
+	case log.KindBytes:
+		return sizedLen(len(v.AsBytes()))
+	case log.KindBool, log.KindInt64, log.KindFloat64:
+		// Tag and an up to 8 bytes value.
+		return 9
+	case log.KindSlice:
+		var n int
+		for _, e := range v.AsSlice() {
+			n += sizedLen(valueSize(e))
+		}
+		return sizedLen(n)
+	case log.KindMap:
+		var n int
+		for _, kv := range v.AsMap() {
+			n += sizedLen(keyValueSize(kv))
+		}
+		return sizedLen(n)
+	}
+	return 0
+}
+
+// sizedLen returns the size of a length-delimited protobuf field holding n
+// bytes: its tag, its length, and the bytes.
+func sizedLen(n int) int {
+	l := 1
+	for v := n; v >= 0x80; v >>= 7 {
+		l++
+	}
+	return 1 + l + n
+}
+
+// truncateRecord returns a copy of r with the string values of its body and
+// attributes truncated so its estimated size does not exceed maxBytes. The
+// returned record may still exceed maxBytes if its other fields do.
+func truncateRecord(r Record, maxBytes int) Record {
+	longest := maxStringLen(r.Body())
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		longest = max(longest, maxStringLen(kv.Value))
+		return true
+	})
+
+	// Search the longest string length the record fits with.
+	lo, hi := 0, longest
+	for lo < hi {
+		mid := lo + (hi-lo+1)/2
+		if recordSize(truncateValues(r, mid)) <= maxBytes {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return truncateValues(r, lo)
+}
+
+// truncateValues returns a copy of r with all string values of its body and
+// attributes truncated to be at most n bytes long.
+func truncateValues(r Record, n int) Record {
+	out := r.Clone()
+	out.SetBody(truncateValue(r.Body(), n))
+
+	attrs := make([]log.KeyValue, 0, r.AttributesLen())
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		attrs = append(attrs, log.KeyValue{Key: kv.Key, Value: truncateValue(kv.Value, n)})
+		return true
+	})
+	// Do not use SetAttributes, the attributes of r already had the limits
+	// applied and its count of dropped attributes needs to be kept.
+	out.front = [attributesInlineCount]log.KeyValue{}
+	out.nFront = copy(out.front[:], attrs)
+	out.back = nil
+	if len(attrs) > out.nFront {
+		out.back = attrs[out.nFront:]
+	}
+	return out
+}
+
+// truncateValue returns v with all its string values truncated to be at most
+// n bytes long. The values of v are not modified.
+func truncateValue(v log.Value, n int) log.Value {
+	switch v.Kind() {
+	case log.KindString:
+		if s := v.AsString(); len(s) > n {
+			return log.StringValue(truncateBytes(s, n))
+		}
+	case log.KindSlice:
+		sl := v.AsSlice()
+		out := make([]log.Value, len(sl))
+		for i := range sl {
+			out[i] = truncateValue(sl[i], n)
+		}
+		return log.SliceValue(out...)
+	case log.KindMap:
+		m := v.AsMap()
+		out := make([]log.KeyValue, len(m))
+		for i, kv := range m {
+			out[i] = log.KeyValue{Key: kv.Key, Value: truncateValue(kv.Value, n)}
+		}
+		return log.MapValue(out...)
+	}
+	return v
+}
+
+// truncateBytes returns s truncated to be at most n bytes long without
+// splitting a UTF-8 encoded character.
+func truncateBytes(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+func maxStringLen(v log.Value) int {
+	switch v.Kind() {
+	case log.KindString:
+		return len(v.AsString())
+	case log.KindSlice:
+		var n int
+		for _, e := range v.AsSlice() {
+			n = max(n, maxStringLen(e))
+		}
+		return n
+	case log.KindMap:
+		var n int
+		for _, kv := range v.AsMap() {
+			n = max(n, maxStringLen(kv.Value))
+		}
+		return n
+	}
+	return 0
+}
+
+// sizeExporter wraps an Exporter's Export method so it is called with export
+// payloads whose estimated size does not exceed a maximum number of bytes.
+// Any larger payload is split into smaller payloads exported sequentially.
+type sizeExporter struct {
+	Exporter
+
+	// maxBytes is the maximum estimated size of a batch exported.
+	maxBytes int
+	// oversize is the policy applied to a record exceeding maxBytes.
+	oversize OversizePolicy
+}
+
+// newSizeExporter wraps exporter. Calls to the Export will have their records
+// payload split so they do not exceed maxBytes. Records exceeding maxBytes on
+// their own are handled according to oversize. If maxBytes is less than or
+// equal to 0, exporter is returned directly.
+func newSizeExporter(exporter Exporter, maxBytes int, oversize OversizePolicy) Exporter {
+	if maxBytes <= 0 {
+		return exporter
+	}
+	return &sizeExporter{Exporter: exporter, maxBytes: maxBytes, oversize: oversize}
+}
+
+// Export exports records in batches no larger than e.maxBytes.
+func (e *sizeExporter) Export(ctx context.Context, records []Record) error {
+	var (
+		start, size, dropped int
+		alone                []Record
+	)
+	flush := func(end int) error {
+		if start >= end {
+			return nil
+		}
+		err := e.Exporter.Export(ctx, records[start:end])
+		start, size = end, 0
+		return err
+	}
+
+	for i := 0; i < len(records); i++ {
+		n := recordSize(records[i])
+		if n > e.maxBytes {
+			if err := flush(i); err != nil {
+				return err
+			}
+			start = i + 1
+
+			switch e.oversize {
+			case OversizeDrop:
+				dropped++
+				continue
+			case OversizeTruncate:
+				alone = append(alone[:0], truncateRecord(records[i], e.maxBytes))
+			default:
+				alone = append(alone[:0], records[i])
+			}
+			if err := e.Exporter.Export(ctx, alone); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if size+n > e.maxBytes {
+			if err := flush(i); err != nil {
+				return err
+			}
+		}
+		size += n
+	}
+	if dropped > 0 {
+		global.Warn("dropped oversize log records", "dropped", dropped, "max_bytes", e.maxBytes)
+	}
+	return flush(len(records))
+}
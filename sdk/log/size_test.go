@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+func sizedRecord(body string, attrs ...log.KeyValue) Record {
+	r := Record{attributeValueLengthLimit: -1, attributeCountLimit: -1}
+	r.SetBody(log.StringValue(body))
+	r.AddAttributes(attrs...)
+	return r
+}
+
+func TestRecordSize(t *testing.T) {
+	empty := recordSize(Record{})
+	assert.Equal(t, recordOverhead+2+2+2, empty)
+
+	r := sizedRecord(strings.Repeat("a", 200))
+	// 200 bytes body encoded with a 2 bytes length in an AnyValue, itself
+	// encoded in a 1 byte tag and 2 bytes length field.
+	assert.Equal(t, empty-2+(1+2+(1+2+200)), recordSize(r))
+
+	withAttr := sizedRecord(strings.Repeat("a", 200), log.String("key", "value"))
+	assert.Greater(t, recordSize(withAttr), recordSize(r))
+}
+
+func TestTruncateRecord(t *testing.T) {
+	r := sizedRecord(
+		strings.Repeat("b", 1000),
+		log.String("short", "value"),
+		log.Slice("slice", log.StringValue(strings.Repeat("s", 500))),
+		log.Map("map", log.String("long", strings.Repeat("m", 500))),
+		log.Int("int", 1),
+	)
+	r.SetSeverityText("INFO")
+	r.addDropped(2)
+	orig := r.Clone()
+
+	const maxBytes = 512
+	got := truncateRecord(r, maxBytes)
+	assert.LessOrEqual(t, recordSize(got), maxBytes)
+	assert.Greater(t, recordSize(got), maxBytes-64, "truncated more than needed")
+
+	assert.Equal(t, "INFO", got.SeverityText())
+	assert.Equal(t, 2, got.DroppedAttributes())
+	assert.Equal(t, 4, got.AttributesLen())
+	assert.True(t, strings.HasPrefix(strings.Repeat("b", 1000), got.Body().AsString()))
+	assert.Equal(t, orig, r, "record modified")
+
+	// Multi-byte characters are not split.
+	assert.Equal(t, "a", truncateBytes("aé", 2))
+	assert.Equal(t, "aé", truncateBytes("aé", 3))
+}
+
+func TestSizeExporter(t *testing.T) {
+	small := sizedRecord(strings.Repeat("s", 100))
+	large := sizedRecord(strings.Repeat("l", 1000))
+	maxBytes := 3 * recordSize(small)
+
+	export := func(t *testing.T, policy OversizePolicy, records ...Record) [][]Record {
+		exp := newTestExporter(nil)
+		t.Cleanup(exp.Stop)
+		e := newSizeExporter(exp, maxBytes, policy)
+		require.NoError(t, e.Export(context.Background(), records))
+		return exp.Records()
+	}
+	lens := func(batches [][]Record) []int {
+		var out []int
+		for _, b := range batches {
+			out = append(out, len(b))
+		}
+		return out
+	}
+
+	t.Run("Split", func(t *testing.T) {
+		got := export(t, OversizeSendAlone, small, small, small, small, small, small, small)
+		assert.Equal(t, []int{3, 3, 1}, lens(got))
+	})
+
+	t.Run("SendAlone", func(t *testing.T) {
+		got := export(t, OversizeSendAlone, small, large, small)
+		assert.Equal(t, []int{1, 1, 1}, lens(got))
+		assert.Equal(t, large.Body(), got[1][0].Body())
+	})
+
+	t.Run("Truncate", func(t *testing.T) {
+		got := export(t, OversizeTruncate, small, large, small)
+		require.Equal(t, []int{1, 1, 1}, lens(got))
+		assert.LessOrEqual(t, recordSize(got[1][0]), maxBytes)
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		got := export(t, OversizeDrop, small, large, small)
+		assert.Equal(t, []int{1, 1}, lens(got))
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		exp := newTestExporter(nil)
+		assert.Same(t, exp, newSizeExporter(exp, 0, OversizeDrop))
+	})
+
+	t.Run("ExportError", func(t *testing.T) {
+		exp := newTestExporter(assert.AnError)
+		t.Cleanup(exp.Stop)
+		e := newSizeExporter(exp, maxBytes, OversizeSendAlone)
+		err := e.Export(context.Background(), []Record{small, small, small, small})
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, 1, exp.ExportN(), "export continued after error")
+	})
+}
+
+func TestBatchProcessorMaxBatchBytes(t *testing.T) {
+	small := sizedRecord(strings.Repeat("s", 100))
+
+	exp := newTestExporter(nil)
+	t.Cleanup(exp.Stop)
+	b := NewBatchProcessor(
+		exp,
+		WithExportInterval(time.Hour),
+		WithExportMaxBatchBytes(2*recordSize(small)),
+	)
+	for i := 0; i < 5; i++ {
+		r := small.Clone()
+		require.NoError(t, b.OnEmit(context.Background(), &r))
+	}
+	require.NoError(t, b.Shutdown(context.Background()))
+
+	var got []int
+	for _, batch := range exp.Records() {
+		got = append(got, len(batch))
+	}
+	assert.Equal(t, []int{2, 2, 1}, got)
+}
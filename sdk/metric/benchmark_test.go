@@ -104,6 +104,16 @@ func benchSyncViews(views ...View) func(*testing.B) {
 				return func() { fHist.Record(ctx, 1, o...) }
 			}
 		}()))
+
+		b.Run("Int64Counter/Bound", benchMeasAttrs(func(s attribute.Set) func() {
+			bound := iCtr.Bind(s)
+			return func() { bound.Add(ctx, 1) }
+		}))
+
+		b.Run("Float64Histogram/Bound", benchMeasAttrs(func(s attribute.Set) func() {
+			bound := fHist.Bind(s)
+			return func() { bound.Record(ctx, 1) }
+		}))
 	}
 }
 
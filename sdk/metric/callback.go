@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+)
+
+// callback is a function registered with a pipeline to make observations for
+// asynchronous instruments.
+type callback struct {
+	// name identifies the callback in reported errors.
+	name string
+	// run runs the callback. Observations need to be dropped if inv is no
+	// longer active. A nil inv is always active.
+	run func(ctx context.Context, inv *invocation) error
+	// running is set while an invocation of run by a pipeline has not
+	// returned. It is shared by all copies of the callback in a pipeline.
+	running *atomic.Bool
+}
+
+// newCallback returns a callback named after the instruments it observes.
+func newCallback(scope string, instruments []string, run func(context.Context, *invocation) error) callback {
+	name := fmt.Sprintf("callback for instrument %q", instruments[0])
+	if len(instruments) > 1 {
+		name = fmt.Sprintf("callback for instruments %q", instruments)
+	}
+	if scope != "" {
+		name += fmt.Sprintf(" of meter %q", scope)
+	}
+	return callback{name: name, run: run, running: new(atomic.Bool)}
+}
+
+// invocation tracks if observations from a single run of a callback are
+// accepted.
+type invocation struct {
+	mu        sync.RWMutex
+	abandoned bool
+}
+
+// acquire returns true if observations can be recorded for inv. If true is
+// returned, release needs to be called once the observation is recorded.
+func (inv *invocation) acquire() bool {
+	if inv == nil {
+		return true
+	}
+	inv.mu.RLock()
+	if inv.abandoned {
+		inv.mu.RUnlock()
+		return false
+	}
+	return true
+}
+
+// release releases a successful acquire of inv.
+func (inv *invocation) release() {
+	if inv != nil {
+		inv.mu.RUnlock()
+	}
+}
+
+// abandon stops inv from accepting any observation. Once abandon returns, no
+// observation is being recorded for inv.
+func (inv *invocation) abandon() {
+	inv.mu.Lock()
+	inv.abandoned = true
+	inv.mu.Unlock()
+}
+
+// runCallbacks runs all callbacks registered with p. Errors returned from
+// callbacks are appended to errs. An error is returned if ctx is done before
+// all callbacks are run.
+//
+// The lock of p needs to be held when calling this method.
+func (p *pipeline) runCallbacks(ctx context.Context, errs *multierror) error {
+	if p.callbackTimeout <= 0 && p.callbackConcurrency < 2 {
+		run := func(c callback) error {
+			if err := c.run(ctx, nil); err != nil {
+				errs.append(err)
+			}
+			return ctx.Err()
+		}
+		for _, c := range p.callbacks {
+			if err := run(c); err != nil {
+				return err
+			}
+		}
+		for e := p.multiCallbacks.Front(); e != nil; e = e.Next() {
+			if err := run(e.Value.(callback)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	cbacks := make([]callback, 0, len(p.callbacks)+p.multiCallbacks.Len())
+	cbacks = append(cbacks, p.callbacks...)
+	for e := p.multiCallbacks.Front(); e != nil; e = e.Next() {
+		cbacks = append(cbacks, e.Value.(callback))
+	}
+
+	results := make([]error, len(cbacks))
+	sem := make(chan struct{}, max(p.callbackConcurrency, 1))
+	var wg sync.WaitGroup
+run:
+	for i, c := range cbacks {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break run
+		}
+		wg.Add(1)
+		go func(i int, c callback) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			results[i] = p.invoke(ctx, c)
+		}(i, c)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	for _, err := range results {
+		if err != nil {
+			errs.append(err)
+		}
+	}
+	return nil
+}
+
+// invoke runs c and returns its result. If c does not return before its
+// timeout, or before ctx is done, c is abandoned and nil is returned. An
+// abandoned callback keeps running, but any observation it makes is dropped.
+// A timeout is reported with otel.Handle.
+//
+// If a previous invocation of c is still running, c is skipped and this is
+// reported with otel.Handle. Without a timeout, c is run on the calling
+// goroutine and is never abandoned.
+func (p *pipeline) invoke(ctx context.Context, c callback) error {
+	if p.callbackTimeout <= 0 {
+		return c.run(ctx, nil)
+	}
+
+	if c.running != nil && !c.running.CompareAndSwap(false, true) {
+		otel.Handle(fmt.Errorf("%s skipped: previous invocation still running", c.name))
+		return nil
+	}
+
+	cbCtx, cancel := context.WithTimeout(ctx, p.callbackTimeout)
+	defer cancel()
+
+	inv := new(invocation)
+	done := make(chan error, 1)
+	go func() {
+		if c.running != nil {
+			defer c.running.Store(false)
+		}
+		done <- c.run(cbCtx, inv)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-cbCtx.Done():
+	}
+
+	inv.abandon()
+	if ctx.Err() == nil && errors.Is(cbCtx.Err(), context.DeadlineExceeded) {
+		otel.Handle(fmt.Errorf("%s timed out after %s: %w", c.name, p.callbackTimeout, cbCtx.Err()))
+	}
+	return nil
+}
@@ -0,0 +1,292 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// errorRecorder records errors passed to the global error handler.
+type errorRecorder struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+func (r *errorRecorder) Handle(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.errs = append(r.errs, err)
+}
+
+func (r *errorRecorder) Errors() []error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]error(nil), r.errs...)
+}
+
+func recordErrors(t *testing.T) *errorRecorder {
+	r := new(errorRecorder)
+	t.Cleanup(func(orig otel.ErrorHandler) func() {
+		otel.SetErrorHandler(r)
+		return func() { otel.SetErrorHandler(orig) }
+	}(otel.GetErrorHandler()))
+	return r
+}
+
+// metricNames returns the names of the metrics in rm.
+func metricNames(rm metricdata.ResourceMetrics) []string {
+	var names []string
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			names = append(names, m.Name)
+		}
+	}
+	return names
+}
+
+func TestNewCallbackName(t *testing.T) {
+	noop := func(context.Context, *invocation) error { return nil }
+
+	c := newCallback("", []string{"a"}, noop)
+	assert.Equal(t, `callback for instrument "a"`, c.name)
+
+	c = newCallback("scope", []string{"a"}, noop)
+	assert.Equal(t, `callback for instrument "a" of meter "scope"`, c.name)
+
+	c = newCallback("scope", []string{"a", "b"}, noop)
+	assert.Equal(t, `callback for instruments ["a" "b"] of meter "scope"`, c.name)
+}
+
+func TestInvocation(t *testing.T) {
+	var inv *invocation
+	require.True(t, inv.acquire(), "nil invocation not active")
+	inv.release()
+
+	inv = new(invocation)
+	require.True(t, inv.acquire(), "new invocation not active")
+	inv.release()
+
+	inv.abandon()
+	assert.False(t, inv.acquire(), "abandoned invocation active")
+}
+
+func TestCallbackTimeout(t *testing.T) {
+	errs := recordErrors(t)
+
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr), WithCallbackTimeout(100*time.Millisecond))
+	m := mp.Meter("TestCallbackTimeout")
+
+	_, err := m.Int64ObservableCounter("healthy", metric.WithInt64Callback(
+		func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(1)
+			return nil
+		},
+	))
+	require.NoError(t, err)
+
+	slow, err := m.Int64ObservableCounter("slow")
+	require.NoError(t, err)
+
+	var calls atomic.Int64
+	release, observed := make(chan struct{}), make(chan struct{})
+	_, err = m.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		if calls.Add(1) > 1 {
+			return nil
+		}
+		// Block beyond the timeout without honoring the context.
+		<-release
+		o.ObserveInt64(slow, 5)
+		close(observed)
+		return nil
+	}, slow)
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(context.Background(), &rm))
+	assert.Equal(t, []string{"healthy"}, metricNames(rm), "healthy results not exported")
+
+	got := errs.Errors()
+	require.Len(t, got, 1, "timeout not reported")
+	assert.ErrorIs(t, got[0], context.DeadlineExceeded)
+	assert.ErrorContains(t, got[0], `callback for instrument "slow" of meter "TestCallbackTimeout" timed out`)
+
+	// The abandoned callback is still running and is not invoked again.
+	require.NoError(t, rdr.Collect(context.Background(), &rm))
+	assert.Equal(t, []string{"healthy"}, metricNames(rm))
+	assert.Equal(t, int64(1), calls.Load(), "running callback invoked again")
+	got = errs.Errors()
+	require.Len(t, got, 2, "skip not reported")
+	assert.ErrorContains(t, got[1], `callback for instrument "slow" of meter "TestCallbackTimeout" skipped`)
+
+	// Observations made after the callback was abandoned are dropped, and the
+	// callback is invoked again once it returned.
+	close(release)
+	<-observed
+	assert.Eventually(t, func() bool {
+		require.NoError(t, rdr.Collect(context.Background(), &rm))
+		return calls.Load() > 1
+	}, time.Second, 10*time.Millisecond, "returned callback not invoked again")
+	assert.Equal(t, []string{"healthy"}, metricNames(rm), "abandoned observation recorded")
+}
+
+func TestCallbackTimeoutReaders(t *testing.T) {
+	errs := recordErrors(t)
+
+	rdr0, rdr1 := NewManualReader(), NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr0), WithReader(rdr1), WithCallbackTimeout(time.Minute))
+	m := mp.Meter("TestCallbackTimeoutReaders")
+
+	counter, err := m.Int64ObservableCounter("counter")
+	require.NoError(t, err)
+
+	// Each invocation blocks until both readers invoked the callback.
+	var started sync.WaitGroup
+	started.Add(2)
+	_, err = m.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		started.Done()
+		done := make(chan struct{})
+		go func() {
+			started.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		o.ObserveInt64(counter, 1)
+		return nil
+	}, counter)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var wg sync.WaitGroup
+	for _, rdr := range []*ManualReader{rdr0, rdr1} {
+		wg.Add(1)
+		go func(rdr *ManualReader) {
+			defer wg.Done()
+			var rm metricdata.ResourceMetrics
+			assert.NoError(t, rdr.Collect(ctx, &rm))
+			assert.Equal(t, []string{"counter"}, metricNames(rm), "observations dropped")
+		}(rdr)
+	}
+	wg.Wait()
+	assert.Empty(t, errs.Errors(), "callback of another reader reported as running")
+}
+
+func TestCallbackTimeoutContext(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr), WithCallbackTimeout(time.Minute))
+	m := mp.Meter("TestCallbackTimeoutContext")
+
+	_, err := m.Int64ObservableGauge("gauge", metric.WithInt64Callback(
+		func(ctx context.Context, o metric.Int64Observer) error {
+			deadline, ok := ctx.Deadline()
+			assert.True(t, ok, "callback context has no deadline")
+			assert.False(t, deadline.After(time.Now().Add(time.Minute)), "callback deadline exceeds timeout")
+			o.Observe(1)
+			return nil
+		},
+	))
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(context.Background(), &rm))
+	assert.Equal(t, []string{"gauge"}, metricNames(rm))
+}
+
+func TestCallbackErrorsReturned(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr), WithCallbackTimeout(time.Minute))
+	m := mp.Meter("TestCallbackErrorsReturned")
+
+	_, err := m.Int64ObservableCounter("failing", metric.WithInt64Callback(
+		func(context.Context, metric.Int64Observer) error { return assert.AnError },
+	))
+	require.NoError(t, err)
+	_, err = m.Int64ObservableCounter("healthy", metric.WithInt64Callback(
+		func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(1)
+			return nil
+		},
+	))
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	assert.ErrorContains(t, rdr.Collect(context.Background(), &rm), assert.AnError.Error())
+	assert.Equal(t, []string{"healthy"}, metricNames(rm))
+}
+
+func TestCallbackCollectionContextDone(t *testing.T) {
+	errs := recordErrors(t)
+
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr), WithCallbackTimeout(time.Minute))
+	m := mp.Meter("TestCallbackCollectionContextDone")
+
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+	_, err := m.Int64ObservableCounter("blocked", metric.WithInt64Callback(
+		func(context.Context, metric.Int64Observer) error {
+			<-release
+			return nil
+		},
+	))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	var rm metricdata.ResourceMetrics
+	assert.ErrorIs(t, rdr.Collect(ctx, &rm), context.DeadlineExceeded)
+	assert.Empty(t, errs.Errors(), "collection timeout reported as callback timeout")
+}
+
+func TestCallbackConcurrency(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr), WithCallbackConcurrency(2))
+	m := mp.Meter("TestCallbackConcurrency")
+
+	// Each callback waits for the other to start. This only completes if they
+	// are run concurrently.
+	var started sync.WaitGroup
+	started.Add(2)
+	cback := func(ctx context.Context, o metric.Int64Observer) error {
+		started.Done()
+		done := make(chan struct{})
+		go func() {
+			started.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		o.Observe(1)
+		return nil
+	}
+	_, err := m.Int64ObservableCounter("a", metric.WithInt64Callback(cback))
+	require.NoError(t, err)
+	_, err = m.Int64ObservableCounter("b", metric.WithInt64Callback(cback))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	assert.ElementsMatch(t, []string{"a", "b"}, metricNames(rm))
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/otel/sdk/resource"
 )
@@ -16,6 +17,9 @@ type config struct {
 	res     *resource.Resource
 	readers []Reader
 	views   []View
+
+	callbackTimeout     time.Duration
+	callbackConcurrency int
 }
 
 // readerSignals returns a force-flush and shutdown function for a
@@ -135,3 +139,36 @@ func WithView(views ...View) Option {
 		return cfg
 	})
 }
+
+// WithCallbackTimeout sets the maximum duration a callback of an asynchronous
+// instrument is allowed to run during a collection.
+//
+// Each callback is passed a context with a deadline of timeout. If a callback
+// has not returned once its deadline is exceeded it is abandoned: the timeout
+// is reported to the global error handler (see otel.Handle), observations it
+// makes afterwards are dropped, and the collection continues with the results
+// of the other callbacks. An abandoned callback that is still running when
+// the next collection starts is skipped for that collection, and this is
+// reported to the global error handler.
+//
+// By default, if this option is not used or timeout is not positive,
+// callbacks are only bound by the context of the collection.
+func WithCallbackTimeout(timeout time.Duration) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.callbackTimeout = timeout
+		return cfg
+	})
+}
+
+// WithCallbackConcurrency sets the maximum number of callbacks of
+// asynchronous instruments run concurrently during a collection. Callbacks
+// run concurrently need to be safe to do so.
+//
+// By default, if this option is not used or n is less than 2, callbacks are
+// run serially.
+func WithCallbackConcurrency(n int) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.callbackConcurrency = n
+		return cfg
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -134,3 +135,13 @@ func TestWithView(t *testing.T) {
 	)})
 	assert.Len(t, c.views, 2)
 }
+
+func TestWithCallbackTimeout(t *testing.T) {
+	c := newConfig([]Option{WithCallbackTimeout(time.Second)})
+	assert.Equal(t, time.Second, c.callbackTimeout)
+}
+
+func TestWithCallbackConcurrency(t *testing.T) {
+	c := newConfig([]Option{WithCallbackConcurrency(4)})
+	assert.Equal(t, 4, c.callbackConcurrency)
+}
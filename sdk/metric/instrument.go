@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync/atomic"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
@@ -172,20 +173,12 @@ func (i instID) normalize() instID {
 
 type int64Inst struct {
 	measures []aggregate.Measure[int64]
-
-	embedded.Int64Counter
-	embedded.Int64UpDownCounter
-	embedded.Int64Histogram
-	embedded.Int64Gauge
+	binds    []aggregate.Bind[int64]
+	// stopped reports if the MeterProvider that created the instrument has
+	// been shut down.
+	stopped *atomic.Bool
 }
 
-var (
-	_ metric.Int64Counter       = (*int64Inst)(nil)
-	_ metric.Int64UpDownCounter = (*int64Inst)(nil)
-	_ metric.Int64Histogram     = (*int64Inst)(nil)
-	_ metric.Int64Gauge         = (*int64Inst)(nil)
-)
-
 func (i *int64Inst) Add(ctx context.Context, val int64, opts ...metric.AddOption) {
 	c := metric.NewAddConfig(opts)
 	i.aggregate(ctx, val, c.Attributes())
@@ -202,22 +195,106 @@ func (i *int64Inst) aggregate(ctx context.Context, val int64, s attribute.Set) {
 	}
 }
 
-type float64Inst struct {
-	measures []aggregate.Measure[float64]
+// bind returns an int64Bound that records measurements for attrs with the
+// aggregate functions of i.
+func (i *int64Inst) bind(attrs attribute.Set) *int64Bound {
+	measures := make([]aggregate.BoundMeasure[int64], len(i.binds))
+	for n, b := range i.binds {
+		measures[n] = b(attrs)
+	}
+	return &int64Bound{measures: measures, stopped: i.stopped}
+}
 
-	embedded.Float64Counter
-	embedded.Float64UpDownCounter
-	embedded.Float64Histogram
-	embedded.Float64Gauge
+type int64Counter struct {
+	embedded.Int64Counter
+	*int64Inst
+}
+
+var _ metric.Int64Counter = int64Counter{}
+
+func (i int64Counter) Bind(attrs attribute.Set) metric.BoundInt64Counter {
+	return i.bind(attrs)
+}
+
+type int64UpDownCounter struct {
+	embedded.Int64UpDownCounter
+	*int64Inst
+}
+
+var _ metric.Int64UpDownCounter = int64UpDownCounter{}
+
+func (i int64UpDownCounter) Bind(attrs attribute.Set) metric.BoundInt64UpDownCounter {
+	return i.bind(attrs)
+}
+
+type int64Histogram struct {
+	embedded.Int64Histogram
+	*int64Inst
+}
+
+var _ metric.Int64Histogram = int64Histogram{}
+
+func (i int64Histogram) Bind(attrs attribute.Set) metric.BoundInt64Histogram {
+	return i.bind(attrs)
+}
+
+type int64Gauge struct {
+	embedded.Int64Gauge
+	*int64Inst
+}
+
+var _ metric.Int64Gauge = int64Gauge{}
+
+func (i int64Gauge) Bind(attrs attribute.Set) metric.BoundInt64Gauge {
+	return i.bind(attrs)
+}
+
+// int64Bound records measurements for a fixed attribute set. The attribute
+// set is resolved by the aggregate functions once when the binding is made
+// instead of for every measurement.
+type int64Bound struct {
+	measures []aggregate.BoundMeasure[int64]
+	stopped  *atomic.Bool
+
+	embedded.BoundInt64Counter
+	embedded.BoundInt64UpDownCounter
+	embedded.BoundInt64Histogram
+	embedded.BoundInt64Gauge
 }
 
 var (
-	_ metric.Float64Counter       = (*float64Inst)(nil)
-	_ metric.Float64UpDownCounter = (*float64Inst)(nil)
-	_ metric.Float64Histogram     = (*float64Inst)(nil)
-	_ metric.Float64Gauge         = (*float64Inst)(nil)
+	_ metric.BoundInt64Counter       = (*int64Bound)(nil)
+	_ metric.BoundInt64UpDownCounter = (*int64Bound)(nil)
+	_ metric.BoundInt64Histogram     = (*int64Bound)(nil)
+	_ metric.BoundInt64Gauge         = (*int64Bound)(nil)
 )
 
+func (b *int64Bound) Add(ctx context.Context, val int64) {
+	b.record(ctx, val)
+}
+
+func (b *int64Bound) Record(ctx context.Context, val int64) {
+	b.record(ctx, val)
+}
+
+func (b *int64Bound) record(ctx context.Context, val int64) {
+	// Bindings are invalidated when the MeterProvider is shut down.
+	if b.stopped.Load() {
+		return
+	}
+	for _, m := range b.measures {
+		m(ctx, val)
+	}
+}
+
+type float64Inst struct {
+	measures []aggregate.Measure[float64]
+	binds    []aggregate.Bind[float64]
+	// stopped reports if the MeterProvider that created the instrument has
+	// been shut down.
+	stopped *atomic.Bool
+}
+
 func (i *float64Inst) Add(ctx context.Context, val float64, opts ...metric.AddOption) {
 	c := metric.NewAddConfig(opts)
 	i.aggregate(ctx, val, c.Attributes())
@@ -234,6 +311,98 @@ func (i *float64Inst) aggregate(ctx context.Context, val float64, s attribute.Se
 	}
 }
 
+// bind returns a float64Bound that records measurements for attrs with the
+// aggregate functions of i.
+func (i *float64Inst) bind(attrs attribute.Set) *float64Bound {
+	measures := make([]aggregate.BoundMeasure[float64], len(i.binds))
+	for n, b := range i.binds {
+		measures[n] = b(attrs)
+	}
+	return &float64Bound{measures: measures, stopped: i.stopped}
+}
+
+type float64Counter struct {
+	embedded.Float64Counter
+	*float64Inst
+}
+
+var _ metric.Float64Counter = float64Counter{}
+
+func (i float64Counter) Bind(attrs attribute.Set) metric.BoundFloat64Counter {
+	return i.bind(attrs)
+}
+
+type float64UpDownCounter struct {
+	embedded.Float64UpDownCounter
+	*float64Inst
+}
+
+var _ metric.Float64UpDownCounter = float64UpDownCounter{}
+
+func (i float64UpDownCounter) Bind(attrs attribute.Set) metric.BoundFloat64UpDownCounter {
+	return i.bind(attrs)
+}
+
+type float64Histogram struct {
+	embedded.Float64Histogram
+	*float64Inst
+}
+
+var _ metric.Float64Histogram = float64Histogram{}
+
+func (i float64Histogram) Bind(attrs attribute.Set) metric.BoundFloat64Histogram {
+	return i.bind(attrs)
+}
+
+type float64Gauge struct {
+	embedded.Float64Gauge
+	*float64Inst
+}
+
+var _ metric.Float64Gauge = float64Gauge{}
+
+func (i float64Gauge) Bind(attrs attribute.Set) metric.BoundFloat64Gauge {
+	return i.bind(attrs)
+}
+
+// float64Bound records measurements for a fixed attribute set. The attribute
+// set is resolved by the aggregate functions once when the binding is made
+// instead of for every measurement.
+type float64Bound struct {
+	measures []aggregate.BoundMeasure[float64]
+	stopped  *atomic.Bool
+
+	embedded.BoundFloat64Counter
+	embedded.BoundFloat64UpDownCounter
+	embedded.BoundFloat64Histogram
+	embedded.BoundFloat64Gauge
+}
+
+var (
+	_ metric.BoundFloat64Counter       = (*float64Bound)(nil)
+	_ metric.BoundFloat64UpDownCounter = (*float64Bound)(nil)
+	_ metric.BoundFloat64Histogram     = (*float64Bound)(nil)
+	_ metric.BoundFloat64Gauge         = (*float64Bound)(nil)
+)
+
+func (b *float64Bound) Add(ctx context.Context, val float64) {
+	b.record(ctx, val)
+}
+
+func (b *float64Bound) Record(ctx context.Context, val float64) {
+	b.record(ctx, val)
+}
+
+func (b *float64Bound) record(ctx context.Context, val float64) {
+	// Bindings are invalidated when the MeterProvider is shut down.
+	if b.stopped.Load() {
+		return
+	}
+	for _, m := range b.measures {
+		m(ctx, val)
+	}
+}
+
 // observablID is a comparable unique identifier of an observable.
 type observablID[N int64 | float64] struct {
 	name        string
@@ -5,8 +5,11 @@ package metric
 
 import (
 	"context"
+	"sync/atomic"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
@@ -26,19 +29,19 @@ func BenchmarkInstrument(b *testing.B) {
 		var meas []aggregate.Measure[int64]
 
 		build.Temporality = metricdata.CumulativeTemporality
-		in, _ := build.LastValue()
+		in, _, _ := build.LastValue()
 		meas = append(meas, in)
 
 		build.Temporality = metricdata.DeltaTemporality
-		in, _ = build.LastValue()
+		in, _, _ = build.LastValue()
 		meas = append(meas, in)
 
 		build.Temporality = metricdata.CumulativeTemporality
-		in, _ = build.Sum(true)
+		in, _, _ = build.Sum(true)
 		meas = append(meas, in)
 
 		build.Temporality = metricdata.DeltaTemporality
-		in, _ = build.Sum(true)
+		in, _, _ = build.Sum(true)
 		meas = append(meas, in)
 
 		inst := int64Inst{measures: meas}
@@ -55,15 +58,15 @@ func BenchmarkInstrument(b *testing.B) {
 		build := aggregate.Builder[int64]{}
 		var meas []aggregate.Measure[int64]
 
-		in, _ := build.PrecomputedLastValue()
+		in, _, _ := build.PrecomputedLastValue()
 		meas = append(meas, in)
 
 		build.Temporality = metricdata.CumulativeTemporality
-		in, _ = build.Sum(true)
+		in, _, _ = build.Sum(true)
 		meas = append(meas, in)
 
 		build.Temporality = metricdata.DeltaTemporality
-		in, _ = build.Sum(true)
+		in, _, _ = build.Sum(true)
 		meas = append(meas, in)
 
 		o := observable[int64]{measures: meas}
@@ -75,3 +78,28 @@ func BenchmarkInstrument(b *testing.B) {
 		}
 	})
 }
+
+func TestBoundInstrumentStopped(t *testing.T) {
+	ctx := context.Background()
+
+	var n int
+	stopped := new(atomic.Bool)
+	inst := &int64Inst{
+		binds: []aggregate.Bind[int64]{
+			func(attribute.Set) aggregate.BoundMeasure[int64] {
+				return func(context.Context, int64) { n++ }
+			},
+		},
+		stopped: stopped,
+	}
+	b := inst.bind(attribute.NewSet(attribute.String("user", "Alice")))
+
+	b.Add(ctx, 1)
+	b.Record(ctx, 1)
+	assert.Equal(t, 2, n, "measurements not recorded")
+
+	stopped.Store(true)
+	b.Add(ctx, 1)
+	b.Record(ctx, 1)
+	assert.Equal(t, 2, n, "measurements recorded after shutdown")
+}
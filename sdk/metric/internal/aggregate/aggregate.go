@@ -19,11 +19,23 @@ var now = time.Now
 // Measure receives measurements to be aggregated.
 type Measure[N int64 | float64] func(context.Context, N, attribute.Set)
 
+// BoundMeasure receives measurements for a bound attribute set to be
+// aggregated.
+type BoundMeasure[N int64 | float64] func(context.Context, N)
+
+// Bind returns a BoundMeasure that aggregates measurements for an attribute
+// set. The attribute set is resolved once when it is bound instead of for
+// each measurement made.
+type Bind[N int64 | float64] func(attribute.Set) BoundMeasure[N]
+
 // ComputeAggregation stores the aggregate of measurements into dest and
 // returns the number of aggregate data-points output.
 type ComputeAggregation func(dest *metricdata.Aggregation) int
 
 // Builder builds an aggregate function.
+//
+// Aggregate functions are returned as an input Measure, a Bind to create inputs
+// for a fixed attribute set, and an output ComputeAggregation.
 type Builder[N int64 | float64] struct {
 	// Temporality is the temporality used for the returned aggregate function.
 	//
@@ -73,74 +85,89 @@ func (b Builder[N]) filter(f fltrMeasure[N]) Measure[N] {
 	}
 }
 
+type fltrBind[N int64 | float64] func(fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) BoundMeasure[N]
+
+func (b Builder[N]) bind(f fltrBind[N]) Bind[N] {
+	if b.Filter != nil {
+		fltr := b.Filter // Copy to make it immutable after assignment.
+		return func(a attribute.Set) BoundMeasure[N] {
+			fAttr, dropped := a.Filter(fltr)
+			return f(fAttr, dropped)
+		}
+	}
+	return func(a attribute.Set) BoundMeasure[N] {
+		return f(a, nil)
+	}
+}
+
 // LastValue returns a last-value aggregate function input and output.
-func (b Builder[N]) LastValue() (Measure[N], ComputeAggregation) {
+func (b Builder[N]) LastValue() (Measure[N], Bind[N], ComputeAggregation) {
 	lv := newLastValue[N](b.AggregationLimit, b.resFunc())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		return b.filter(lv.measure), lv.delta
+		return b.filter(lv.measure), b.bind(lv.bind), lv.delta
 	default:
-		return b.filter(lv.measure), lv.cumulative
+		return b.filter(lv.measure), b.bind(lv.bind), lv.cumulative
 	}
 }
 
 // PrecomputedLastValue returns a last-value aggregate function input and
 // output. The aggregation returned from the returned ComputeAggregation
 // function will always only return values from the previous collection cycle.
-func (b Builder[N]) PrecomputedLastValue() (Measure[N], ComputeAggregation) {
+func (b Builder[N]) PrecomputedLastValue() (Measure[N], Bind[N], ComputeAggregation) {
 	lv := newPrecomputedLastValue[N](b.AggregationLimit, b.resFunc())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		return b.filter(lv.measure), lv.delta
+		return b.filter(lv.measure), b.bind(lv.bind), lv.delta
 	default:
-		return b.filter(lv.measure), lv.cumulative
+		return b.filter(lv.measure), b.bind(lv.bind), lv.cumulative
 	}
 }
 
 // PrecomputedSum returns a sum aggregate function input and output. The
 // arguments passed to the input are expected to be the precomputed sum values.
-func (b Builder[N]) PrecomputedSum(monotonic bool) (Measure[N], ComputeAggregation) {
+func (b Builder[N]) PrecomputedSum(monotonic bool) (Measure[N], Bind[N], ComputeAggregation) {
 	s := newPrecomputedSum[N](monotonic, b.AggregationLimit, b.resFunc())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		return b.filter(s.measure), s.delta
+		return b.filter(s.measure), b.bind(s.bind), s.delta
 	default:
-		return b.filter(s.measure), s.cumulative
+		return b.filter(s.measure), b.bind(s.bind), s.cumulative
 	}
 }
 
 // Sum returns a sum aggregate function input and output.
-func (b Builder[N]) Sum(monotonic bool) (Measure[N], ComputeAggregation) {
+func (b Builder[N]) Sum(monotonic bool) (Measure[N], Bind[N], ComputeAggregation) {
 	s := newSum[N](monotonic, b.AggregationLimit, b.resFunc())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		return b.filter(s.measure), s.delta
+		return b.filter(s.measure), b.bind(s.bind), s.delta
 	default:
-		return b.filter(s.measure), s.cumulative
+		return b.filter(s.measure), b.bind(s.bind), s.cumulative
 	}
 }
 
 // ExplicitBucketHistogram returns a histogram aggregate function input and
 // output.
-func (b Builder[N]) ExplicitBucketHistogram(boundaries []float64, noMinMax, noSum bool) (Measure[N], ComputeAggregation) {
+func (b Builder[N]) ExplicitBucketHistogram(boundaries []float64, noMinMax, noSum bool) (Measure[N], Bind[N], ComputeAggregation) {
 	h := newHistogram[N](boundaries, noMinMax, noSum, b.AggregationLimit, b.resFunc())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		return b.filter(h.measure), h.delta
+		return b.filter(h.measure), b.bind(h.bind), h.delta
 	default:
-		return b.filter(h.measure), h.cumulative
+		return b.filter(h.measure), b.bind(h.bind), h.cumulative
 	}
 }
 
 // ExponentialBucketHistogram returns a histogram aggregate function input and
 // output.
-func (b Builder[N]) ExponentialBucketHistogram(maxSize, maxScale int32, noMinMax, noSum bool) (Measure[N], ComputeAggregation) {
+func (b Builder[N]) ExponentialBucketHistogram(maxSize, maxScale int32, noMinMax, noSum bool) (Measure[N], Bind[N], ComputeAggregation) {
 	h := newExponentialHistogram[N](maxSize, maxScale, noMinMax, noSum, b.AggregationLimit, b.resFunc())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		return b.filter(h.measure), h.delta
+		return b.filter(h.measure), b.bind(h.bind), h.delta
 	default:
-		return b.filter(h.measure), h.cumulative
+		return b.filter(h.measure), b.bind(h.bind), h.cumulative
 	}
 }
 
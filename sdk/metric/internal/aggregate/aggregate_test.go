@@ -105,6 +105,75 @@ func testBuilderFilter[N int64 | float64]() func(t *testing.T) {
 	}
 }
 
+func TestBuilderBind(t *testing.T) {
+	t.Run("Int64", testBuilderBind[int64]())
+	t.Run("Float64", testBuilderBind[float64]())
+}
+
+func testBuilderBind[N int64 | float64]() func(t *testing.T) {
+	return func(t *testing.T) {
+		t.Helper()
+
+		type factory func(Builder[N]) (Measure[N], Bind[N], ComputeAggregation)
+		aggs := map[string]factory{
+			"Sum": func(b Builder[N]) (Measure[N], Bind[N], ComputeAggregation) {
+				return b.Sum(true)
+			},
+			"PrecomputedSum": func(b Builder[N]) (Measure[N], Bind[N], ComputeAggregation) {
+				return b.PrecomputedSum(true)
+			},
+			"LastValue": func(b Builder[N]) (Measure[N], Bind[N], ComputeAggregation) {
+				return b.LastValue()
+			},
+			"PrecomputedLastValue": func(b Builder[N]) (Measure[N], Bind[N], ComputeAggregation) {
+				return b.PrecomputedLastValue()
+			},
+			"ExplicitBucketHistogram": func(b Builder[N]) (Measure[N], Bind[N], ComputeAggregation) {
+				return b.ExplicitBucketHistogram([]float64{0, 5, 10}, false, false)
+			},
+			"ExponentialBucketHistogram": func(b Builder[N]) (Measure[N], Bind[N], ComputeAggregation) {
+				return b.ExponentialBucketHistogram(4, 20, false, false)
+			},
+		}
+		builders := map[string]Builder[N]{
+			"Delta":      {Temporality: metricdata.DeltaTemporality, ReservoirFunc: dropExemplars[N]},
+			"Cumulative": {Temporality: metricdata.CumulativeTemporality, ReservoirFunc: dropExemplars[N]},
+			"Filter":     {Temporality: metricdata.DeltaTemporality, ReservoirFunc: dropExemplars[N], Filter: attrFltr},
+			"Limit":      {Temporality: metricdata.CumulativeTemporality, ReservoirFunc: dropExemplars[N], AggregationLimit: 3},
+		}
+
+		ctx := context.Background()
+		attrs := []attribute.Set{alice, bob, carol, dave}
+		for aggName, f := range aggs {
+			for bName, b := range builders {
+				t.Run(aggName+"/"+bName, func(t *testing.T) {
+					meas, _, wantComp := f(b)
+					_, bind, gotComp := f(b)
+
+					bound := make([]BoundMeasure[N], len(attrs))
+					for i, a := range attrs {
+						bound[i] = bind(a)
+					}
+
+					var want, got metricdata.Aggregation
+					for cycle := 0; cycle < 3; cycle++ {
+						// Do not measure all attributes every cycle so bound
+						// measures need to handle their values being dropped.
+						for i := cycle % 2; i < len(attrs); i++ {
+							v := N(i + cycle + 1)
+							meas(ctx, v, attrs[i])
+							bound[i](ctx, v)
+						}
+
+						assert.Equal(t, wantComp(&want), gotComp(&got), "data size")
+						metricdatatest.AssertAggregationsEqual(t, want, got, metricdatatest.IgnoreTimestamp())
+					}
+				})
+			}
+		}
+	}
+}
+
 type arg[N int64 | float64] struct {
 	ctx context.Context
 
@@ -139,7 +208,7 @@ func test[N int64 | float64](meas Measure[N], comp ComputeAggregation, steps []t
 	}
 }
 
-func benchmarkAggregate[N int64 | float64](factory func() (Measure[N], ComputeAggregation)) func(*testing.B) {
+func benchmarkAggregate[N int64 | float64](factory func() (Measure[N], Bind[N], ComputeAggregation)) func(*testing.B) {
 	counts := []int{1, 10, 100}
 	return func(b *testing.B) {
 		for _, n := range counts {
@@ -152,7 +221,7 @@ func benchmarkAggregate[N int64 | float64](factory func() (Measure[N], ComputeAg
 
 var bmarkRes metricdata.Aggregation
 
-func benchmarkAggregateN[N int64 | float64](b *testing.B, factory func() (Measure[N], ComputeAggregation), count int) {
+func benchmarkAggregateN[N int64 | float64](b *testing.B, factory func() (Measure[N], Bind[N], ComputeAggregation), count int) {
 	ctx := context.Background()
 	attrs := make([]attribute.Set, count)
 	for i := range attrs {
@@ -161,7 +230,7 @@ func benchmarkAggregateN[N int64 | float64](b *testing.B, factory func() (Measur
 
 	b.Run("Measure", func(b *testing.B) {
 		got := &bmarkRes
-		meas, comp := factory()
+		meas, _, comp := factory()
 		b.ReportAllocs()
 		b.ResetTimer()
 
@@ -177,7 +246,7 @@ func benchmarkAggregateN[N int64 | float64](b *testing.B, factory func() (Measur
 	b.Run("ComputeAggregation", func(b *testing.B) {
 		comps := make([]ComputeAggregation, b.N)
 		for n := range comps {
-			meas, comp := factory()
+			meas, _, comp := factory()
 			for _, attr := range attrs {
 				meas(ctx, 1, attr)
 			}
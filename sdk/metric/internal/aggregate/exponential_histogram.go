@@ -309,10 +309,27 @@ type expoHistogram[N int64 | float64] struct {
 	limit    limiter[*expoHistogramDataPoint[N]]
 	values   map[attribute.Distinct]*expoHistogramDataPoint[N]
 	valuesMu sync.Mutex
+	// gen is incremented every time values is cleared. Bound measures use it
+	// to detect when the data point they reference is no longer in values.
+	gen uint64
 
 	start time.Time
 }
 
+// lookup returns the data point for fltrAttr, adding it to e if it does not
+// exist. The valuesMu lock of e needs to be held when calling this method.
+func (e *expoHistogram[N]) lookup(fltrAttr attribute.Set) *expoHistogramDataPoint[N] {
+	attr := e.limit.Attributes(fltrAttr, e.values)
+	v, ok := e.values[attr.Equivalent()]
+	if !ok {
+		v = newExpoHistogramDataPoint[N](attr, e.maxSize, e.maxScale, e.noMinMax, e.noSum)
+		v.res = e.newRes()
+
+		e.values[attr.Equivalent()] = v
+	}
+	return v
+}
+
 func (e *expoHistogram[N]) measure(ctx context.Context, value N, fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) {
 	// Ignore NaN and infinity.
 	if math.IsInf(float64(value), 0) || math.IsNaN(float64(value)) {
@@ -322,18 +339,33 @@ func (e *expoHistogram[N]) measure(ctx context.Context, value N, fltrAttr attrib
 	e.valuesMu.Lock()
 	defer e.valuesMu.Unlock()
 
-	attr := e.limit.Attributes(fltrAttr, e.values)
-	v, ok := e.values[attr.Equivalent()]
-	if !ok {
-		v = newExpoHistogramDataPoint[N](attr, e.maxSize, e.maxScale, e.noMinMax, e.noSum)
-		v.res = e.newRes()
-
-		e.values[attr.Equivalent()] = v
-	}
+	v := e.lookup(fltrAttr)
 	v.record(value)
 	v.res.Offer(ctx, value, droppedAttr)
 }
 
+func (e *expoHistogram[N]) bind(fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) BoundMeasure[N] {
+	var (
+		v   *expoHistogramDataPoint[N]
+		gen uint64
+	)
+	return func(ctx context.Context, value N) {
+		// Ignore NaN and infinity.
+		if math.IsInf(float64(value), 0) || math.IsNaN(float64(value)) {
+			return
+		}
+
+		e.valuesMu.Lock()
+		defer e.valuesMu.Unlock()
+
+		if v == nil || gen != e.gen {
+			v, gen = e.lookup(fltrAttr), e.gen
+		}
+		v.record(value)
+		v.res.Offer(ctx, value, droppedAttr)
+	}
+}
+
 func (e *expoHistogram[N]) delta(dest *metricdata.Aggregation) int {
 	t := now()
 
@@ -380,6 +412,7 @@ func (e *expoHistogram[N]) delta(dest *metricdata.Aggregation) int {
 	}
 	// Unused attribute sets do not report.
 	clear(e.values)
+	e.gen++
 
 	e.start = t
 	h.DataPoints = hDPts
@@ -7,7 +7,6 @@ import (
 	"context"
 	"slices"
 	"sort"
-	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -42,16 +41,35 @@ func (b *buckets[N]) bin(idx int, value N) {
 	}
 }
 
+// merge returns the buckets in group combined. If group holds a single
+// buckets, it is returned. Otherwise, new buckets are returned and the
+// buckets in group are not modified.
+func merge[N int64 | float64](group []*buckets[N]) *buckets[N] {
+	if len(group) == 1 {
+		return group[0]
+	}
+
+	m := *group[0]
+	m.counts = slices.Clone(m.counts)
+	for _, b := range group[1:] {
+		for i, c := range b.counts {
+			m.counts[i] += c
+		}
+		m.count += b.count
+		m.total += b.total
+		m.min = min(m.min, b.min)
+		m.max = max(m.max, b.max)
+	}
+	return &m
+}
+
 // histValues summarizes a set of measurements as an histValues with
 // explicitly defined buckets.
 type histValues[N int64 | float64] struct {
+	*stripes[N, buckets[N]]
+
 	noSum  bool
 	bounds []float64
-
-	newRes   func() exemplar.FilteredReservoir[N]
-	limit    limiter[*buckets[N]]
-	values   map[attribute.Distinct]*buckets[N]
-	valuesMu sync.Mutex
 }
 
 func newHistValues[N int64 | float64](bounds []float64, noSum bool, limit int, r func() exemplar.FilteredReservoir[N]) *histValues[N] {
@@ -61,15 +79,38 @@ func newHistValues[N int64 | float64](bounds []float64, noSum bool, limit int, r
 	// complete control over the fix.
 	b := slices.Clone(bounds)
 	slices.Sort(b)
+	newValue := func(attr attribute.Set, res exemplar.FilteredReservoir[N]) *buckets[N] {
+		// N+1 buckets. For example:
+		//
+		//   bounds = [0, 5, 10]
+		//
+		// Then,
+		//
+		//   buckets = (-∞, 0], (0, 5.0], (5.0, 10.0], (10.0, +∞)
+		v := newBuckets[N](attr, len(b)+1)
+		v.res = res
+		return v
+	}
 	return &histValues[N]{
-		noSum:  noSum,
-		bounds: b,
-		newRes: r,
-		limit:  newLimiter[*buckets[N]](limit),
-		values: make(map[attribute.Distinct]*buckets[N]),
+		stripes: newStripes(stripeCount(), limit, r, newValue),
+		noSum:   noSum,
+		bounds:  b,
 	}
 }
 
+// record records value in the bucket b at index idx.
+func (s *histValues[N]) record(ctx context.Context, b *buckets[N], idx int, value N, droppedAttr []attribute.KeyValue) {
+	if b.count == 0 {
+		// Ensure min and max are recorded values (not zero), for new buckets.
+		b.min, b.max = value, value
+	}
+	b.bin(idx, value)
+	if !s.noSum {
+		b.sum(value)
+	}
+	b.res.Offer(ctx, value, droppedAttr)
+}
+
 // Aggregate records the measurement value, scoped by attr, and aggregates it
 // into a histogram.
 func (s *histValues[N]) measure(ctx context.Context, value N, fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) {
@@ -80,31 +121,24 @@ func (s *histValues[N]) measure(ctx context.Context, value N, fltrAttr attribute
 	// (s.bounds[len(s.bounds)-1], +∞).
 	idx := sort.SearchFloat64s(s.bounds, float64(value))
 
-	s.valuesMu.Lock()
-	defer s.valuesMu.Unlock()
+	i := s.pick(fltrAttr)
+	s.parts[i].Lock()
+	defer s.parts[i].Unlock()
 
-	attr := s.limit.Attributes(fltrAttr, s.values)
-	b, ok := s.values[attr.Equivalent()]
-	if !ok {
-		// N+1 buckets. For example:
-		//
-		//   bounds = [0, 5, 10]
-		//
-		// Then,
-		//
-		//   buckets = (-∞, 0], (0, 5.0], (5.0, 10.0], (10.0, +∞)
-		b = newBuckets[N](attr, len(s.bounds)+1)
-		b.res = s.newRes()
+	s.record(ctx, s.lookup(i, fltrAttr), idx, value, droppedAttr)
+}
 
-		// Ensure min and max are recorded values (not zero), for new buckets.
-		b.min, b.max = value, value
-		s.values[attr.Equivalent()] = b
-	}
-	b.bin(idx, value)
-	if !s.noSum {
-		b.sum(value)
+func (s *histValues[N]) bind(fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) BoundMeasure[N] {
+	b := s.stripes.bind(fltrAttr)
+	return func(ctx context.Context, value N) {
+		idx := sort.SearchFloat64s(s.bounds, float64(value))
+
+		i := b.pick()
+		s.parts[i].Lock()
+		defer s.parts[i].Unlock()
+
+		s.record(ctx, b.value(i), idx, value, droppedAttr)
 	}
-	b.res.Offer(ctx, value, droppedAttr)
 }
 
 // newHistogram returns an Aggregator that summarizes a set of measurements as
@@ -134,17 +168,19 @@ func (s *histogram[N]) delta(dest *metricdata.Aggregation) int {
 	h, _ := (*dest).(metricdata.Histogram[N])
 	h.Temporality = metricdata.DeltaTemporality
 
-	s.valuesMu.Lock()
-	defer s.valuesMu.Unlock()
+	s.lockAll()
+	defer s.unlockAll()
 
 	// Do not allow modification of our copy of bounds.
 	bounds := slices.Clone(s.bounds)
 
-	n := len(s.values)
+	groups := s.merged()
+	n := len(groups)
 	hDPts := reset(h.DataPoints, n, n)
 
 	var i int
-	for _, val := range s.values {
+	for _, g := range groups {
+		val := merge(g)
 		hDPts[i].Attributes = val.attrs
 		hDPts[i].StartTime = s.start
 		hDPts[i].Time = t
@@ -166,7 +202,7 @@ func (s *histogram[N]) delta(dest *metricdata.Aggregation) int {
 		i++
 	}
 	// Unused attribute sets do not report.
-	clear(s.values)
+	s.clear()
 	// The delta collection cycle resets.
 	s.start = t
 
@@ -184,17 +220,19 @@ func (s *histogram[N]) cumulative(dest *metricdata.Aggregation) int {
 	h, _ := (*dest).(metricdata.Histogram[N])
 	h.Temporality = metricdata.CumulativeTemporality
 
-	s.valuesMu.Lock()
-	defer s.valuesMu.Unlock()
+	s.lockAll()
+	defer s.unlockAll()
 
 	// Do not allow modification of our copy of bounds.
 	bounds := slices.Clone(s.bounds)
 
-	n := len(s.values)
+	groups := s.merged()
+	n := len(groups)
 	hDPts := reset(h.DataPoints, n, n)
 
 	var i int
-	for _, val := range s.values {
+	for _, g := range groups {
+		val := merge(g)
 		hDPts[i].Attributes = val.attrs
 		hDPts[i].StartTime = s.start
 		hDPts[i].Time = t
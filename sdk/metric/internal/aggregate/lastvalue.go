@@ -23,8 +23,8 @@ type datapoint[N int64 | float64] struct {
 func newLastValue[N int64 | float64](limit int, r func() exemplar.FilteredReservoir[N]) *lastValue[N] {
 	return &lastValue[N]{
 		newRes: r,
-		limit:  newLimiter[datapoint[N]](limit),
-		values: make(map[attribute.Distinct]datapoint[N]),
+		limit:  newLimiter[*datapoint[N]](limit),
+		values: make(map[attribute.Distinct]*datapoint[N]),
 		start:  now(),
 	}
 }
@@ -34,26 +34,50 @@ type lastValue[N int64 | float64] struct {
 	sync.Mutex
 
 	newRes func() exemplar.FilteredReservoir[N]
-	limit  limiter[datapoint[N]]
-	values map[attribute.Distinct]datapoint[N]
+	limit  limiter[*datapoint[N]]
+	values map[attribute.Distinct]*datapoint[N]
 	start  time.Time
+	// gen is incremented every time values is cleared. Bound measures use it
+	// to detect when the datapoint they reference is no longer in values.
+	gen uint64
 }
 
-func (s *lastValue[N]) measure(ctx context.Context, value N, fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) {
-	s.Lock()
-	defer s.Unlock()
-
+// lookup returns the datapoint for fltrAttr, adding it to s if it does not
+// exist. The lock of s needs to be held when calling this method.
+func (s *lastValue[N]) lookup(fltrAttr attribute.Set) *datapoint[N] {
 	attr := s.limit.Attributes(fltrAttr, s.values)
 	d, ok := s.values[attr.Equivalent()]
 	if !ok {
-		d.res = s.newRes()
+		d = &datapoint[N]{attrs: attr, res: s.newRes()}
+		s.values[attr.Equivalent()] = d
 	}
+	return d
+}
 
-	d.attrs = attr
+func (s *lastValue[N]) measure(ctx context.Context, value N, fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) {
+	s.Lock()
+	defer s.Unlock()
+
+	d := s.lookup(fltrAttr)
 	d.value = value
 	d.res.Offer(ctx, value, droppedAttr)
+}
 
-	s.values[attr.Equivalent()] = d
+func (s *lastValue[N]) bind(fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) BoundMeasure[N] {
+	var (
+		d   *datapoint[N]
+		gen uint64
+	)
+	return func(ctx context.Context, value N) {
+		s.Lock()
+		defer s.Unlock()
+
+		if d == nil || gen != s.gen {
+			d, gen = s.lookup(fltrAttr), s.gen
+		}
+		d.value = value
+		d.res.Offer(ctx, value, droppedAttr)
+	}
 }
 
 func (s *lastValue[N]) delta(dest *metricdata.Aggregation) int {
@@ -68,6 +92,7 @@ func (s *lastValue[N]) delta(dest *metricdata.Aggregation) int {
 	n := s.copyDpts(&gData.DataPoints, t)
 	// Do not report stale values.
 	clear(s.values)
+	s.gen++
 	// Update start time for delta temporality.
 	s.start = t
 
@@ -136,6 +161,7 @@ func (s *precomputedLastValue[N]) delta(dest *metricdata.Aggregation) int {
 	n := s.copyDpts(&gData.DataPoints, t)
 	// Do not report stale values.
 	clear(s.values)
+	s.gen++
 	// Update start time for delta temporality.
 	s.start = t
 
@@ -156,6 +182,7 @@ func (s *precomputedLastValue[N]) cumulative(dest *metricdata.Aggregation) int {
 	n := s.copyDpts(&gData.DataPoints, t)
 	// Do not report stale values.
 	clear(s.values)
+	s.gen++
 	*dest = gData
 
 	return n
@@ -0,0 +1,313 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregate // import "go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+
+import (
+	"math"
+	"math/rand"
+	"runtime"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/internal/exemplar"
+)
+
+// maxStripes is the maximum number of stripes synchronous measurements are
+// spread across.
+const maxStripes = 64
+
+// setStripes is the maximum number of stripes the measurements of a single
+// attribute set are spread across. An attribute set is stored once per stripe
+// it is measured in, this bounds the memory cost of striping on hosts with
+// many cores while concurrent measurements of the same set still do not all
+// contend on one lock.
+const setStripes = 4
+
+// stripeCount returns the number of stripes to spread synchronous
+// measurements across. It is GOMAXPROCS rounded up to a power of two, capped
+// at maxStripes.
+func stripeCount() int {
+	procs := runtime.GOMAXPROCS(0)
+	n := 1
+	for n < procs && n < maxStripes {
+		n <<= 1
+	}
+	return n
+}
+
+// stripe is a partition of the values of an aggregation. Each stripe is
+// guarded by its own lock so concurrent measurements made in different
+// stripes do not contend.
+type stripe[T any] struct {
+	sync.Mutex
+	values map[attribute.Distinct]*T
+	// gen is incremented every time values is cleared. Bound measures use it
+	// to detect when the value they reference is no longer in values.
+	gen uint64
+
+	// Pad stripes so adjacent ones do not share a cache line.
+	_ [64]byte
+}
+
+// stripes is the storage of values for an aggregation. Measurements are
+// spread across stripes and merged when collected. The measurements of an
+// attribute set are only spread across the stripes selected by its hash (see
+// pickHash), so it is stored in at most setStripes of them.
+//
+// The cardinality limit and exemplar reservoir of an attribute set are
+// shared by all stripes so they are applied the same as if values were
+// stored in a single map.
+type stripes[N int64 | float64, T any] struct {
+	newRes   func() exemplar.FilteredReservoir[N]
+	newValue func(attribute.Set, exemplar.FilteredReservoir[N]) *T
+	aggLimit int
+	parts    []stripe[T]
+
+	// resMu guards res.
+	resMu sync.Mutex
+	// res holds the reservoir of every attribute set stored in any stripe.
+	// It is only used when there is more than one stripe.
+	res map[attribute.Distinct]exemplar.FilteredReservoir[N]
+
+	// index and groups are reused to merge stripes during collection. They
+	// are guarded by the locks of all stripes.
+	index  map[attribute.Distinct]int
+	groups [][]*T
+}
+
+// newStripes returns stripes with n partitions. New values are created with
+// newValue, passing the attribute set and the reservoir for the value.
+func newStripes[N int64 | float64, T any](n, limit int, r func() exemplar.FilteredReservoir[N], newValue func(attribute.Set, exemplar.FilteredReservoir[N]) *T) *stripes[N, T] {
+	s := &stripes[N, T]{
+		newRes:   r,
+		newValue: newValue,
+		aggLimit: limit,
+		parts:    make([]stripe[T], n),
+		index:    make(map[attribute.Distinct]int),
+	}
+	for i := range s.parts {
+		s.parts[i].values = make(map[attribute.Distinct]*T)
+	}
+	if n > 1 {
+		s.res = make(map[attribute.Distinct]exemplar.FilteredReservoir[N])
+	}
+	return s
+}
+
+// pick returns the index of the stripe a measurement of fltrAttr is to be
+// made in.
+func (s *stripes[N, T]) pick(fltrAttr attribute.Set) int {
+	switch n := len(s.parts); {
+	case n == 1:
+		return 0
+	case n <= setStripes:
+		// Measurements of any attribute set are spread across all stripes,
+		// fltrAttr does not need to be hashed.
+		return s.pickHash(0)
+	}
+	return s.pickHash(hashSet(fltrAttr))
+}
+
+// pickHash returns the index of the stripe a measurement of the attribute set
+// with hash h is to be made in. The stripes of an attribute set are the
+// setStripes consecutive stripes starting at the one selected by h.
+// Measurements are spread randomly across them.
+func (s *stripes[N, T]) pickHash(h uint64) int {
+	n := uint64(len(s.parts))
+	// The top-level math/rand functions are lock-free when the global source
+	// has not been seeded.
+	off := uint64(rand.Uint32()) & (min(n, setStripes) - 1) //nolint:gosec // G404: Stripe selection does not need a strong random number generator.
+	return int((h + off) & (n - 1))
+}
+
+// FNV-1a parameters used by hashSet.
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// hashSet returns a hash of the attributes of set. The values of slice
+// attributes are not hashed.
+//
+// The hash is computed on every unbound measurement, it does not need to be
+// strong but it needs to be cheap. Its low bits are used to pick stripes.
+func hashSet(set attribute.Set) uint64 {
+	h := uint64(fnvOffset64)
+	for i, n := 0, set.Len(); i < n; i++ {
+		kv, _ := set.Get(i)
+		h = hashString(h, string(kv.Key))
+		h = hashUint64(h, uint64(kv.Value.Type()))
+		switch kv.Value.Type() {
+		case attribute.STRING:
+			h = hashString(h, kv.Value.AsString())
+		case attribute.BOOL:
+			if kv.Value.AsBool() {
+				h = hashUint64(h, 1)
+			} else {
+				h = hashUint64(h, 0)
+			}
+		case attribute.INT64:
+			h = hashUint64(h, uint64(kv.Value.AsInt64())) //nolint:gosec // G115: Only the bits are hashed.
+		case attribute.FLOAT64:
+			h = hashUint64(h, math.Float64bits(kv.Value.AsFloat64()))
+		}
+	}
+	// Mix the high bits into the low ones. The FNV multiplication only
+	// propagates changes to higher bits.
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	return h
+}
+
+func hashString(h uint64, s string) uint64 {
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= fnvPrime64
+	}
+	return h
+}
+
+func hashUint64(h, v uint64) uint64 {
+	h ^= v
+	h *= fnvPrime64
+	return h
+}
+
+// lookup returns the value for fltrAttr in the stripe at index i, adding it
+// if it does not exist. The lock of the stripe needs to be held when calling
+// this method.
+//
+// Adding a value to a stripe takes resMu. This happens once per stripe an
+// attribute set is stored in until the stripes are cleared.
+func (s *stripes[N, T]) lookup(i int, fltrAttr attribute.Set) *T {
+	st := &s.parts[i]
+	if v, ok := st.values[fltrAttr.Equivalent()]; ok {
+		return v
+	}
+
+	if len(s.parts) == 1 {
+		attr := newLimiter[*T](s.aggLimit).Attributes(fltrAttr, st.values)
+		v, ok := st.values[attr.Equivalent()]
+		if !ok {
+			v = s.newValue(attr, s.newRes())
+			st.values[attr.Equivalent()] = v
+		}
+		return v
+	}
+
+	s.resMu.Lock()
+	attr := newLimiter[exemplar.FilteredReservoir[N]](s.aggLimit).Attributes(fltrAttr, s.res)
+	res, ok := s.res[attr.Equivalent()]
+	if !ok {
+		res = s.newRes()
+		s.res[attr.Equivalent()] = res
+	}
+	s.resMu.Unlock()
+
+	v, ok := st.values[attr.Equivalent()]
+	if !ok {
+		v = s.newValue(attr, res)
+		st.values[attr.Equivalent()] = v
+	}
+	return v
+}
+
+// lockAll locks every stripe of s.
+func (s *stripes[N, T]) lockAll() {
+	for i := range s.parts {
+		s.parts[i].Lock()
+	}
+}
+
+// unlockAll unlocks every stripe of s.
+func (s *stripes[N, T]) unlockAll() {
+	for i := range s.parts {
+		s.parts[i].Unlock()
+	}
+}
+
+// merged returns the values of all stripes grouped by attribute set. The
+// returned groups are only valid until the next call to merged. The locks of
+// all stripes need to be held when calling this method.
+func (s *stripes[N, T]) merged() [][]*T {
+	clear(s.index)
+	for i := range s.groups {
+		clear(s.groups[i])
+		s.groups[i] = s.groups[i][:0]
+	}
+	s.groups = s.groups[:0]
+
+	for i := range s.parts {
+		for key, v := range s.parts[i].values {
+			g, ok := s.index[key]
+			if !ok {
+				g = len(s.groups)
+				s.index[key] = g
+				if g < cap(s.groups) {
+					s.groups = s.groups[:g+1]
+				} else {
+					s.groups = append(s.groups, nil)
+				}
+			}
+			s.groups[g] = append(s.groups[g], v)
+		}
+	}
+	return s.groups
+}
+
+// clear removes all values from s. The locks of all stripes need to be held
+// when calling this method.
+func (s *stripes[N, T]) clear() {
+	for i := range s.parts {
+		clear(s.parts[i].values)
+		s.parts[i].gen++
+	}
+	if s.res != nil {
+		s.resMu.Lock()
+		clear(s.res)
+		s.resMu.Unlock()
+	}
+}
+
+// binding caches the values of a fixed attribute set in each stripe.
+type binding[N int64 | float64, T any] struct {
+	s     *stripes[N, T]
+	attrs attribute.Set
+	hash  uint64
+	vals  []*T
+	gens  []uint64
+}
+
+// bind returns a binding of fltrAttr to s.
+func (s *stripes[N, T]) bind(fltrAttr attribute.Set) *binding[N, T] {
+	b := &binding[N, T]{
+		s:     s,
+		attrs: fltrAttr,
+		vals:  make([]*T, len(s.parts)),
+		gens:  make([]uint64, len(s.parts)),
+	}
+	if len(s.parts) > setStripes {
+		b.hash = hashSet(fltrAttr)
+	}
+	return b
+}
+
+// pick returns the index of the stripe a measurement of the bound attribute
+// set is to be made in.
+func (b *binding[N, T]) pick() int {
+	if len(b.s.parts) == 1 {
+		return 0
+	}
+	return b.s.pickHash(b.hash)
+}
+
+// value returns the value of the bound attribute set in the stripe at index
+// i. The lock of the stripe needs to be held when calling this method.
+func (b *binding[N, T]) value(i int) *T {
+	if gen := b.s.parts[i].gen; b.vals[i] == nil || b.gens[i] != gen {
+		b.vals[i], b.gens[i] = b.s.lookup(i, b.attrs), gen
+	}
+	return b.vals[i]
+}
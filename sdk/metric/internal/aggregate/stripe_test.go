@@ -0,0 +1,359 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregate // import "go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+func TestStripeCount(t *testing.T) {
+	orig := runtime.GOMAXPROCS(0)
+	t.Cleanup(func() { runtime.GOMAXPROCS(orig) })
+
+	for procs, want := range map[int]int{
+		1:   1,
+		2:   2,
+		3:   4,
+		8:   8,
+		9:   16,
+		200: maxStripes,
+	} {
+		runtime.GOMAXPROCS(procs)
+		assert.Equal(t, want, stripeCount(), "GOMAXPROCS=%d", procs)
+	}
+}
+
+func TestStripesMerged(t *testing.T) {
+	const n = 4
+	s := newValueMap[int64](n, 3, dropExemplars[int64])
+
+	// Measure alice and bob in every stripe. Carol and Dave exceed the
+	// cardinality limit, regardless of the stripe they are measured in.
+	for i := 0; i < n; i++ {
+		s.lookup(i, alice).n += 1
+		s.lookup(i, bob).n += 2
+		s.lookup(i, carol).n += 3
+		s.lookup(i, dave).n += 4
+	}
+
+	want := map[attribute.Distinct]int64{
+		alice.Equivalent():       n,
+		bob.Equivalent():         2 * n,
+		overflowSet.Equivalent(): 7 * n,
+	}
+	got := make(map[attribute.Distinct]int64)
+	for _, g := range s.merged() {
+		assert.Len(t, g, n, "values not stored in every stripe")
+		attrs, v := mergeSums(g)
+		got[attrs.Equivalent()] = v
+	}
+	assert.Equal(t, want, got)
+
+	s.clear()
+	assert.Empty(t, s.merged(), "values not cleared")
+	assert.Empty(t, s.res, "reservoirs not cleared")
+}
+
+func TestStripesBinding(t *testing.T) {
+	const n = 2
+	s := newValueMap[int64](n, 0, dropExemplars[int64])
+	b := s.stripes.bind(alice)
+
+	v := b.value(0)
+	v.n++
+	assert.Same(t, v, b.value(0), "bound value not cached")
+	assert.Same(t, v, s.lookup(0, alice), "bound value not stored")
+
+	s.clear()
+	assert.NotSame(t, v, b.value(0), "bound value not invalidated")
+	assert.Same(t, b.value(0), s.lookup(0, alice))
+	assert.Same(t, b.value(1), s.lookup(1, alice))
+}
+
+func TestStripesPick(t *testing.T) {
+	s := newValueMap[int64](maxStripes, 0, dropExemplars[int64])
+	used := make(map[int]struct{})
+	for _, attrs := range []attribute.Set{alice, bob, carol, dave} {
+		picked := make(map[int]struct{})
+		for i := 0; i < 1000; i++ {
+			picked[s.pick(attrs)] = struct{}{}
+		}
+		assert.LessOrEqual(t, len(picked), setStripes, "%s spread across too many stripes", attrs.Encoded(attribute.DefaultEncoder()))
+		for i := range picked {
+			used[i] = struct{}{}
+		}
+
+		b := s.stripes.bind(attrs)
+		for i := 0; i < 1000; i++ {
+			assert.Contains(t, picked, b.pick(), "bound stripes differ")
+		}
+	}
+	assert.Greater(t, len(used), setStripes, "attribute sets not spread across stripes")
+
+	one := newValueMap[int64](1, 0, dropExemplars[int64])
+	assert.Equal(t, 0, one.pick(alice))
+	assert.Equal(t, 0, one.stripes.bind(alice).pick())
+
+	// With no more stripes than setStripes, attribute sets are not hashed and
+	// spread across all stripes.
+	few := newValueMap[int64](setStripes, 0, dropExemplars[int64])
+	b := few.stripes.bind(alice)
+	assert.Zero(t, b.hash, "attribute set hashed")
+	picked := make(map[int]struct{})
+	for i := 0; i < 1000; i++ {
+		picked[few.pick(alice)] = struct{}{}
+		picked[b.pick()] = struct{}{}
+	}
+	assert.Len(t, picked, setStripes)
+}
+
+func TestHashSet(t *testing.T) {
+	sets := []attribute.Set{
+		*attribute.EmptySet(),
+		attribute.NewSet(attribute.String("k", "a")),
+		attribute.NewSet(attribute.String("k", "b")),
+		attribute.NewSet(attribute.String("l", "a")),
+		attribute.NewSet(attribute.Int("k", 1)),
+		attribute.NewSet(attribute.Int("k", 2)),
+		attribute.NewSet(attribute.Float64("k", 1)),
+		attribute.NewSet(attribute.Bool("k", true)),
+		attribute.NewSet(attribute.Bool("k", false)),
+		attribute.NewSet(attribute.String("k", "a"), attribute.Int("n", 1)),
+	}
+	hashes := make(map[uint64]int)
+	for i, set := range sets {
+		h := hashSet(set)
+		assert.Equal(t, h, hashSet(attribute.NewSet(set.ToSlice()...)), "hash of equal sets differs")
+		if j, ok := hashes[h]; ok {
+			t.Errorf("hash of set %d equal to set %d", i, j)
+		}
+		hashes[h] = i
+	}
+}
+
+func TestMergeBuckets(t *testing.T) {
+	a := newBuckets[int64](alice, 3)
+	a.counts = []uint64{1, 0, 1}
+	a.count, a.total, a.min, a.max = 2, 8, -2, 10
+
+	b := newBuckets[int64](alice, 3)
+	b.counts = []uint64{0, 2, 0}
+	b.count, b.total, b.min, b.max = 2, 6, 3, 3
+
+	assert.Same(t, a, merge([]*buckets[int64]{a}), "single buckets not returned")
+
+	got := merge([]*buckets[int64]{a, b})
+	assert.Equal(t, []uint64{1, 2, 1}, got.counts)
+	assert.Equal(t, uint64(4), got.count)
+	assert.Equal(t, int64(14), got.total)
+	assert.Equal(t, int64(-2), got.min)
+	assert.Equal(t, int64(10), got.max)
+	assert.Equal(t, []uint64{1, 0, 1}, a.counts, "merged buckets modified")
+}
+
+func TestStripedConcurrentSafe(t *testing.T) {
+	const (
+		goroutines = 8
+		n          = 1000
+	)
+
+	type factory func(Builder[int64]) (Measure[int64], Bind[int64], ComputeAggregation)
+	aggs := map[string]factory{
+		"Sum": func(b Builder[int64]) (Measure[int64], Bind[int64], ComputeAggregation) {
+			return b.Sum(true)
+		},
+		"ExplicitBucketHistogram": func(b Builder[int64]) (Measure[int64], Bind[int64], ComputeAggregation) {
+			return b.ExplicitBucketHistogram([]float64{0, 5, 10}, false, true)
+		},
+	}
+
+	for name, f := range aggs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			meas, bind, comp := f(Builder[int64]{Temporality: metricdata.CumulativeTemporality})
+			bound := bind(alice)
+
+			var wg sync.WaitGroup
+			for g := 0; g < goroutines; g++ {
+				wg.Add(2)
+				go func() {
+					defer wg.Done()
+					for i := 0; i < n; i++ {
+						meas(ctx, 1, alice)
+						bound(ctx, 1)
+					}
+				}()
+				go func() {
+					defer wg.Done()
+					var got metricdata.Aggregation
+					_ = comp(&got)
+				}()
+			}
+			wg.Wait()
+
+			var got metricdata.Aggregation
+			require.Equal(t, 1, comp(&got))
+
+			var total int64
+			switch agg := got.(type) {
+			case metricdata.Sum[int64]:
+				total = agg.DataPoints[0].Value
+			case metricdata.Histogram[int64]:
+				total = int64(agg.DataPoints[0].Count) //nolint:gosec // Count is small.
+			}
+			assert.Equal(t, int64(2*goroutines*n), total)
+		})
+	}
+}
+
+func TestStripedMatchesUnstriped(t *testing.T) {
+	ctx := context.Background()
+	attrs := []attribute.Set{alice, bob, carol, dave}
+	counts := []int{1, 4}
+
+	sums := make([]metricdata.Aggregation, len(counts))
+	hists := make([]metricdata.Aggregation, len(counts))
+	for i, n := range counts {
+		s := &sum[int64]{valueMap: newValueMap[int64](n, 3, dropExemplars[int64]), monotonic: true}
+		h := newHistogram[int64]([]float64{0, 5, 10}, false, false, 3, dropExemplars[int64])
+		h.stripes = newStripes(n, 3, dropExemplars[int64], h.stripes.newValue)
+		for j := 0; j < 100; j++ {
+			a := attrs[j%len(attrs)]
+			s.measure(ctx, int64(j), a, nil)
+			h.measure(ctx, int64(j%13), a, nil)
+		}
+		s.cumulative(&sums[i])
+		h.cumulative(&hists[i])
+	}
+
+	metricdatatest.AssertAggregationsEqual(t, sums[0], sums[1], metricdatatest.IgnoreTimestamp())
+	metricdatatest.AssertAggregationsEqual(t, hists[0], hists[1], metricdatatest.IgnoreTimestamp())
+}
+
+func BenchmarkStriped(b *testing.B) {
+	// Run with the -cpu flag (e.g. -cpu 1,2,4,8) to compare how striped and
+	// unstriped aggregations scale with GOMAXPROCS.
+	b.Run("Sum", benchmarkStriped(func(n int) (Measure[int64], Bind[int64]) {
+		s := &sum[int64]{valueMap: newValueMap[int64](n, 0, dropExemplars[int64])}
+		return Builder[int64]{}.filter(s.measure), Builder[int64]{}.bind(s.bind)
+	}))
+	b.Run("Histogram", benchmarkStriped(func(n int) (Measure[int64], Bind[int64]) {
+		h := newHistogram[int64](bounds, false, false, 0, dropExemplars[int64])
+		h.stripes = newStripes(n, 0, dropExemplars[int64], h.stripes.newValue)
+		return Builder[int64]{}.filter(h.measure), Builder[int64]{}.bind(h.bind)
+	}))
+}
+
+func benchmarkStriped(factory func(stripes int) (Measure[int64], Bind[int64])) func(*testing.B) {
+	return func(b *testing.B) {
+		ctx := context.Background()
+		// The aggregations are created in the leaf benchmarks, GOMAXPROCS is
+		// only set to the -cpu values when those are run.
+		run := func(stripes func() int) func(*testing.B) {
+			return func(b *testing.B) {
+				b.Run("Measure", func(b *testing.B) {
+					meas, _ := factory(stripes())
+					b.ReportAllocs()
+					b.RunParallel(func(pb *testing.PB) {
+						for pb.Next() {
+							meas(ctx, 1, alice)
+						}
+					})
+				})
+				b.Run("Bound", func(b *testing.B) {
+					_, bind := factory(stripes())
+					bound := bind(alice)
+					b.ReportAllocs()
+					b.RunParallel(func(pb *testing.PB) {
+						for pb.Next() {
+							bound(ctx, 1)
+						}
+					})
+				})
+			}
+		}
+		b.Run("Unstriped", run(func() int { return 1 }))
+		b.Run("Striped", run(stripeCount))
+	}
+}
+
+func BenchmarkStripedHighCardinality(b *testing.B) {
+	// Compare the allocations and memory of striped and unstriped
+	// aggregations measuring many distinct attribute sets concurrently. The
+	// allocated bytes, allocations, and stored values are reported per
+	// attribute set.
+	b.Run("Sum", benchmarkStripedHighCardinality(func(n int) (Measure[int64], func() int) {
+		s := &sum[int64]{valueMap: newValueMap[int64](n, 0, dropExemplars[int64])}
+		return Builder[int64]{}.filter(s.measure), func() int { return storedValues(s.parts) }
+	}))
+	b.Run("Histogram", benchmarkStripedHighCardinality(func(n int) (Measure[int64], func() int) {
+		h := newHistogram[int64](bounds, false, false, 0, dropExemplars[int64])
+		h.stripes = newStripes(n, 0, dropExemplars[int64], h.stripes.newValue)
+		return Builder[int64]{}.filter(h.measure), func() int { return storedValues(h.parts) }
+	}))
+}
+
+func storedValues[T any](parts []stripe[T]) int {
+	var n int
+	for i := range parts {
+		n += len(parts[i].values)
+	}
+	return n
+}
+
+func benchmarkStripedHighCardinality(factory func(stripes int) (Measure[int64], func() int)) func(*testing.B) {
+	const sets, rounds = 1000, 8
+	attrs := make([]attribute.Set, sets)
+	for i := range attrs {
+		attrs[i] = attribute.NewSet(attribute.Int("id", i), attribute.String("user", "Alice"))
+	}
+
+	return func(b *testing.B) {
+		ctx := context.Background()
+		run := func(stripes func() int) func(*testing.B) {
+			return func(b *testing.B) {
+				var before, after runtime.MemStats
+				runtime.GC()
+				runtime.ReadMemStats(&before)
+
+				var values int
+				for i := 0; i < b.N; i++ {
+					meas, stored := factory(stripes())
+					var wg sync.WaitGroup
+					for g := 0; g < runtime.GOMAXPROCS(0); g++ {
+						wg.Add(1)
+						go func() {
+							defer wg.Done()
+							for r := 0; r < rounds; r++ {
+								for _, a := range attrs {
+									meas(ctx, 1, a)
+								}
+							}
+						}()
+					}
+					wg.Wait()
+					values += stored()
+				}
+
+				runtime.ReadMemStats(&after)
+				n := float64(b.N * sets)
+				b.ReportMetric(float64(after.TotalAlloc-before.TotalAlloc)/n, "B/set")
+				b.ReportMetric(float64(after.Mallocs-before.Mallocs)/n, "allocs/set")
+				b.ReportMetric(float64(values)/n, "values/set")
+			}
+		}
+		b.Run("Unstriped", run(func() int { return 1 }))
+		b.Run("Striped", run(stripeCount))
+	}
+}
@@ -5,7 +5,6 @@ package aggregate // import "go.opentelemetry.io/otel/sdk/metric/internal/aggreg
 
 import (
 	"context"
-	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -19,37 +18,50 @@ type sumValue[N int64 | float64] struct {
 	attrs attribute.Set
 }
 
+func newSumValue[N int64 | float64](attrs attribute.Set, r exemplar.FilteredReservoir[N]) *sumValue[N] {
+	return &sumValue[N]{res: r, attrs: attrs}
+}
+
 // valueMap is the storage for sums.
 type valueMap[N int64 | float64] struct {
-	sync.Mutex
-	newRes func() exemplar.FilteredReservoir[N]
-	limit  limiter[sumValue[N]]
-	values map[attribute.Distinct]sumValue[N]
+	*stripes[N, sumValue[N]]
 }
 
-func newValueMap[N int64 | float64](limit int, r func() exemplar.FilteredReservoir[N]) *valueMap[N] {
-	return &valueMap[N]{
-		newRes: r,
-		limit:  newLimiter[sumValue[N]](limit),
-		values: make(map[attribute.Distinct]sumValue[N]),
-	}
+// newValueMap returns a valueMap that spreads measurements across n stripes.
+func newValueMap[N int64 | float64](n, limit int, r func() exemplar.FilteredReservoir[N]) *valueMap[N] {
+	return &valueMap[N]{stripes: newStripes(n, limit, r, newSumValue[N])}
 }
 
 func (s *valueMap[N]) measure(ctx context.Context, value N, fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) {
-	s.Lock()
-	defer s.Unlock()
+	i := s.pick(fltrAttr)
+	s.parts[i].Lock()
+	defer s.parts[i].Unlock()
 
-	attr := s.limit.Attributes(fltrAttr, s.values)
-	v, ok := s.values[attr.Equivalent()]
-	if !ok {
-		v.res = s.newRes()
-	}
-
-	v.attrs = attr
+	v := s.lookup(i, fltrAttr)
 	v.n += value
 	v.res.Offer(ctx, value, droppedAttr)
+}
 
-	s.values[attr.Equivalent()] = v
+func (s *valueMap[N]) bind(fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) BoundMeasure[N] {
+	b := s.stripes.bind(fltrAttr)
+	return func(ctx context.Context, value N) {
+		i := b.pick()
+		s.parts[i].Lock()
+		defer s.parts[i].Unlock()
+
+		v := b.value(i)
+		v.n += value
+		v.res.Offer(ctx, value, droppedAttr)
+	}
+}
+
+// mergeSums returns the attributes and sum of the values in group.
+func mergeSums[N int64 | float64](group []*sumValue[N]) (attribute.Set, N) {
+	var n N
+	for _, v := range group {
+		n += v.n
+	}
+	return group[0].attrs, n
 }
 
 // newSum returns an aggregator that summarizes a set of measurements as their
@@ -57,7 +69,7 @@ func (s *valueMap[N]) measure(ctx context.Context, value N, fltrAttr attribute.S
 // the measurements were made in.
 func newSum[N int64 | float64](monotonic bool, limit int, r func() exemplar.FilteredReservoir[N]) *sum[N] {
 	return &sum[N]{
-		valueMap:  newValueMap[N](limit, r),
+		valueMap:  newValueMap[N](stripeCount(), limit, r),
 		monotonic: monotonic,
 		start:     now(),
 	}
@@ -80,23 +92,23 @@ func (s *sum[N]) delta(dest *metricdata.Aggregation) int {
 	sData.Temporality = metricdata.DeltaTemporality
 	sData.IsMonotonic = s.monotonic
 
-	s.Lock()
-	defer s.Unlock()
+	s.lockAll()
+	defer s.unlockAll()
 
-	n := len(s.values)
+	groups := s.merged()
+	n := len(groups)
 	dPts := reset(sData.DataPoints, n, n)
 
 	var i int
-	for _, val := range s.values {
-		dPts[i].Attributes = val.attrs
+	for _, g := range groups {
+		dPts[i].Attributes, dPts[i].Value = mergeSums(g)
 		dPts[i].StartTime = s.start
 		dPts[i].Time = t
-		dPts[i].Value = val.n
-		collectExemplars(&dPts[i].Exemplars, val.res.Collect)
+		collectExemplars(&dPts[i].Exemplars, g[0].res.Collect)
 		i++
 	}
 	// Do not report stale values.
-	clear(s.values)
+	s.clear()
 	// The delta collection cycle resets.
 	s.start = t
 
@@ -115,19 +127,19 @@ func (s *sum[N]) cumulative(dest *metricdata.Aggregation) int {
 	sData.Temporality = metricdata.CumulativeTemporality
 	sData.IsMonotonic = s.monotonic
 
-	s.Lock()
-	defer s.Unlock()
+	s.lockAll()
+	defer s.unlockAll()
 
-	n := len(s.values)
+	groups := s.merged()
+	n := len(groups)
 	dPts := reset(sData.DataPoints, n, n)
 
 	var i int
-	for _, value := range s.values {
-		dPts[i].Attributes = value.attrs
+	for _, g := range groups {
+		dPts[i].Attributes, dPts[i].Value = mergeSums(g)
 		dPts[i].StartTime = s.start
 		dPts[i].Time = t
-		dPts[i].Value = value.n
-		collectExemplars(&dPts[i].Exemplars, value.res.Collect)
+		collectExemplars(&dPts[i].Exemplars, g[0].res.Collect)
 		// TODO (#3006): This will use an unbounded amount of memory if there
 		// are unbounded number of attribute sets being aggregated. Attribute
 		// sets that become "stale" need to be forgotten so this will not
@@ -146,7 +158,9 @@ func (s *sum[N]) cumulative(dest *metricdata.Aggregation) int {
 // the aggregation cycle the measurements were made in.
 func newPrecomputedSum[N int64 | float64](monotonic bool, limit int, r func() exemplar.FilteredReservoir[N]) *precomputedSum[N] {
 	return &precomputedSum[N]{
-		valueMap:  newValueMap[N](limit, r),
+		// Observations are made serially by callbacks, they do not need to be
+		// spread across stripes.
+		valueMap:  newValueMap[N](1, limit, r),
 		monotonic: monotonic,
 		start:     now(),
 	}
@@ -172,27 +186,30 @@ func (s *precomputedSum[N]) delta(dest *metricdata.Aggregation) int {
 	sData.Temporality = metricdata.DeltaTemporality
 	sData.IsMonotonic = s.monotonic
 
-	s.Lock()
-	defer s.Unlock()
+	s.lockAll()
+	defer s.unlockAll()
 
-	n := len(s.values)
+	groups := s.merged()
+	n := len(groups)
 	dPts := reset(sData.DataPoints, n, n)
 
 	var i int
-	for key, value := range s.values {
-		delta := value.n - s.reported[key]
+	for _, g := range groups {
+		attrs, value := mergeSums(g)
+		key := attrs.Equivalent()
+		delta := value - s.reported[key]
 
-		dPts[i].Attributes = value.attrs
+		dPts[i].Attributes = attrs
 		dPts[i].StartTime = s.start
 		dPts[i].Time = t
 		dPts[i].Value = delta
-		collectExemplars(&dPts[i].Exemplars, value.res.Collect)
+		collectExemplars(&dPts[i].Exemplars, g[0].res.Collect)
 
-		newReported[key] = value.n
+		newReported[key] = value
 		i++
 	}
 	// Unused attribute sets do not report.
-	clear(s.values)
+	s.clear()
 	s.reported = newReported
 	// The delta collection cycle resets.
 	s.start = t
@@ -212,24 +229,24 @@ func (s *precomputedSum[N]) cumulative(dest *metricdata.Aggregation) int {
 	sData.Temporality = metricdata.CumulativeTemporality
 	sData.IsMonotonic = s.monotonic
 
-	s.Lock()
-	defer s.Unlock()
+	s.lockAll()
+	defer s.unlockAll()
 
-	n := len(s.values)
+	groups := s.merged()
+	n := len(groups)
 	dPts := reset(sData.DataPoints, n, n)
 
 	var i int
-	for _, val := range s.values {
-		dPts[i].Attributes = val.attrs
+	for _, g := range groups {
+		dPts[i].Attributes, dPts[i].Value = mergeSums(g)
 		dPts[i].StartTime = s.start
 		dPts[i].Time = t
-		dPts[i].Value = val.n
-		collectExemplars(&dPts[i].Exemplars, val.res.Collect)
+		collectExemplars(&dPts[i].Exemplars, g[0].res.Collect)
 
 		i++
 	}
 	// Unused attribute sets do not report.
-	clear(s.values)
+	s.clear()
 
 	sData.DataPoints = dPts
 	*dest = sData
@@ -5,12 +5,15 @@ package exemplar // import "go.opentelemetry.io/otel/sdk/metric/internal/exempla
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 )
 
 // FilteredReservoir wraps a [Reservoir] with a filter.
+//
+// A FilteredReservoir is safe to use concurrently.
 type FilteredReservoir[N int64 | float64] interface {
 	// Offer accepts the parameters associated with a measurement. The
 	// parameters will be stored as an exemplar if the filter decides to
@@ -26,7 +29,11 @@ type FilteredReservoir[N int64 | float64] interface {
 
 // filteredReservoir handles the pre-sampled exemplar of measurements made.
 type filteredReservoir[N int64 | float64] struct {
-	filter    Filter
+	filter Filter
+
+	// mu guards reservoir. It is only acquired for measurements that pass
+	// the filter.
+	mu        sync.Mutex
 	reservoir Reservoir
 }
 
@@ -42,8 +49,15 @@ func NewFilteredReservoir[N int64 | float64](f Filter, r Reservoir) FilteredRese
 func (f *filteredReservoir[N]) Offer(ctx context.Context, val N, attr []attribute.KeyValue) {
 	if f.filter(ctx) {
 		// only record the current time if we are sampling this measurment.
-		f.reservoir.Offer(ctx, time.Now(), NewValue(val), attr)
+		t := time.Now()
+		f.mu.Lock()
+		f.reservoir.Offer(ctx, t, NewValue(val), attr)
+		f.mu.Unlock()
 	}
 }
 
-func (f *filteredReservoir[N]) Collect(dest *[]Exemplar) { f.reservoir.Collect(dest) }
+func (f *filteredReservoir[N]) Collect(dest *[]Exemplar) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.reservoir.Collect(dest)
+}
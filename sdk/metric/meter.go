@@ -7,7 +7,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"sync/atomic"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
@@ -29,6 +32,9 @@ type meter struct {
 
 	scope instrumentation.Scope
 	pipes pipelines
+	// stopped reports if the MeterProvider that created the meter has been
+	// shut down.
+	stopped *atomic.Bool
 
 	int64Insts             *cacheWithErr[instID, *int64Inst]
 	float64Insts           *cacheWithErr[instID, *float64Inst]
@@ -39,7 +45,7 @@ type meter struct {
 	float64Resolver resolver[float64]
 }
 
-func newMeter(s instrumentation.Scope, p pipelines) *meter {
+func newMeter(s instrumentation.Scope, p pipelines, stopped *atomic.Bool) *meter {
 	// viewCache ensures instrument conflicts, including number conflicts, this
 	// meter is asked to create are logged to the user.
 	var viewCache cache[string, instID]
@@ -52,6 +58,7 @@ func newMeter(s instrumentation.Scope, p pipelines) *meter {
 	return &meter{
 		scope:                  s,
 		pipes:                  p,
+		stopped:                stopped,
 		int64Insts:             &int64Insts,
 		float64Insts:           &float64Insts,
 		int64ObservableInsts:   &int64ObservableInsts,
@@ -71,12 +78,12 @@ func (m *meter) Int64Counter(name string, options ...metric.Int64CounterOption)
 	cfg := metric.NewInt64CounterConfig(options...)
 	const kind = InstrumentKindCounter
 	p := int64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), cfg.AttributeKeys())
 	if err != nil {
-		return i, err
+		return int64Counter{int64Inst: i}, err
 	}
 
-	return i, validateInstrumentName(name)
+	return int64Counter{int64Inst: i}, validateInstrumentName(name)
 }
 
 // Int64UpDownCounter returns a new instrument identified by name and
@@ -86,12 +93,12 @@ func (m *meter) Int64UpDownCounter(name string, options ...metric.Int64UpDownCou
 	cfg := metric.NewInt64UpDownCounterConfig(options...)
 	const kind = InstrumentKindUpDownCounter
 	p := int64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), cfg.AttributeKeys())
 	if err != nil {
-		return i, err
+		return int64UpDownCounter{int64Inst: i}, err
 	}
 
-	return i, validateInstrumentName(name)
+	return int64UpDownCounter{int64Inst: i}, validateInstrumentName(name)
 }
 
 // Int64Histogram returns a new instrument identified by name and configured
@@ -102,10 +109,10 @@ func (m *meter) Int64Histogram(name string, options ...metric.Int64HistogramOpti
 	p := int64InstProvider{m}
 	i, err := p.lookupHistogram(name, cfg)
 	if err != nil {
-		return i, err
+		return int64Histogram{int64Inst: i}, err
 	}
 
-	return i, validateInstrumentName(name)
+	return int64Histogram{int64Inst: i}, validateInstrumentName(name)
 }
 
 // Int64Gauge returns a new instrument identified by name and configured
@@ -115,17 +122,17 @@ func (m *meter) Int64Gauge(name string, options ...metric.Int64GaugeOption) (met
 	cfg := metric.NewInt64GaugeConfig(options...)
 	const kind = InstrumentKindGauge
 	p := int64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), cfg.AttributeKeys())
 	if err != nil {
-		return i, err
+		return int64Gauge{int64Inst: i}, err
 	}
 
-	return i, validateInstrumentName(name)
+	return int64Gauge{int64Inst: i}, validateInstrumentName(name)
 }
 
 // int64ObservableInstrument returns a new observable identified by the Instrument.
 // It registers callbacks for each reader's pipeline.
-func (m *meter) int64ObservableInstrument(id Instrument, callbacks []metric.Int64Callback) (int64Observable, error) {
+func (m *meter) int64ObservableInstrument(id Instrument, attrKeys []attribute.Key, callbacks []metric.Int64Callback) (int64Observable, error) {
 	key := instID{
 		Name:        id.Name,
 		Description: id.Description,
@@ -137,10 +144,11 @@ func (m *meter) int64ObservableInstrument(id Instrument, callbacks []metric.Int6
 	}
 	return m.int64ObservableInsts.Lookup(key, func() (int64Observable, error) {
 		inst := newInt64Observable(m, id.Kind, id.Name, id.Description, id.Unit)
+		filter := advisoryAttributeFilter(attrKeys)
 		for _, insert := range m.int64Resolver.inserters {
 			// Connect the measure functions for instruments in this pipeline with the
 			// callbacks for this pipeline.
-			in, err := insert.Instrument(id, insert.readerDefaultAggregation(id.Kind))
+			in, _, err := insert.Instrument(id, insert.readerDefaultAggregation(id.Kind), filter)
 			if err != nil {
 				return inst, err
 			}
@@ -151,9 +159,10 @@ func (m *meter) int64ObservableInstrument(id Instrument, callbacks []metric.Int6
 			}
 			inst.appendMeasures(in)
 			for _, cback := range callbacks {
-				inst := int64Observer{measures: in}
 				fn := cback
-				insert.addCallback(func(ctx context.Context) error { return fn(ctx, inst) })
+				insert.addCallback(newCallback(m.scope.Name, []string{id.Name}, func(ctx context.Context, inv *invocation) error {
+					return fn(ctx, int64Observer{measures: in, inv: inv})
+				}))
 			}
 		}
 		return inst, validateInstrumentName(id.Name)
@@ -178,7 +187,7 @@ func (m *meter) Int64ObservableCounter(name string, options ...metric.Int64Obser
 		Kind:        InstrumentKindObservableCounter,
 		Scope:       m.scope,
 	}
-	return m.int64ObservableInstrument(id, cfg.Callbacks())
+	return m.int64ObservableInstrument(id, cfg.AttributeKeys(), cfg.Callbacks())
 }
 
 // Int64ObservableUpDownCounter returns a new instrument identified by name and
@@ -199,7 +208,7 @@ func (m *meter) Int64ObservableUpDownCounter(name string, options ...metric.Int6
 		Kind:        InstrumentKindObservableUpDownCounter,
 		Scope:       m.scope,
 	}
-	return m.int64ObservableInstrument(id, cfg.Callbacks())
+	return m.int64ObservableInstrument(id, cfg.AttributeKeys(), cfg.Callbacks())
 }
 
 // Int64ObservableGauge returns a new instrument identified by name and
@@ -220,7 +229,7 @@ func (m *meter) Int64ObservableGauge(name string, options ...metric.Int64Observa
 		Kind:        InstrumentKindObservableGauge,
 		Scope:       m.scope,
 	}
-	return m.int64ObservableInstrument(id, cfg.Callbacks())
+	return m.int64ObservableInstrument(id, cfg.AttributeKeys(), cfg.Callbacks())
 }
 
 // Float64Counter returns a new instrument identified by name and configured
@@ -230,12 +239,12 @@ func (m *meter) Float64Counter(name string, options ...metric.Float64CounterOpti
 	cfg := metric.NewFloat64CounterConfig(options...)
 	const kind = InstrumentKindCounter
 	p := float64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), cfg.AttributeKeys())
 	if err != nil {
-		return i, err
+		return float64Counter{float64Inst: i}, err
 	}
 
-	return i, validateInstrumentName(name)
+	return float64Counter{float64Inst: i}, validateInstrumentName(name)
 }
 
 // Float64UpDownCounter returns a new instrument identified by name and
@@ -245,12 +254,12 @@ func (m *meter) Float64UpDownCounter(name string, options ...metric.Float64UpDow
 	cfg := metric.NewFloat64UpDownCounterConfig(options...)
 	const kind = InstrumentKindUpDownCounter
 	p := float64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), cfg.AttributeKeys())
 	if err != nil {
-		return i, err
+		return float64UpDownCounter{float64Inst: i}, err
 	}
 
-	return i, validateInstrumentName(name)
+	return float64UpDownCounter{float64Inst: i}, validateInstrumentName(name)
 }
 
 // Float64Histogram returns a new instrument identified by name and configured
@@ -261,10 +270,10 @@ func (m *meter) Float64Histogram(name string, options ...metric.Float64Histogram
 	p := float64InstProvider{m}
 	i, err := p.lookupHistogram(name, cfg)
 	if err != nil {
-		return i, err
+		return float64Histogram{float64Inst: i}, err
 	}
 
-	return i, validateInstrumentName(name)
+	return float64Histogram{float64Inst: i}, validateInstrumentName(name)
 }
 
 // Float64Gauge returns a new instrument identified by name and configured
@@ -274,17 +283,17 @@ func (m *meter) Float64Gauge(name string, options ...metric.Float64GaugeOption)
 	cfg := metric.NewFloat64GaugeConfig(options...)
 	const kind = InstrumentKindGauge
 	p := float64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), cfg.AttributeKeys())
 	if err != nil {
-		return i, err
+		return float64Gauge{float64Inst: i}, err
 	}
 
-	return i, validateInstrumentName(name)
+	return float64Gauge{float64Inst: i}, validateInstrumentName(name)
 }
 
 // float64ObservableInstrument returns a new observable identified by the Instrument.
 // It registers callbacks for each reader's pipeline.
-func (m *meter) float64ObservableInstrument(id Instrument, callbacks []metric.Float64Callback) (float64Observable, error) {
+func (m *meter) float64ObservableInstrument(id Instrument, attrKeys []attribute.Key, callbacks []metric.Float64Callback) (float64Observable, error) {
 	key := instID{
 		Name:        id.Name,
 		Description: id.Description,
@@ -296,10 +305,11 @@ func (m *meter) float64ObservableInstrument(id Instrument, callbacks []metric.Fl
 	}
 	return m.float64ObservableInsts.Lookup(key, func() (float64Observable, error) {
 		inst := newFloat64Observable(m, id.Kind, id.Name, id.Description, id.Unit)
+		filter := advisoryAttributeFilter(attrKeys)
 		for _, insert := range m.float64Resolver.inserters {
 			// Connect the measure functions for instruments in this pipeline with the
 			// callbacks for this pipeline.
-			in, err := insert.Instrument(id, insert.readerDefaultAggregation(id.Kind))
+			in, _, err := insert.Instrument(id, insert.readerDefaultAggregation(id.Kind), filter)
 			if err != nil {
 				return inst, err
 			}
@@ -310,9 +320,10 @@ func (m *meter) float64ObservableInstrument(id Instrument, callbacks []metric.Fl
 			}
 			inst.appendMeasures(in)
 			for _, cback := range callbacks {
-				inst := float64Observer{measures: in}
 				fn := cback
-				insert.addCallback(func(ctx context.Context) error { return fn(ctx, inst) })
+				insert.addCallback(newCallback(m.scope.Name, []string{id.Name}, func(ctx context.Context, inv *invocation) error {
+					return fn(ctx, float64Observer{measures: in, inv: inv})
+				}))
 			}
 		}
 		return inst, validateInstrumentName(id.Name)
@@ -337,7 +348,7 @@ func (m *meter) Float64ObservableCounter(name string, options ...metric.Float64O
 		Kind:        InstrumentKindObservableCounter,
 		Scope:       m.scope,
 	}
-	return m.float64ObservableInstrument(id, cfg.Callbacks())
+	return m.float64ObservableInstrument(id, cfg.AttributeKeys(), cfg.Callbacks())
 }
 
 // Float64ObservableUpDownCounter returns a new instrument identified by name
@@ -358,7 +369,7 @@ func (m *meter) Float64ObservableUpDownCounter(name string, options ...metric.Fl
 		Kind:        InstrumentKindObservableUpDownCounter,
 		Scope:       m.scope,
 	}
-	return m.float64ObservableInstrument(id, cfg.Callbacks())
+	return m.float64ObservableInstrument(id, cfg.AttributeKeys(), cfg.Callbacks())
 }
 
 // Float64ObservableGauge returns a new instrument identified by name and
@@ -379,7 +390,7 @@ func (m *meter) Float64ObservableGauge(name string, options ...metric.Float64Obs
 		Kind:        InstrumentKindObservableGauge,
 		Scope:       m.scope,
 	}
-	return m.float64ObservableInstrument(id, cfg.Callbacks())
+	return m.float64ObservableInstrument(id, cfg.AttributeKeys(), cfg.Callbacks())
 }
 
 func validateInstrumentName(name string) error {
@@ -481,7 +492,9 @@ func (m *meter) RegisterCallback(f metric.Callback, insts ...metric.Observable)
 	}
 
 	// Some or all instruments were valid.
-	cback := func(ctx context.Context) error { return f(ctx, reg) }
+	cback := newCallback(m.scope.Name, reg.names(), func(ctx context.Context, inv *invocation) error {
+		return f(ctx, reg.with(inv))
+	})
 	return m.pipes.registerMultiCallback(cback), err
 }
 
@@ -490,6 +503,9 @@ type observer struct {
 
 	float64 map[observablID[float64]]struct{}
 	int64   map[observablID[int64]]struct{}
+
+	// inv is the invocation of the callback observations are made for.
+	inv *invocation
 }
 
 func newObserver() observer {
@@ -503,6 +519,25 @@ func (r observer) len() int {
 	return len(r.float64) + len(r.int64)
 }
 
+// names returns the sorted names of the instruments registered with r.
+func (r observer) names() []string {
+	names := make([]string, 0, r.len())
+	for id := range r.float64 {
+		names = append(names, id.name)
+	}
+	for id := range r.int64 {
+		names = append(names, id.name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// with returns a copy of r that makes observations for inv.
+func (r observer) with(inv *invocation) observer {
+	r.inv = inv
+	return r
+}
+
 func (r observer) registerFloat64(id observablID[float64]) {
 	r.float64[id] = struct{}{}
 }
@@ -517,6 +552,11 @@ var (
 )
 
 func (r observer) ObserveFloat64(o metric.Float64Observable, v float64, opts ...metric.ObserveOption) {
+	if !r.inv.acquire() {
+		return
+	}
+	defer r.inv.release()
+
 	var oImpl float64Observable
 	switch conv := o.(type) {
 	case float64Observable:
@@ -552,6 +592,11 @@ func (r observer) ObserveFloat64(o metric.Float64Observable, v float64, opts ...
 }
 
 func (r observer) ObserveInt64(o metric.Int64Observable, v int64, opts ...metric.ObserveOption) {
+	if !r.inv.acquire() {
+		return
+	}
+	defer r.inv.release()
+
 	var oImpl int64Observable
 	switch conv := o.(type) {
 	case int64Observable:
@@ -595,7 +640,7 @@ func (noopRegister) Unregister() error {
 // int64InstProvider provides int64 OpenTelemetry instruments.
 type int64InstProvider struct{ *meter }
 
-func (p int64InstProvider) aggs(kind InstrumentKind, name, desc, u string) ([]aggregate.Measure[int64], error) {
+func (p int64InstProvider) aggs(kind InstrumentKind, name, desc, u string, attrKeys []attribute.Key) ([]aggregate.Measure[int64], []aggregate.Bind[int64], error) {
 	inst := Instrument{
 		Name:        name,
 		Description: desc,
@@ -603,10 +648,10 @@ func (p int64InstProvider) aggs(kind InstrumentKind, name, desc, u string) ([]ag
 		Kind:        kind,
 		Scope:       p.scope,
 	}
-	return p.int64Resolver.Aggregators(inst)
+	return p.int64Resolver.Aggregators(inst, attrKeys)
 }
 
-func (p int64InstProvider) histogramAggs(name string, cfg metric.Int64HistogramConfig) ([]aggregate.Measure[int64], error) {
+func (p int64InstProvider) histogramAggs(name string, cfg metric.Int64HistogramConfig) ([]aggregate.Measure[int64], []aggregate.Bind[int64], error) {
 	boundaries := cfg.ExplicitBucketBoundaries()
 	aggError := AggregationExplicitBucketHistogram{Boundaries: boundaries}.err()
 	if aggError != nil {
@@ -620,20 +665,20 @@ func (p int64InstProvider) histogramAggs(name string, cfg metric.Int64HistogramC
 		Kind:        InstrumentKindHistogram,
 		Scope:       p.scope,
 	}
-	measures, err := p.int64Resolver.HistogramAggregators(inst, boundaries)
-	return measures, errors.Join(aggError, err)
+	measures, binds, err := p.int64Resolver.HistogramAggregators(inst, boundaries, cfg.AttributeKeys())
+	return measures, binds, errors.Join(aggError, err)
 }
 
 // lookup returns the resolved instrumentImpl.
-func (p int64InstProvider) lookup(kind InstrumentKind, name, desc, u string) (*int64Inst, error) {
+func (p int64InstProvider) lookup(kind InstrumentKind, name, desc, u string, attrKeys []attribute.Key) (*int64Inst, error) {
 	return p.meter.int64Insts.Lookup(instID{
 		Name:        name,
 		Description: desc,
 		Unit:        u,
 		Kind:        kind,
 	}, func() (*int64Inst, error) {
-		aggs, err := p.aggs(kind, name, desc, u)
-		return &int64Inst{measures: aggs}, err
+		meas, binds, err := p.aggs(kind, name, desc, u, attrKeys)
+		return &int64Inst{measures: meas, binds: binds, stopped: p.stopped}, err
 	})
 }
 
@@ -645,15 +690,15 @@ func (p int64InstProvider) lookupHistogram(name string, cfg metric.Int64Histogra
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindHistogram,
 	}, func() (*int64Inst, error) {
-		aggs, err := p.histogramAggs(name, cfg)
-		return &int64Inst{measures: aggs}, err
+		meas, binds, err := p.histogramAggs(name, cfg)
+		return &int64Inst{measures: meas, binds: binds, stopped: p.stopped}, err
 	})
 }
 
 // float64InstProvider provides float64 OpenTelemetry instruments.
 type float64InstProvider struct{ *meter }
 
-func (p float64InstProvider) aggs(kind InstrumentKind, name, desc, u string) ([]aggregate.Measure[float64], error) {
+func (p float64InstProvider) aggs(kind InstrumentKind, name, desc, u string, attrKeys []attribute.Key) ([]aggregate.Measure[float64], []aggregate.Bind[float64], error) {
 	inst := Instrument{
 		Name:        name,
 		Description: desc,
@@ -661,10 +706,10 @@ func (p float64InstProvider) aggs(kind InstrumentKind, name, desc, u string) ([]
 		Kind:        kind,
 		Scope:       p.scope,
 	}
-	return p.float64Resolver.Aggregators(inst)
+	return p.float64Resolver.Aggregators(inst, attrKeys)
 }
 
-func (p float64InstProvider) histogramAggs(name string, cfg metric.Float64HistogramConfig) ([]aggregate.Measure[float64], error) {
+func (p float64InstProvider) histogramAggs(name string, cfg metric.Float64HistogramConfig) ([]aggregate.Measure[float64], []aggregate.Bind[float64], error) {
 	boundaries := cfg.ExplicitBucketBoundaries()
 	aggError := AggregationExplicitBucketHistogram{Boundaries: boundaries}.err()
 	if aggError != nil {
@@ -678,20 +723,20 @@ func (p float64InstProvider) histogramAggs(name string, cfg metric.Float64Histog
 		Kind:        InstrumentKindHistogram,
 		Scope:       p.scope,
 	}
-	measures, err := p.float64Resolver.HistogramAggregators(inst, boundaries)
-	return measures, errors.Join(aggError, err)
+	measures, binds, err := p.float64Resolver.HistogramAggregators(inst, boundaries, cfg.AttributeKeys())
+	return measures, binds, errors.Join(aggError, err)
 }
 
 // lookup returns the resolved instrumentImpl.
-func (p float64InstProvider) lookup(kind InstrumentKind, name, desc, u string) (*float64Inst, error) {
+func (p float64InstProvider) lookup(kind InstrumentKind, name, desc, u string, attrKeys []attribute.Key) (*float64Inst, error) {
 	return p.meter.float64Insts.Lookup(instID{
 		Name:        name,
 		Description: desc,
 		Unit:        u,
 		Kind:        kind,
 	}, func() (*float64Inst, error) {
-		aggs, err := p.aggs(kind, name, desc, u)
-		return &float64Inst{measures: aggs}, err
+		meas, binds, err := p.aggs(kind, name, desc, u, attrKeys)
+		return &float64Inst{measures: meas, binds: binds, stopped: p.stopped}, err
 	})
 }
 
@@ -703,17 +748,25 @@ func (p float64InstProvider) lookupHistogram(name string, cfg metric.Float64Hist
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindHistogram,
 	}, func() (*float64Inst, error) {
-		aggs, err := p.histogramAggs(name, cfg)
-		return &float64Inst{measures: aggs}, err
+		meas, binds, err := p.histogramAggs(name, cfg)
+		return &float64Inst{measures: meas, binds: binds, stopped: p.stopped}, err
 	})
 }
 
 type int64Observer struct {
 	embedded.Int64Observer
 	measures[int64]
+
+	// inv is the invocation of the callback observations are made for.
+	inv *invocation
 }
 
 func (o int64Observer) Observe(val int64, opts ...metric.ObserveOption) {
+	if !o.inv.acquire() {
+		return
+	}
+	defer o.inv.release()
+
 	c := metric.NewObserveConfig(opts)
 	o.observe(val, c.Attributes())
 }
@@ -721,9 +774,17 @@ func (o int64Observer) Observe(val int64, opts ...metric.ObserveOption) {
 type float64Observer struct {
 	embedded.Float64Observer
 	measures[float64]
+
+	// inv is the invocation of the callback observations are made for.
+	inv *invocation
 }
 
 func (o float64Observer) Observe(val float64, opts ...metric.ObserveOption) {
+	if !o.inv.acquire() {
+		return
+	}
+	defer o.inv.release()
+
 	c := metric.NewObserveConfig(opts)
 	o.observe(val, c.Attributes())
 }
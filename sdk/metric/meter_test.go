@@ -2113,6 +2113,171 @@ func TestHistogramBucketPrecedenceOrdering(t *testing.T) {
 	}
 }
 
+func TestAdvisoryAttributeKeysPrecedenceOrdering(t *testing.T) {
+	attrs := metric.WithAttributes(
+		attribute.String("foo", "bar"),
+		attribute.Int("version", 1),
+		attribute.Bool("ok", true),
+	)
+	for _, tt := range []struct {
+		desc      string
+		views     []View
+		instOpts  []metric.InstrumentOption
+		wantAttrs attribute.Set
+	}{
+		{
+			desc: "default",
+			wantAttrs: attribute.NewSet(
+				attribute.String("foo", "bar"),
+				attribute.Int("version", 1),
+				attribute.Bool("ok", true),
+			),
+		},
+		{
+			desc:      "advised keys",
+			instOpts:  []metric.InstrumentOption{metric.WithAttributeKeys("foo", "ok")},
+			wantAttrs: attribute.NewSet(attribute.String("foo", "bar"), attribute.Bool("ok", true)),
+		},
+		{
+			desc:      "advised no keys",
+			instOpts:  []metric.InstrumentOption{metric.WithAttributeKeys()},
+			wantAttrs: *attribute.EmptySet(),
+		},
+		{
+			desc:     "view without filter uses advised keys",
+			instOpts: []metric.InstrumentOption{metric.WithAttributeKeys("foo")},
+			views: []View{NewView(Instrument{Name: "*"}, Stream{
+				Description: "renamed",
+			})},
+			wantAttrs: attribute.NewSet(attribute.String("foo", "bar")),
+		},
+		{
+			desc:     "overridden by view",
+			instOpts: []metric.InstrumentOption{metric.WithAttributeKeys("foo")},
+			views: []View{NewView(Instrument{Name: "*"}, Stream{
+				AttributeFilter: attribute.NewAllowKeysFilter("version"),
+			})},
+			wantAttrs: attribute.NewSet(attribute.Int("version", 1)),
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			reader := NewManualReader()
+			meter := NewMeterProvider(WithView(tt.views...), WithReader(reader)).Meter("TestAdvisoryAttributeKeysPrecedenceOrdering")
+
+			var (
+				ctrOpts  []metric.Int64CounterOption
+				histOpts []metric.Float64HistogramOption
+				obsOpts  []metric.Int64ObservableGaugeOption
+			)
+			for _, o := range tt.instOpts {
+				ctrOpts = append(ctrOpts, o)
+				histOpts = append(histOpts, o)
+				obsOpts = append(obsOpts, o)
+			}
+
+			ctr, err := meter.Int64Counter("sync.int64.counter", ctrOpts...)
+			require.NoError(t, err)
+			ctr.Add(context.Background(), 1, attrs)
+
+			hist, err := meter.Float64Histogram("sync.float64.histogram", histOpts...)
+			require.NoError(t, err)
+			hist.Record(context.Background(), 1, attrs)
+
+			obsOpts = append(obsOpts, metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+				o.Observe(1, attrs)
+				return nil
+			}))
+			_, err = meter.Int64ObservableGauge("async.int64.gauge", obsOpts...)
+			require.NoError(t, err)
+
+			var rm metricdata.ResourceMetrics
+			require.NoError(t, reader.Collect(context.Background(), &rm))
+			require.Len(t, rm.ScopeMetrics, 1)
+			require.Len(t, rm.ScopeMetrics[0].Metrics, 3)
+			for _, m := range rm.ScopeMetrics[0].Metrics {
+				var got attribute.Set
+				switch data := m.Data.(type) {
+				case metricdata.Sum[int64]:
+					require.Len(t, data.DataPoints, 1)
+					got = data.DataPoints[0].Attributes
+				case metricdata.Histogram[float64]:
+					require.Len(t, data.DataPoints, 1)
+					got = data.DataPoints[0].Attributes
+				case metricdata.Gauge[int64]:
+					require.Len(t, data.DataPoints, 1)
+					got = data.DataPoints[0].Attributes
+				default:
+					t.Fatalf("unexpected data type %T", data)
+				}
+				assert.Truef(t, tt.wantAttrs.Equals(&got), "%s: want %v, got %v", m.Name, tt.wantAttrs.ToSlice(), got.ToSlice())
+			}
+		})
+	}
+}
+
+func TestBoundInstruments(t *testing.T) {
+	ctx := context.Background()
+	alice := attribute.NewSet(attribute.String("user", "Alice"))
+	bob := attribute.NewSet(attribute.String("user", "Bob"))
+
+	reader := NewManualReader()
+	mp := NewMeterProvider(WithReader(reader))
+	meter := mp.Meter("TestBoundInstruments")
+
+	ctr, err := meter.Int64Counter("int64.counter")
+	require.NoError(t, err)
+	gauge, err := meter.Float64Gauge("float64.gauge")
+	require.NoError(t, err)
+
+	bAliceCtr, bAliceGauge := ctr.Bind(alice), gauge.Bind(alice)
+	bAliceCtr.Add(ctx, 2)
+	bAliceCtr.Add(ctx, 3)
+	bAliceGauge.Record(ctx, 1)
+	bAliceGauge.Record(ctx, 4)
+	// Bound and unbound measurements for the same attributes are aggregated
+	// together.
+	ctr.Add(ctx, 1, metric.WithAttributeSet(alice))
+	ctr.Bind(bob).Add(ctx, 7)
+
+	want := metricdata.ScopeMetrics{
+		Scope: instrumentation.Scope{Name: "TestBoundInstruments"},
+		Metrics: []metricdata.Metrics{
+			{
+				Name: "int64.counter",
+				Data: metricdata.Sum[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints: []metricdata.DataPoint[int64]{
+						{Attributes: alice, Value: 6},
+						{Attributes: bob, Value: 7},
+					},
+				},
+			},
+			{
+				Name: "float64.gauge",
+				Data: metricdata.Gauge[float64]{
+					DataPoints: []metricdata.DataPoint[float64]{
+						{Attributes: alice, Value: 4},
+					},
+				},
+			},
+		},
+	}
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	metricdatatest.AssertEqual(t, want, rm.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+
+	require.NoError(t, mp.Shutdown(ctx))
+	assert.True(t, bAliceCtr.(*int64Bound).stopped.Load(), "counter binding not invalidated")
+	assert.True(t, bAliceGauge.(*float64Bound).stopped.Load(), "gauge binding not invalidated")
+	assert.NotPanics(t, func() {
+		bAliceCtr.Add(ctx, 1)
+		bAliceGauge.Record(ctx, 1)
+	}, "bound instruments used after shutdown")
+}
+
 func TestObservableDropAggregation(t *testing.T) {
 	const (
 		intPrefix         = "observable.int64."
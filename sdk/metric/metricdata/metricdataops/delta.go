@@ -0,0 +1,230 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricdataops // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdataops"
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// Delta returns the difference between the cumulative metric data prev and
+// curr, two snapshots taken from the same source with prev collected before
+// curr.
+//
+// Cumulative sums, histograms, and exponential histograms in curr are
+// converted to delta temporality. Each of their data points has the value of
+// the data point in prev with the same attributes subtracted, and its start
+// time set to the time of that data point. A data point without a counterpart
+// in prev, or one that was reset after prev was collected, is returned as is.
+// A reset is detected by a changed start time or, for monotonic sums and
+// histograms, a decreased value. The minimum and maximum of a subtracted
+// histogram data point are unknown and not set.
+//
+// All other aggregations, and metrics not found in prev, are returned as is.
+// An error wrapping [ErrIncompatible] is returned for a metric that has
+// different aggregation types in prev and curr.
+func Delta(prev, curr metricdata.ResourceMetrics) (metricdata.ResourceMetrics, error) {
+	prevMetrics := make(map[instrumentation.Scope]map[string]metricdata.Aggregation, len(prev.ScopeMetrics))
+	for _, sm := range prev.ScopeMetrics {
+		m, ok := prevMetrics[sm.Scope]
+		if !ok {
+			m = make(map[string]metricdata.Aggregation, len(sm.Metrics))
+			prevMetrics[sm.Scope] = m
+		}
+		for _, metric := range sm.Metrics {
+			m[metric.Name] = metric.Data
+		}
+	}
+
+	var errs []error
+	out := metricdata.ResourceMetrics{
+		Resource:     curr.Resource,
+		ScopeMetrics: make([]metricdata.ScopeMetrics, len(curr.ScopeMetrics)),
+	}
+	for i, sm := range curr.ScopeMetrics {
+		out.ScopeMetrics[i] = metricdata.ScopeMetrics{
+			Scope:   sm.Scope,
+			Metrics: slices.Clone(sm.Metrics),
+		}
+		for j, m := range out.ScopeMetrics[i].Metrics {
+			p, ok := prevMetrics[sm.Scope][m.Name]
+			if !ok {
+				continue
+			}
+			data, err := DeltaAggregation(p, m.Data)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("scope %q: metric %q: %w", sm.Scope.Name, m.Name, err))
+				continue
+			}
+			out.ScopeMetrics[i].Metrics[j].Data = data
+		}
+	}
+	return out, errors.Join(errs...)
+}
+
+// DeltaAggregation returns the difference between the cumulative aggregations
+// prev and curr. See [Delta] for how the difference is computed.
+//
+// If prev is nil, curr is returned.
+func DeltaAggregation(prev, curr metricdata.Aggregation) (metricdata.Aggregation, error) {
+	if prev == nil {
+		return curr, nil
+	}
+
+	switch curr := curr.(type) {
+	case metricdata.Sum[int64]:
+		return deltaAs(prev, curr, deltaSum[int64])
+	case metricdata.Sum[float64]:
+		return deltaAs(prev, curr, deltaSum[float64])
+	case metricdata.Histogram[int64]:
+		return deltaAs(prev, curr, deltaHistogram[int64])
+	case metricdata.Histogram[float64]:
+		return deltaAs(prev, curr, deltaHistogram[float64])
+	case metricdata.ExponentialHistogram[int64]:
+		return deltaAs(prev, curr, deltaExponentialHistogram[int64])
+	case metricdata.ExponentialHistogram[float64]:
+		return deltaAs(prev, curr, deltaExponentialHistogram[float64])
+	case metricdata.Gauge[int64]:
+		return deltaAs(prev, curr, keepCurrent[metricdata.Gauge[int64]])
+	case metricdata.Gauge[float64]:
+		return deltaAs(prev, curr, keepCurrent[metricdata.Gauge[float64]])
+	case metricdata.Summary:
+		return deltaAs(prev, curr, keepCurrent[metricdata.Summary])
+	default:
+		return nil, fmt.Errorf("%w: unknown aggregation %T", ErrIncompatible, curr)
+	}
+}
+
+// deltaAs computes the delta of prev and curr with f if prev is of the same
+// type as curr.
+func deltaAs[A metricdata.Aggregation](prev metricdata.Aggregation, curr A, f func(A, A) A) (metricdata.Aggregation, error) {
+	p, ok := prev.(A)
+	if !ok {
+		return nil, fmt.Errorf("%w: aggregations %T and %T", ErrIncompatible, prev, curr)
+	}
+	return f(p, curr), nil
+}
+
+func keepCurrent[A metricdata.Aggregation](_, curr A) A { return curr }
+
+func deltaSum[N int64 | float64](prev, curr metricdata.Sum[N]) metricdata.Sum[N] {
+	if curr.Temporality != metricdata.CumulativeTemporality || prev.Temporality != metricdata.CumulativeTemporality {
+		return curr
+	}
+	monotonic := curr.IsMonotonic && prev.IsMonotonic
+	curr.Temporality = metricdata.DeltaTemporality
+	curr.DataPoints = deltaPoints(prev.DataPoints, curr.DataPoints, dataPointAttrs[N], func(p, c metricdata.DataPoint[N]) (metricdata.DataPoint[N], bool) {
+		if !p.StartTime.Equal(c.StartTime) || (monotonic && c.Value < p.Value) {
+			return c, false
+		}
+		c.Value -= p.Value
+		c.StartTime = p.Time
+		return c, true
+	})
+	return curr
+}
+
+func deltaHistogram[N int64 | float64](prev, curr metricdata.Histogram[N]) metricdata.Histogram[N] {
+	if curr.Temporality != metricdata.CumulativeTemporality || prev.Temporality != metricdata.CumulativeTemporality {
+		return curr
+	}
+	curr.Temporality = metricdata.DeltaTemporality
+	curr.DataPoints = deltaPoints(prev.DataPoints, curr.DataPoints, histogramDataPointAttrs[N], deltaHistogramDataPoint[N])
+	return curr
+}
+
+func deltaHistogramDataPoint[N int64 | float64](p, c metricdata.HistogramDataPoint[N]) (metricdata.HistogramDataPoint[N], bool) {
+	if !p.StartTime.Equal(c.StartTime) || c.Count < p.Count ||
+		!slices.Equal(p.Bounds, c.Bounds) || len(p.BucketCounts) != len(c.BucketCounts) {
+		return c, false
+	}
+
+	counts := make([]uint64, len(c.BucketCounts))
+	for i := range counts {
+		if c.BucketCounts[i] < p.BucketCounts[i] {
+			return c, false
+		}
+		counts[i] = c.BucketCounts[i] - p.BucketCounts[i]
+	}
+	c.BucketCounts = counts
+	c.Count -= p.Count
+	c.Sum -= p.Sum
+	c.Min = metricdata.Extrema[N]{}
+	c.Max = metricdata.Extrema[N]{}
+	c.StartTime = p.Time
+	return c, true
+}
+
+func deltaExponentialHistogram[N int64 | float64](prev, curr metricdata.ExponentialHistogram[N]) metricdata.ExponentialHistogram[N] {
+	if curr.Temporality != metricdata.CumulativeTemporality || prev.Temporality != metricdata.CumulativeTemporality {
+		return curr
+	}
+	curr.Temporality = metricdata.DeltaTemporality
+	curr.DataPoints = deltaPoints(prev.DataPoints, curr.DataPoints, exponentialHistogramDataPointAttrs[N], deltaExponentialHistogramDataPoint[N])
+	return curr
+}
+
+func deltaExponentialHistogramDataPoint[N int64 | float64](p, c metricdata.ExponentialHistogramDataPoint[N]) (metricdata.ExponentialHistogramDataPoint[N], bool) {
+	if !p.StartTime.Equal(c.StartTime) || c.Count < p.Count || c.ZeroCount < p.ZeroCount ||
+		p.ZeroThreshold != c.ZeroThreshold {
+		return c, false
+	}
+
+	scale := min(p.Scale, c.Scale)
+	p, err := Rescale(p, scale)
+	if err != nil {
+		return c, false
+	}
+	d, err := Rescale(c, scale)
+	if err != nil {
+		return c, false
+	}
+
+	var ok bool
+	if d.PositiveBucket, ok = subtractBuckets(d.PositiveBucket, p.PositiveBucket); !ok {
+		return c, false
+	}
+	if d.NegativeBucket, ok = subtractBuckets(d.NegativeBucket, p.NegativeBucket); !ok {
+		return c, false
+	}
+	d.Count -= p.Count
+	d.ZeroCount -= p.ZeroCount
+	d.Sum -= p.Sum
+	d.Min = metricdata.Extrema[N]{}
+	d.Max = metricdata.Extrema[N]{}
+	d.StartTime = p.Time
+	return d, true
+}
+
+// deltaPoints returns the data points of curr with their counterparts in
+// prev, matched by the attributes returned by attrs, subtracted with f. If f
+// returns false, the current data point was reset and is used as is.
+func deltaPoints[P any](prev, curr []P, attrs func(P) attribute.Set, f func(p, c P) (P, bool)) []P {
+	if len(prev) == 0 || len(curr) == 0 {
+		return curr
+	}
+
+	index := make(map[attribute.Distinct]int, len(prev))
+	for i, p := range prev {
+		set := attrs(p)
+		index[set.Equivalent()] = i
+	}
+
+	out := make([]P, len(curr))
+	for i, c := range curr {
+		out[i] = c
+		set := attrs(c)
+		if j, ok := index[set.Equivalent()]; ok {
+			if d, ok := f(prev[j], c); ok {
+				out[i] = d
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricdataops // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdataops"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+func TestDelta(t *testing.T) {
+	rm := func(metrics ...metricdata.Metrics) metricdata.ResourceMetrics {
+		return metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope:   scopeA,
+			Metrics: metrics,
+		}}}
+	}
+	gauge := metricdata.Gauge[int64]{DataPoints: []metricdata.DataPoint[int64]{point(alice, t0, t2, 9)}}
+
+	prev := rm(
+		metricdata.Metrics{Name: "requests", Data: sum(
+			metricdata.CumulativeTemporality,
+			point(alice, t0, t1, 5),
+			point(bob, t0, t1, 5),
+		)},
+		metricdata.Metrics{Name: "gauge", Data: metricdata.Gauge[int64]{}},
+	)
+	curr := rm(
+		metricdata.Metrics{Name: "requests", Data: sum(
+			metricdata.CumulativeTemporality,
+			point(alice, t0, t2, 8),
+			// Bob was reset.
+			point(bob, t1, t2, 2),
+		)},
+		metricdata.Metrics{Name: "gauge", Data: gauge},
+		metricdata.Metrics{Name: "new", Data: sum(metricdata.CumulativeTemporality, point(alice, t1, t2, 1))},
+	)
+
+	got, err := Delta(prev, curr)
+	require.NoError(t, err)
+	metricdatatest.AssertEqual(t, rm(
+		metricdata.Metrics{Name: "requests", Data: sum(
+			metricdata.DeltaTemporality,
+			point(alice, t1, t2, 3),
+			point(bob, t1, t2, 2),
+		)},
+		metricdata.Metrics{Name: "gauge", Data: gauge},
+		metricdata.Metrics{Name: "new", Data: sum(metricdata.CumulativeTemporality, point(alice, t1, t2, 1))},
+	), got)
+
+	// The inputs are not modified.
+	s := curr.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+	assert.Equal(t, metricdata.CumulativeTemporality, s.Temporality)
+	assert.Equal(t, int64(8), s.DataPoints[0].Value)
+
+	curr.ScopeMetrics[0].Metrics[1].Data = metricdata.Summary{}
+	_, err = Delta(prev, curr)
+	assert.ErrorIs(t, err, ErrIncompatible)
+}
+
+func TestDeltaAggregationSumReset(t *testing.T) {
+	prev := sum(metricdata.CumulativeTemporality, point(alice, t0, t1, 5))
+	curr := sum(metricdata.CumulativeTemporality, point(alice, t0, t2, 2))
+
+	got, err := DeltaAggregation(prev, curr)
+	require.NoError(t, err)
+	metricdatatest.AssertAggregationsEqual(t, sum(metricdata.DeltaTemporality, point(alice, t0, t2, 2)), got)
+
+	// Non-monotonic sums can decrease.
+	prev.IsMonotonic, curr.IsMonotonic = false, false
+	got, err = DeltaAggregation(prev, curr)
+	require.NoError(t, err)
+	want := sum(metricdata.DeltaTemporality, point(alice, t1, t2, -3))
+	want.IsMonotonic = false
+	metricdatatest.AssertAggregationsEqual(t, want, got)
+}
+
+func TestDeltaAggregationDelta(t *testing.T) {
+	prev := sum(metricdata.DeltaTemporality, point(alice, t0, t1, 5))
+	curr := sum(metricdata.DeltaTemporality, point(alice, t1, t2, 7))
+
+	got, err := DeltaAggregation(prev, curr)
+	require.NoError(t, err)
+	metricdatatest.AssertAggregationsEqual(t, curr, got)
+}
+
+func TestDeltaAggregationHistogram(t *testing.T) {
+	hist := func(pt metricdata.HistogramDataPoint[float64]) metricdata.Histogram[float64] {
+		return metricdata.Histogram[float64]{
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints:  []metricdata.HistogramDataPoint[float64]{pt},
+		}
+	}
+	prevPt := metricdata.HistogramDataPoint[float64]{
+		Attributes:   alice,
+		StartTime:    t0,
+		Time:         t1,
+		Count:        2,
+		Bounds:       []float64{1},
+		BucketCounts: []uint64{1, 1},
+		Min:          metricdata.NewExtrema(0.5),
+		Max:          metricdata.NewExtrema(2.),
+		Sum:          2.5,
+	}
+	currPt := prevPt
+	currPt.Time = t2
+	currPt.Count = 5
+	currPt.BucketCounts = []uint64{1, 4}
+	currPt.Max = metricdata.NewExtrema(5.)
+	currPt.Sum = 12.5
+
+	got, err := DeltaAggregation(hist(prevPt), hist(currPt))
+	require.NoError(t, err)
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Histogram[float64]{
+		Temporality: metricdata.DeltaTemporality,
+		DataPoints: []metricdata.HistogramDataPoint[float64]{{
+			Attributes:   alice,
+			StartTime:    t1,
+			Time:         t2,
+			Count:        3,
+			Bounds:       []float64{1},
+			BucketCounts: []uint64{0, 3},
+			Sum:          10,
+		}},
+	}, got)
+
+	// A decreased bucket count is a reset.
+	currPt.BucketCounts = []uint64{0, 5}
+	got, err = DeltaAggregation(hist(prevPt), hist(currPt))
+	require.NoError(t, err)
+	assert.Equal(t, currPt, got.(metricdata.Histogram[float64]).DataPoints[0])
+}
+
+func TestDeltaAggregationExponentialHistogram(t *testing.T) {
+	eh := func(pt metricdata.ExponentialHistogramDataPoint[int64]) metricdata.ExponentialHistogram[int64] {
+		return metricdata.ExponentialHistogram[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints:  []metricdata.ExponentialHistogramDataPoint[int64]{pt},
+		}
+	}
+	prev := metricdata.ExponentialHistogramDataPoint[int64]{
+		Attributes:     alice,
+		StartTime:      t0,
+		Time:           t1,
+		Count:          2,
+		Sum:            6,
+		Scale:          1,
+		PositiveBucket: metricdata.ExponentialBucket{Offset: 2, Counts: []uint64{1, 1}},
+	}
+	// The current data point was downscaled since prev was collected.
+	curr := metricdata.ExponentialHistogramDataPoint[int64]{
+		Attributes:     alice,
+		StartTime:      t0,
+		Time:           t3,
+		Count:          5,
+		Sum:            30,
+		Scale:          0,
+		ZeroCount:      1,
+		PositiveBucket: metricdata.ExponentialBucket{Offset: 1, Counts: []uint64{3, 1}},
+	}
+
+	got, err := DeltaAggregation(eh(prev), eh(curr))
+	require.NoError(t, err)
+	metricdatatest.AssertAggregationsEqual(t, metricdata.ExponentialHistogram[int64]{
+		Temporality: metricdata.DeltaTemporality,
+		DataPoints: []metricdata.ExponentialHistogramDataPoint[int64]{{
+			Attributes:     alice,
+			StartTime:      t1,
+			Time:           t3,
+			Count:          3,
+			Sum:            24,
+			Scale:          0,
+			ZeroCount:      1,
+			PositiveBucket: metricdata.ExponentialBucket{Offset: 1, Counts: []uint64{1, 1}},
+		}},
+	}, got)
+}
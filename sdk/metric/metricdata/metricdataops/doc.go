@@ -0,0 +1,16 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metricdataops provides operations to combine and transform the
+// metric data of the metricdata package.
+//
+// Use [Merge] to combine the metric data collected from multiple sources,
+// [Delta] to compute the change between two cumulative collections, and
+// [Reaggregate] to remove attributes from data points. Exponential histogram
+// data points of different scales can be rescaled with [Rescale] and merged
+// with [MergeExponentialHistogramDataPoints].
+//
+// The operations of this package never modify the data they are passed. The
+// returned data may share memory with the passed data, it needs to be treated
+// as read-only.
+package metricdataops // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdataops"
@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricdataops // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdataops"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// minScale is the lowest scale an exponential histogram can have.
+const minScale = -10
+
+var errUpscale = errors.New("exponential histogram cannot be upscaled")
+
+// Rescale returns dp with its buckets downscaled to scale. Every
+// downscaled bucket counts the values of all buckets of dp it covers.
+//
+// Exponential histograms cannot be upscaled. An error is returned if scale is
+// greater than the scale of dp, or if scale is below the minimum scale of -10.
+func Rescale[N int64 | float64](dp metricdata.ExponentialHistogramDataPoint[N], scale int32) (metricdata.ExponentialHistogramDataPoint[N], error) {
+	if scale > dp.Scale {
+		return dp, fmt.Errorf("%w: scale %d to %d", errUpscale, dp.Scale, scale)
+	}
+	if scale < minScale {
+		return dp, fmt.Errorf("invalid exponential histogram scale: %d", scale)
+	}
+
+	delta := dp.Scale - scale
+	dp.PositiveBucket = downscale(dp.PositiveBucket, delta)
+	dp.NegativeBucket = downscale(dp.NegativeBucket, delta)
+	dp.Scale = scale
+	return dp, nil
+}
+
+// downscale returns b with its bucket indexes reduced by delta scales.
+func downscale(b metricdata.ExponentialBucket, delta int32) metricdata.ExponentialBucket {
+	if delta == 0 || len(b.Counts) == 0 {
+		return b
+	}
+
+	// Reducing the scale by one merges every two adjacent buckets, the index
+	// of a bucket at the lower scale is its index shifted right by delta.
+	first := b.Offset >> delta
+	last := (b.Offset + int32(len(b.Counts)) - 1) >> delta //nolint:gosec // Bucket counts are bounded by the maximum size.
+	counts := make([]uint64, last-first+1)
+	for i, c := range b.Counts {
+		counts[((b.Offset+int32(i))>>delta)-first] += c //nolint:gosec // Bucket counts are bounded by the maximum size.
+	}
+	return metricdata.ExponentialBucket{Offset: first, Counts: counts}
+}
+
+// MergeExponentialHistogramDataPoints returns the data points a and b merged
+// into a single data point. The data points are merged at the lower scale of
+// the two, see [Rescale].
+//
+// An error wrapping [ErrIncompatible] is returned if a and b have different
+// zero thresholds.
+func MergeExponentialHistogramDataPoints[N int64 | float64](a, b metricdata.ExponentialHistogramDataPoint[N]) (metricdata.ExponentialHistogramDataPoint[N], error) {
+	if a.ZeroThreshold != b.ZeroThreshold {
+		return a, fmt.Errorf("%w: zero thresholds %g and %g", ErrIncompatible, a.ZeroThreshold, b.ZeroThreshold)
+	}
+
+	scale := min(a.Scale, b.Scale)
+	a, err := Rescale(a, scale)
+	if err != nil {
+		return a, err
+	}
+	b, err = Rescale(b, scale)
+	if err != nil {
+		return a, err
+	}
+
+	a.Min = mergeExtrema(a.Min, a.Count, b.Min, b.Count, minimum[N])
+	a.Max = mergeExtrema(a.Max, a.Count, b.Max, b.Count, maximum[N])
+
+	a.StartTime = earliest(a.StartTime, b.StartTime)
+	a.Time = latest(a.Time, b.Time)
+	a.Count += b.Count
+	a.Sum += b.Sum
+	a.ZeroCount += b.ZeroCount
+	a.PositiveBucket = addBuckets(a.PositiveBucket, b.PositiveBucket)
+	a.NegativeBucket = addBuckets(a.NegativeBucket, b.NegativeBucket)
+	a.Exemplars = concat(a.Exemplars, b.Exemplars)
+	return a, nil
+}
+
+// addBuckets returns the bucket counts of a and b, of the same scale, added.
+func addBuckets(a, b metricdata.ExponentialBucket) metricdata.ExponentialBucket {
+	if len(b.Counts) == 0 {
+		return a
+	}
+	if len(a.Counts) == 0 {
+		return b
+	}
+
+	first := min(a.Offset, b.Offset)
+	end := max(a.Offset+int32(len(a.Counts)), b.Offset+int32(len(b.Counts))) //nolint:gosec // Bucket counts are bounded by the maximum size.
+	counts := make([]uint64, end-first)
+	for i, c := range a.Counts {
+		counts[int(a.Offset-first)+i] += c
+	}
+	for i, c := range b.Counts {
+		counts[int(b.Offset-first)+i] += c
+	}
+	return metricdata.ExponentialBucket{Offset: first, Counts: counts}
+}
+
+// subtractBuckets returns the bucket counts of b, of the same scale,
+// subtracted from a. False is returned if a does not contain at least the
+// counts of b.
+func subtractBuckets(a, b metricdata.ExponentialBucket) (metricdata.ExponentialBucket, bool) {
+	if len(b.Counts) == 0 {
+		return a, true
+	}
+
+	aEnd := a.Offset + int32(len(a.Counts)) //nolint:gosec // Bucket counts are bounded by the maximum size.
+	counts := make([]uint64, len(a.Counts))
+	copy(counts, a.Counts)
+	for i, c := range b.Counts {
+		if c == 0 {
+			continue
+		}
+		idx := b.Offset + int32(i) //nolint:gosec // Bucket counts are bounded by the maximum size.
+		if idx < a.Offset || idx >= aEnd || counts[idx-a.Offset] < c {
+			return a, false
+		}
+		counts[idx-a.Offset] -= c
+	}
+	return metricdata.ExponentialBucket{Offset: a.Offset, Counts: counts}, true
+}
@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricdataops // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdataops"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+func TestRescale(t *testing.T) {
+	dp := metricdata.ExponentialHistogramDataPoint[float64]{
+		Attributes: alice,
+		Count:      15,
+		Scale:      2,
+		PositiveBucket: metricdata.ExponentialBucket{
+			Offset: -3,
+			Counts: []uint64{1, 2, 3, 4, 5},
+		},
+		NegativeBucket: metricdata.ExponentialBucket{
+			Offset: 4,
+			Counts: []uint64{1},
+		},
+	}
+
+	got, err := Rescale(dp, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), got.Scale)
+	// Indexes -3..1 map to -2, -1, -1, 0, 0 at scale 1 and to -1, -1, -1, 0, 0
+	// at scale -1.
+	assert.Equal(t, metricdata.ExponentialBucket{Offset: -2, Counts: []uint64{1, 5, 9}}, got.PositiveBucket)
+	assert.Equal(t, metricdata.ExponentialBucket{Offset: 2, Counts: []uint64{1}}, got.NegativeBucket)
+
+	got, err = Rescale(dp, -1)
+	require.NoError(t, err)
+	assert.Equal(t, metricdata.ExponentialBucket{Offset: -1, Counts: []uint64{6, 9}}, got.PositiveBucket)
+
+	got, err = Rescale(dp, 2)
+	require.NoError(t, err)
+	assert.Equal(t, dp, got)
+
+	assert.Equal(t, []uint64{1, 2, 3, 4, 5}, dp.PositiveBucket.Counts, "input modified")
+
+	_, err = Rescale(dp, 3)
+	assert.Error(t, err, "upscaled")
+	_, err = Rescale(dp, -11)
+	assert.Error(t, err, "invalid scale")
+}
+
+func TestMergeExponentialHistogramDataPoints(t *testing.T) {
+	a := metricdata.ExponentialHistogramDataPoint[int64]{
+		Attributes:    alice,
+		StartTime:     t0,
+		Time:          t1,
+		Count:         4,
+		Min:           metricdata.NewExtrema[int64](1),
+		Max:           metricdata.NewExtrema[int64](4),
+		Sum:           10,
+		Scale:         1,
+		ZeroCount:     1,
+		ZeroThreshold: 0,
+		PositiveBucket: metricdata.ExponentialBucket{
+			Offset: 0,
+			Counts: []uint64{1, 1, 1},
+		},
+	}
+	b := metricdata.ExponentialHistogramDataPoint[int64]{
+		Attributes:    alice,
+		StartTime:     t1,
+		Time:          t2,
+		Count:         3,
+		Min:           metricdata.NewExtrema[int64](-2),
+		Max:           metricdata.NewExtrema[int64](8),
+		Sum:           9,
+		Scale:         0,
+		ZeroThreshold: 0,
+		PositiveBucket: metricdata.ExponentialBucket{
+			Offset: 2,
+			Counts: []uint64{1},
+		},
+		NegativeBucket: metricdata.ExponentialBucket{
+			Offset: 0,
+			Counts: []uint64{2},
+		},
+	}
+
+	got, err := MergeExponentialHistogramDataPoints(a, b)
+	require.NoError(t, err)
+	metricdatatest.AssertEqual(t, metricdata.ExponentialHistogramDataPoint[int64]{
+		Attributes:    alice,
+		StartTime:     t0,
+		Time:          t2,
+		Count:         7,
+		Min:           metricdata.NewExtrema[int64](-2),
+		Max:           metricdata.NewExtrema[int64](8),
+		Sum:           19,
+		Scale:         0,
+		ZeroCount:     1,
+		ZeroThreshold: 0,
+		PositiveBucket: metricdata.ExponentialBucket{
+			Offset: 0,
+			Counts: []uint64{2, 1, 1},
+		},
+		NegativeBucket: metricdata.ExponentialBucket{
+			Offset: 0,
+			Counts: []uint64{2},
+		},
+	}, got)
+
+	b.ZeroThreshold = 1
+	_, err = MergeExponentialHistogramDataPoints(a, b)
+	assert.ErrorIs(t, err, ErrIncompatible)
+}
+
+func TestSubtractBuckets(t *testing.T) {
+	a := metricdata.ExponentialBucket{Offset: 1, Counts: []uint64{3, 2, 1}}
+
+	got, ok := subtractBuckets(a, metricdata.ExponentialBucket{Offset: 2, Counts: []uint64{1, 1}})
+	require.True(t, ok)
+	assert.Equal(t, metricdata.ExponentialBucket{Offset: 1, Counts: []uint64{3, 1, 0}}, got)
+
+	_, ok = subtractBuckets(a, metricdata.ExponentialBucket{Offset: 0, Counts: []uint64{1}})
+	assert.False(t, ok, "bucket out of range subtracted")
+
+	_, ok = subtractBuckets(a, metricdata.ExponentialBucket{Offset: 3, Counts: []uint64{2}})
+	assert.False(t, ok, "negative count")
+}
@@ -0,0 +1,325 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricdataops // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdataops"
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// ErrIncompatible is returned when metric data cannot be combined. For
+// example, when the aggregations of a metric are of different types or have
+// different temporalities.
+var ErrIncompatible = errors.New("incompatible metric data")
+
+// Merge returns the metric data of a and b combined.
+//
+// The resources of a and b are merged with [resource.Merge]. Metrics are
+// matched by their instrumentation scope and name, and their aggregations are
+// merged with [MergeAggregation]. Metrics only found in one of a or b are
+// included as is.
+func Merge(a, b metricdata.ResourceMetrics) (metricdata.ResourceMetrics, error) {
+	out := metricdata.ResourceMetrics{Resource: a.Resource}
+	switch {
+	case a.Resource == nil:
+		out.Resource = b.Resource
+	case b.Resource != nil:
+		res, err := resource.Merge(a.Resource, b.Resource)
+		if err != nil {
+			return metricdata.ResourceMetrics{}, err
+		}
+		out.Resource = res
+	}
+
+	var errs []error
+	index := make(map[instrumentation.Scope]int, len(a.ScopeMetrics)+len(b.ScopeMetrics))
+	for _, sms := range [][]metricdata.ScopeMetrics{a.ScopeMetrics, b.ScopeMetrics} {
+		for _, sm := range sms {
+			i, ok := index[sm.Scope]
+			if !ok {
+				index[sm.Scope] = len(out.ScopeMetrics)
+				out.ScopeMetrics = append(out.ScopeMetrics, metricdata.ScopeMetrics{Scope: sm.Scope})
+				i = len(out.ScopeMetrics) - 1
+			}
+			var err error
+			out.ScopeMetrics[i].Metrics, err = mergeMetrics(out.ScopeMetrics[i].Metrics, sm.Metrics)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("scope %q: %w", sm.Scope.Name, err))
+			}
+		}
+	}
+	return out, errors.Join(errs...)
+}
+
+// mergeMetrics returns the metrics of b merged into a.
+func mergeMetrics(a, b []metricdata.Metrics) ([]metricdata.Metrics, error) {
+	var errs []error
+	out := slices.Clone(a)
+	for _, m := range b {
+		i := slices.IndexFunc(out, func(o metricdata.Metrics) bool { return o.Name == m.Name })
+		if i < 0 {
+			out = append(out, m)
+			continue
+		}
+
+		merged, err := mergeMetric(out[i], m)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("metric %q: %w", m.Name, err))
+			continue
+		}
+		out[i] = merged
+	}
+	return out, errors.Join(errs...)
+}
+
+func mergeMetric(a, b metricdata.Metrics) (metricdata.Metrics, error) {
+	if a.Unit != b.Unit {
+		return a, fmt.Errorf("%w: units %q and %q", ErrIncompatible, a.Unit, b.Unit)
+	}
+	if a.Description == "" {
+		a.Description = b.Description
+	}
+	var err error
+	a.Data, err = MergeAggregation(a.Data, b.Data)
+	return a, err
+}
+
+// MergeAggregation returns the aggregations a and b combined. Both
+// aggregations need to be of the same type, and, if applicable, have the same
+// temporality, monotonicity, and bucket boundaries. Otherwise, an error
+// wrapping [ErrIncompatible] is returned.
+//
+// Data points with the same attributes are merged. Sum values, histogram
+// counts and sums are added, the earliest start time and latest time are
+// used, and exemplars are combined. Gauge data points are merged by keeping
+// the latest one. Exponential histogram data points of different scales are
+// merged at the lower scale. Summary data points cannot be merged, an error is
+// returned if both aggregations contain one for the same attributes.
+//
+// If either a or b is nil, the other is returned.
+func MergeAggregation(a, b metricdata.Aggregation) (metricdata.Aggregation, error) {
+	if a == nil {
+		return b, nil
+	}
+	if b == nil {
+		return a, nil
+	}
+
+	switch a := a.(type) {
+	case metricdata.Gauge[int64]:
+		return mergeAs(a, b, mergeGauge[int64])
+	case metricdata.Gauge[float64]:
+		return mergeAs(a, b, mergeGauge[float64])
+	case metricdata.Sum[int64]:
+		return mergeAs(a, b, mergeSum[int64])
+	case metricdata.Sum[float64]:
+		return mergeAs(a, b, mergeSum[float64])
+	case metricdata.Histogram[int64]:
+		return mergeAs(a, b, mergeHistogram[int64])
+	case metricdata.Histogram[float64]:
+		return mergeAs(a, b, mergeHistogram[float64])
+	case metricdata.ExponentialHistogram[int64]:
+		return mergeAs(a, b, mergeExponentialHistogram[int64])
+	case metricdata.ExponentialHistogram[float64]:
+		return mergeAs(a, b, mergeExponentialHistogram[float64])
+	case metricdata.Summary:
+		return mergeAs(a, b, mergeSummary)
+	default:
+		return nil, fmt.Errorf("%w: unknown aggregation %T", ErrIncompatible, a)
+	}
+}
+
+// mergeAs merges a and b with f if b is of the same type as a.
+func mergeAs[A metricdata.Aggregation](a A, b metricdata.Aggregation, f func(A, A) (A, error)) (metricdata.Aggregation, error) {
+	bAgg, ok := b.(A)
+	if !ok {
+		return nil, fmt.Errorf("%w: aggregations %T and %T", ErrIncompatible, a, b)
+	}
+	return f(a, bAgg)
+}
+
+func mergeGauge[N int64 | float64](a, b metricdata.Gauge[N]) (metricdata.Gauge[N], error) {
+	var err error
+	a.DataPoints, err = mergePoints(a.DataPoints, b.DataPoints, dataPointAttrs[N], lastDataPoint[N])
+	return a, err
+}
+
+func mergeSum[N int64 | float64](a, b metricdata.Sum[N]) (metricdata.Sum[N], error) {
+	if a.Temporality != b.Temporality {
+		return a, fmt.Errorf("%w: temporalities %s and %s", ErrIncompatible, a.Temporality, b.Temporality)
+	}
+	if a.IsMonotonic != b.IsMonotonic {
+		return a, fmt.Errorf("%w: monotonic and non-monotonic sums", ErrIncompatible)
+	}
+	var err error
+	a.DataPoints, err = mergePoints(a.DataPoints, b.DataPoints, dataPointAttrs[N], mergeDataPoint[N])
+	return a, err
+}
+
+func mergeHistogram[N int64 | float64](a, b metricdata.Histogram[N]) (metricdata.Histogram[N], error) {
+	if a.Temporality != b.Temporality {
+		return a, fmt.Errorf("%w: temporalities %s and %s", ErrIncompatible, a.Temporality, b.Temporality)
+	}
+	var err error
+	a.DataPoints, err = mergePoints(a.DataPoints, b.DataPoints, histogramDataPointAttrs[N], mergeHistogramDataPoint[N])
+	return a, err
+}
+
+func mergeExponentialHistogram[N int64 | float64](a, b metricdata.ExponentialHistogram[N]) (metricdata.ExponentialHistogram[N], error) {
+	if a.Temporality != b.Temporality {
+		return a, fmt.Errorf("%w: temporalities %s and %s", ErrIncompatible, a.Temporality, b.Temporality)
+	}
+	var err error
+	a.DataPoints, err = mergePoints(a.DataPoints, b.DataPoints, exponentialHistogramDataPointAttrs[N], MergeExponentialHistogramDataPoints[N])
+	return a, err
+}
+
+func mergeSummary(a, b metricdata.Summary) (metricdata.Summary, error) {
+	var err error
+	a.DataPoints, err = mergePoints(a.DataPoints, b.DataPoints, summaryDataPointAttrs, mergeSummaryDataPoint)
+	return a, err
+}
+
+// mergePoints returns the data points of a and b combined. Points with the
+// same attributes, as returned by attrs, are merged with f.
+func mergePoints[P any](a, b []P, attrs func(P) attribute.Set, f func(P, P) (P, error)) ([]P, error) {
+	if len(a) == 0 && len(b) == 0 {
+		return a, nil
+	}
+
+	var errs []error
+	out := make([]P, 0, len(a)+len(b))
+	index := make(map[attribute.Distinct]int, len(a)+len(b))
+	for _, pts := range [][]P{a, b} {
+		for _, p := range pts {
+			set := attrs(p)
+			i, ok := index[set.Equivalent()]
+			if !ok {
+				index[set.Equivalent()] = len(out)
+				out = append(out, p)
+				continue
+			}
+
+			merged, err := f(out[i], p)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("attributes %s: %w", set.Encoded(attribute.DefaultEncoder()), err))
+				continue
+			}
+			out[i] = merged
+		}
+	}
+	return out, errors.Join(errs...)
+}
+
+func dataPointAttrs[N int64 | float64](p metricdata.DataPoint[N]) attribute.Set { return p.Attributes }
+
+func histogramDataPointAttrs[N int64 | float64](p metricdata.HistogramDataPoint[N]) attribute.Set {
+	return p.Attributes
+}
+
+func exponentialHistogramDataPointAttrs[N int64 | float64](p metricdata.ExponentialHistogramDataPoint[N]) attribute.Set {
+	return p.Attributes
+}
+
+func summaryDataPointAttrs(p metricdata.SummaryDataPoint) attribute.Set { return p.Attributes }
+
+func lastDataPoint[N int64 | float64](a, b metricdata.DataPoint[N]) (metricdata.DataPoint[N], error) {
+	if b.Time.After(a.Time) {
+		return b, nil
+	}
+	return a, nil
+}
+
+func mergeDataPoint[N int64 | float64](a, b metricdata.DataPoint[N]) (metricdata.DataPoint[N], error) {
+	a.StartTime = earliest(a.StartTime, b.StartTime)
+	a.Time = latest(a.Time, b.Time)
+	a.Value += b.Value
+	a.Exemplars = concat(a.Exemplars, b.Exemplars)
+	return a, nil
+}
+
+func mergeHistogramDataPoint[N int64 | float64](a, b metricdata.HistogramDataPoint[N]) (metricdata.HistogramDataPoint[N], error) {
+	if !slices.Equal(a.Bounds, b.Bounds) {
+		return a, fmt.Errorf("%w: bounds %v and %v", ErrIncompatible, a.Bounds, b.Bounds)
+	}
+	if len(a.BucketCounts) != len(b.BucketCounts) {
+		return a, fmt.Errorf("%w: %d and %d bucket counts", ErrIncompatible, len(a.BucketCounts), len(b.BucketCounts))
+	}
+
+	counts := make([]uint64, len(a.BucketCounts))
+	for i := range counts {
+		counts[i] = a.BucketCounts[i] + b.BucketCounts[i]
+	}
+	a.Min = mergeExtrema(a.Min, a.Count, b.Min, b.Count, minimum[N])
+	a.Max = mergeExtrema(a.Max, a.Count, b.Max, b.Count, maximum[N])
+
+	a.StartTime = earliest(a.StartTime, b.StartTime)
+	a.Time = latest(a.Time, b.Time)
+	a.Count += b.Count
+	a.BucketCounts = counts
+	a.Sum += b.Sum
+	a.Exemplars = concat(a.Exemplars, b.Exemplars)
+	return a, nil
+}
+
+func mergeSummaryDataPoint(a, _ metricdata.SummaryDataPoint) (metricdata.SummaryDataPoint, error) {
+	return a, fmt.Errorf("%w: summary data points cannot be merged", ErrIncompatible)
+}
+
+// mergeExtrema returns the extrema a and b, of data sets with aCount and
+// bCount values, combined with f. The returned extrema is only defined if it
+// is known for all values of both sets.
+func mergeExtrema[N int64 | float64](a metricdata.Extrema[N], aCount uint64, b metricdata.Extrema[N], bCount uint64, f func(N, N) N) metricdata.Extrema[N] {
+	aVal, aOK := a.Value()
+	bVal, bOK := b.Value()
+	switch {
+	case aOK && bOK:
+		return metricdata.NewExtrema(f(aVal, bVal))
+	case aOK && bCount == 0:
+		return a
+	case bOK && aCount == 0:
+		return b
+	default:
+		return metricdata.Extrema[N]{}
+	}
+}
+
+func minimum[N int64 | float64](a, b N) N { return min(a, b) }
+
+func maximum[N int64 | float64](a, b N) N { return max(a, b) }
+
+// earliest returns the earliest non-zero time of a and b.
+func earliest(a, b time.Time) time.Time {
+	if a.IsZero() || (!b.IsZero() && b.Before(a)) {
+		return b
+	}
+	return a
+}
+
+// latest returns the latest time of a and b.
+func latest(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// concat returns a new slice containing the elements of a and b. If either is
+// empty, the other is returned.
+func concat[E any](a, b []E) []E {
+	if len(b) == 0 {
+		return a
+	}
+	if len(a) == 0 {
+		return b
+	}
+	return append(slices.Clip(a), b...)
+}
@@ -0,0 +1,250 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricdataops // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdataops"
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+var (
+	alice = attribute.NewSet(attribute.String("user", "alice"), attribute.String("region", "eu"))
+	bob   = attribute.NewSet(attribute.String("user", "bob"), attribute.String("region", "eu"))
+
+	t0 = time.Unix(0, 0)
+	t1 = t0.Add(time.Second)
+	t2 = t1.Add(time.Second)
+	t3 = t2.Add(time.Second)
+
+	scopeA = instrumentation.Scope{Name: "a"}
+	scopeB = instrumentation.Scope{Name: "b"}
+)
+
+func sum(temporality metricdata.Temporality, pts ...metricdata.DataPoint[int64]) metricdata.Sum[int64] {
+	return metricdata.Sum[int64]{Temporality: temporality, IsMonotonic: true, DataPoints: pts}
+}
+
+func point(attrs attribute.Set, start, end time.Time, v int64) metricdata.DataPoint[int64] {
+	return metricdata.DataPoint[int64]{Attributes: attrs, StartTime: start, Time: end, Value: v}
+}
+
+func TestMerge(t *testing.T) {
+	a := metricdata.ResourceMetrics{
+		Resource: resource.NewSchemaless(attribute.String("host", "a")),
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope: scopeA,
+			Metrics: []metricdata.Metrics{
+				{Name: "requests", Unit: "1", Data: sum(metricdata.CumulativeTemporality, point(alice, t0, t1, 1))},
+				{Name: "only.a", Data: sum(metricdata.CumulativeTemporality, point(alice, t0, t1, 1))},
+			},
+		}},
+	}
+	b := metricdata.ResourceMetrics{
+		Resource: resource.NewSchemaless(attribute.String("service", "b")),
+		ScopeMetrics: []metricdata.ScopeMetrics{
+			{
+				Scope: scopeA,
+				Metrics: []metricdata.Metrics{{
+					Name:        "requests",
+					Description: "Number of requests",
+					Unit:        "1",
+					Data: sum(
+						metricdata.CumulativeTemporality,
+						point(alice, t1, t2, 2),
+						point(bob, t1, t2, 3),
+					),
+				}},
+			},
+			{
+				Scope:   scopeB,
+				Metrics: []metricdata.Metrics{{Name: "only.b", Data: sum(metricdata.DeltaTemporality)}},
+			},
+		},
+	}
+
+	got, err := Merge(a, b)
+	require.NoError(t, err)
+
+	want := metricdata.ResourceMetrics{
+		Resource: resource.NewSchemaless(attribute.String("host", "a"), attribute.String("service", "b")),
+		ScopeMetrics: []metricdata.ScopeMetrics{
+			{
+				Scope: scopeA,
+				Metrics: []metricdata.Metrics{
+					{
+						Name:        "requests",
+						Description: "Number of requests",
+						Unit:        "1",
+						Data: sum(
+							metricdata.CumulativeTemporality,
+							point(alice, t0, t2, 3),
+							point(bob, t1, t2, 3),
+						),
+					},
+					{Name: "only.a", Data: sum(metricdata.CumulativeTemporality, point(alice, t0, t1, 1))},
+				},
+			},
+			{
+				Scope:   scopeB,
+				Metrics: []metricdata.Metrics{{Name: "only.b", Data: sum(metricdata.DeltaTemporality)}},
+			},
+		},
+	}
+	metricdatatest.AssertEqual(t, want, got)
+
+	// The inputs are not modified.
+	assert.Len(t, a.ScopeMetrics[0].Metrics, 2)
+	assert.Equal(t, int64(1), a.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64]).DataPoints[0].Value)
+}
+
+func TestMergeIncompatible(t *testing.T) {
+	rm := func(unit string, data metricdata.Aggregation) metricdata.ResourceMetrics {
+		return metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope:   scopeA,
+			Metrics: []metricdata.Metrics{{Name: "m", Unit: unit, Data: data}},
+		}}}
+	}
+
+	cumulative := sum(metricdata.CumulativeTemporality, point(alice, t0, t1, 1))
+	tests := map[string]struct {
+		a, b metricdata.ResourceMetrics
+	}{
+		"Unit": {
+			a: rm("s", cumulative),
+			b: rm("ms", cumulative),
+		},
+		"Type": {
+			a: rm("", cumulative),
+			b: rm("", metricdata.Gauge[int64]{}),
+		},
+		"Temporality": {
+			a: rm("", cumulative),
+			b: rm("", sum(metricdata.DeltaTemporality)),
+		},
+		"Monotonicity": {
+			a: rm("", cumulative),
+			b: rm("", metricdata.Sum[int64]{Temporality: metricdata.CumulativeTemporality}),
+		},
+		"Bounds": {
+			a: rm("", metricdata.Histogram[int64]{DataPoints: []metricdata.HistogramDataPoint[int64]{
+				{Attributes: alice, Bounds: []float64{1}, BucketCounts: []uint64{0, 1}},
+			}}),
+			b: rm("", metricdata.Histogram[int64]{DataPoints: []metricdata.HistogramDataPoint[int64]{
+				{Attributes: alice, Bounds: []float64{2}, BucketCounts: []uint64{0, 1}},
+			}}),
+		},
+		"Summary": {
+			a: rm("", metricdata.Summary{DataPoints: []metricdata.SummaryDataPoint{{Attributes: alice}}}),
+			b: rm("", metricdata.Summary{DataPoints: []metricdata.SummaryDataPoint{{Attributes: alice}}}),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Merge(test.a, test.b)
+			assert.ErrorIs(t, err, ErrIncompatible)
+		})
+	}
+}
+
+func TestMergeAggregationGauge(t *testing.T) {
+	a := metricdata.Gauge[float64]{DataPoints: []metricdata.DataPoint[float64]{
+		{Attributes: alice, Time: t2, Value: 2},
+		{Attributes: bob, Time: t1, Value: 1},
+	}}
+	b := metricdata.Gauge[float64]{DataPoints: []metricdata.DataPoint[float64]{
+		{Attributes: alice, Time: t1, Value: 10},
+		{Attributes: bob, Time: t2, Value: 20},
+	}}
+
+	got, err := MergeAggregation(a, b)
+	require.NoError(t, err)
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Gauge[float64]{DataPoints: []metricdata.DataPoint[float64]{
+		{Attributes: alice, Time: t2, Value: 2},
+		{Attributes: bob, Time: t2, Value: 20},
+	}}, got)
+}
+
+func TestMergeAggregationHistogram(t *testing.T) {
+	ex := metricdata.Exemplar[int64]{Time: t1, Value: 3}
+	a := metricdata.Histogram[int64]{
+		Temporality: metricdata.DeltaTemporality,
+		DataPoints: []metricdata.HistogramDataPoint[int64]{{
+			Attributes:   alice,
+			StartTime:    t0,
+			Time:         t1,
+			Count:        2,
+			Bounds:       []float64{0, 5},
+			BucketCounts: []uint64{0, 1, 1},
+			Min:          metricdata.NewExtrema[int64](3),
+			Max:          metricdata.NewExtrema[int64](7),
+			Sum:          10,
+			Exemplars:    []metricdata.Exemplar[int64]{ex},
+		}},
+	}
+	b := metricdata.Histogram[int64]{
+		Temporality: metricdata.DeltaTemporality,
+		DataPoints: []metricdata.HistogramDataPoint[int64]{{
+			Attributes:   alice,
+			StartTime:    t1,
+			Time:         t2,
+			Count:        1,
+			Bounds:       []float64{0, 5},
+			BucketCounts: []uint64{1, 0, 0},
+			Min:          metricdata.NewExtrema[int64](-1),
+			Max:          metricdata.NewExtrema[int64](-1),
+			Sum:          -1,
+		}},
+	}
+
+	got, err := MergeAggregation(a, b)
+	require.NoError(t, err)
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Histogram[int64]{
+		Temporality: metricdata.DeltaTemporality,
+		DataPoints: []metricdata.HistogramDataPoint[int64]{{
+			Attributes:   alice,
+			StartTime:    t0,
+			Time:         t2,
+			Count:        3,
+			Bounds:       []float64{0, 5},
+			BucketCounts: []uint64{1, 1, 1},
+			Min:          metricdata.NewExtrema[int64](-1),
+			Max:          metricdata.NewExtrema[int64](7),
+			Sum:          9,
+			Exemplars:    []metricdata.Exemplar[int64]{ex},
+		}},
+	}, got)
+	assert.Equal(t, []uint64{0, 1, 1}, a.DataPoints[0].BucketCounts, "input modified")
+}
+
+func TestMergeAggregationNil(t *testing.T) {
+	agg := sum(metricdata.DeltaTemporality)
+
+	got, err := MergeAggregation(nil, agg)
+	require.NoError(t, err)
+	assert.Equal(t, agg, got)
+
+	got, err = MergeAggregation(agg, nil)
+	require.NoError(t, err)
+	assert.Equal(t, agg, got)
+}
+
+func TestMergeExtrema(t *testing.T) {
+	set := metricdata.NewExtrema[int64](1)
+	var unset metricdata.Extrema[int64]
+
+	assert.Equal(t, set, mergeExtrema(set, 1, unset, 0, minimum[int64]), "empty data set not ignored")
+	assert.Equal(t, set, mergeExtrema(unset, 0, set, 1, minimum[int64]), "empty data set not ignored")
+	assert.Equal(t, unset, mergeExtrema(set, 1, unset, 1, minimum[int64]), "unknown extrema not propagated")
+	assert.Equal(t, metricdata.NewExtrema[int64](0), mergeExtrema(set, 1, metricdata.NewExtrema[int64](0), 1, minimum[int64]))
+}
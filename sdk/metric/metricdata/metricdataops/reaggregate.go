@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricdataops // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdataops"
+
+import (
+	"fmt"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// Reaggregate returns agg with the attributes of its data points reduced to
+// those keep returns true for. Data points that end up with the same
+// attributes are merged the same way [MergeAggregation] merges them. The
+// attributes dropped from a data point are added to the filtered attributes
+// of its exemplars.
+//
+// A nil keep drops all attributes. An error is returned if data points that
+// cannot be merged, i.e. summary data points, end up with the same
+// attributes.
+func Reaggregate(agg metricdata.Aggregation, keep attribute.Filter) (metricdata.Aggregation, error) {
+	if keep == nil {
+		keep = func(attribute.KeyValue) bool { return false }
+	}
+
+	switch agg := agg.(type) {
+	case nil:
+		return nil, nil
+	case metricdata.Gauge[int64]:
+		return reaggregateGauge(agg, keep)
+	case metricdata.Gauge[float64]:
+		return reaggregateGauge(agg, keep)
+	case metricdata.Sum[int64]:
+		return reaggregateSum(agg, keep)
+	case metricdata.Sum[float64]:
+		return reaggregateSum(agg, keep)
+	case metricdata.Histogram[int64]:
+		return reaggregateHistogram(agg, keep)
+	case metricdata.Histogram[float64]:
+		return reaggregateHistogram(agg, keep)
+	case metricdata.ExponentialHistogram[int64]:
+		return reaggregateExponentialHistogram(agg, keep)
+	case metricdata.ExponentialHistogram[float64]:
+		return reaggregateExponentialHistogram(agg, keep)
+	case metricdata.Summary:
+		return reaggregateSummary(agg, keep)
+	default:
+		return nil, fmt.Errorf("%w: unknown aggregation %T", ErrIncompatible, agg)
+	}
+}
+
+func reaggregateGauge[N int64 | float64](g metricdata.Gauge[N], keep attribute.Filter) (metricdata.Gauge[N], error) {
+	var err error
+	g.DataPoints, err = reaggregatePoints(g.DataPoints, keep, func(p metricdata.DataPoint[N]) (attribute.Set, []metricdata.Exemplar[N]) {
+		return p.Attributes, p.Exemplars
+	}, func(p metricdata.DataPoint[N], attrs attribute.Set, ex []metricdata.Exemplar[N]) metricdata.DataPoint[N] {
+		p.Attributes, p.Exemplars = attrs, ex
+		return p
+	}, lastDataPoint[N])
+	return g, err
+}
+
+func reaggregateSum[N int64 | float64](s metricdata.Sum[N], keep attribute.Filter) (metricdata.Sum[N], error) {
+	var err error
+	s.DataPoints, err = reaggregatePoints(s.DataPoints, keep, func(p metricdata.DataPoint[N]) (attribute.Set, []metricdata.Exemplar[N]) {
+		return p.Attributes, p.Exemplars
+	}, func(p metricdata.DataPoint[N], attrs attribute.Set, ex []metricdata.Exemplar[N]) metricdata.DataPoint[N] {
+		p.Attributes, p.Exemplars = attrs, ex
+		return p
+	}, mergeDataPoint[N])
+	return s, err
+}
+
+func reaggregateHistogram[N int64 | float64](h metricdata.Histogram[N], keep attribute.Filter) (metricdata.Histogram[N], error) {
+	var err error
+	h.DataPoints, err = reaggregatePoints(h.DataPoints, keep, func(p metricdata.HistogramDataPoint[N]) (attribute.Set, []metricdata.Exemplar[N]) {
+		return p.Attributes, p.Exemplars
+	}, func(p metricdata.HistogramDataPoint[N], attrs attribute.Set, ex []metricdata.Exemplar[N]) metricdata.HistogramDataPoint[N] {
+		p.Attributes, p.Exemplars = attrs, ex
+		return p
+	}, mergeHistogramDataPoint[N])
+	return h, err
+}
+
+func reaggregateExponentialHistogram[N int64 | float64](h metricdata.ExponentialHistogram[N], keep attribute.Filter) (metricdata.ExponentialHistogram[N], error) {
+	var err error
+	h.DataPoints, err = reaggregatePoints(h.DataPoints, keep, func(p metricdata.ExponentialHistogramDataPoint[N]) (attribute.Set, []metricdata.Exemplar[N]) {
+		return p.Attributes, p.Exemplars
+	}, func(p metricdata.ExponentialHistogramDataPoint[N], attrs attribute.Set, ex []metricdata.Exemplar[N]) metricdata.ExponentialHistogramDataPoint[N] {
+		p.Attributes, p.Exemplars = attrs, ex
+		return p
+	}, MergeExponentialHistogramDataPoints[N])
+	return h, err
+}
+
+func reaggregateSummary(s metricdata.Summary, keep attribute.Filter) (metricdata.Summary, error) {
+	var err error
+	// Summary data points have no exemplars.
+	s.DataPoints, err = reaggregatePoints(s.DataPoints, keep, func(p metricdata.SummaryDataPoint) (attribute.Set, []metricdata.Exemplar[int64]) {
+		return p.Attributes, nil
+	}, func(p metricdata.SummaryDataPoint, attrs attribute.Set, _ []metricdata.Exemplar[int64]) metricdata.SummaryDataPoint {
+		p.Attributes = attrs
+		return p
+	}, mergeSummaryDataPoint)
+	return s, err
+}
+
+// reaggregatePoints returns pts with their attributes filtered by keep and
+// the points with the same resulting attributes merged with merge. The
+// attributes and exemplars of a point are read with get and replaced with
+// set.
+func reaggregatePoints[P any, N int64 | float64](
+	pts []P,
+	keep attribute.Filter,
+	get func(P) (attribute.Set, []metricdata.Exemplar[N]),
+	set func(P, attribute.Set, []metricdata.Exemplar[N]) P,
+	merge func(P, P) (P, error),
+) ([]P, error) {
+	if len(pts) == 0 {
+		return pts, nil
+	}
+
+	filtered := make([]P, len(pts))
+	for i, p := range pts {
+		attrs, ex := get(p)
+		kept, dropped := attrs.Filter(keep)
+		filtered[i] = set(p, kept, withFiltered(ex, dropped))
+	}
+	return mergePoints(filtered[:0:0], filtered, func(p P) attribute.Set {
+		attrs, _ := get(p)
+		return attrs
+	}, merge)
+}
+
+// withFiltered returns ex with dropped added to the filtered attributes of
+// each exemplar.
+func withFiltered[N int64 | float64](ex []metricdata.Exemplar[N], dropped []attribute.KeyValue) []metricdata.Exemplar[N] {
+	if len(ex) == 0 || len(dropped) == 0 {
+		return ex
+	}
+
+	out := make([]metricdata.Exemplar[N], len(ex))
+	for i, e := range ex {
+		e.FilteredAttributes = append(slices.Clip(e.FilteredAttributes), dropped...)
+		out[i] = e
+	}
+	return out
+}
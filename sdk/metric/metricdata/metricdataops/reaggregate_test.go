@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricdataops // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdataops"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+var (
+	keepRegion = attribute.NewAllowKeysFilter("region")
+	eu         = attribute.NewSet(attribute.String("region", "eu"))
+)
+
+func TestReaggregateSum(t *testing.T) {
+	ex := metricdata.Exemplar[int64]{
+		FilteredAttributes: []attribute.KeyValue{attribute.Int("id", 1)},
+		Time:               t1,
+		Value:              2,
+	}
+	in := sum(metricdata.CumulativeTemporality, point(alice, t0, t1, 2), point(bob, t0, t1, 3))
+	in.DataPoints[0].Exemplars = []metricdata.Exemplar[int64]{ex}
+
+	got, err := Reaggregate(in, keepRegion)
+	require.NoError(t, err)
+
+	want := sum(metricdata.CumulativeTemporality, point(eu, t0, t1, 5))
+	want.DataPoints[0].Exemplars = []metricdata.Exemplar[int64]{{
+		FilteredAttributes: []attribute.KeyValue{attribute.Int("id", 1), attribute.String("user", "alice")},
+		Time:               t1,
+		Value:              2,
+	}}
+	metricdatatest.AssertAggregationsEqual(t, want, got)
+
+	// The inputs are not modified.
+	assert.Equal(t, alice, in.DataPoints[0].Attributes)
+	assert.Equal(t, []attribute.KeyValue{attribute.Int("id", 1)}, in.DataPoints[0].Exemplars[0].FilteredAttributes)
+}
+
+func TestReaggregateDropAll(t *testing.T) {
+	in := metricdata.Histogram[float64]{
+		Temporality: metricdata.DeltaTemporality,
+		DataPoints: []metricdata.HistogramDataPoint[float64]{
+			{Attributes: alice, StartTime: t0, Time: t1, Count: 1, Bounds: []float64{1}, BucketCounts: []uint64{1, 0}, Sum: 0.5},
+			{Attributes: bob, StartTime: t0, Time: t1, Count: 1, Bounds: []float64{1}, BucketCounts: []uint64{0, 1}, Sum: 2},
+		},
+	}
+
+	got, err := Reaggregate(in, nil)
+	require.NoError(t, err)
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Histogram[float64]{
+		Temporality: metricdata.DeltaTemporality,
+		DataPoints: []metricdata.HistogramDataPoint[float64]{
+			{Attributes: *attribute.EmptySet(), StartTime: t0, Time: t1, Count: 2, Bounds: []float64{1}, BucketCounts: []uint64{1, 1}, Sum: 2.5},
+		},
+	}, got)
+}
+
+func TestReaggregateGauge(t *testing.T) {
+	in := metricdata.Gauge[int64]{DataPoints: []metricdata.DataPoint[int64]{
+		point(alice, t0, t2, 2),
+		point(bob, t0, t1, 3),
+	}}
+
+	got, err := Reaggregate(in, keepRegion)
+	require.NoError(t, err)
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Gauge[int64]{
+		DataPoints: []metricdata.DataPoint[int64]{point(eu, t0, t2, 2)},
+	}, got)
+}
+
+func TestReaggregateSummary(t *testing.T) {
+	in := metricdata.Summary{DataPoints: []metricdata.SummaryDataPoint{
+		{Attributes: alice, Count: 1},
+		{Attributes: bob, Count: 2},
+	}}
+
+	got, err := Reaggregate(in, attribute.NewAllowKeysFilter("user"))
+	require.NoError(t, err)
+	assert.Len(t, got.(metricdata.Summary).DataPoints, 2)
+
+	_, err = Reaggregate(in, keepRegion)
+	assert.ErrorIs(t, err, ErrIncompatible)
+}
+
+func TestReaggregateNil(t *testing.T) {
+	got, err := Reaggregate(nil, keepRegion)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
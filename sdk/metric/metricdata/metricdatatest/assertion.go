@@ -62,6 +62,13 @@ type config struct {
 	ignoreTimestamp bool
 	ignoreExemplars bool
 	ignoreValue     bool
+
+	absTolerance float64
+	relTolerance float64
+
+	// contains is true if actual values can contain additional ScopeMetrics,
+	// Metrics, and data points.
+	contains bool
 }
 
 func newConfig(opts []Option) config {
@@ -113,17 +120,73 @@ func IgnoreValue() Option {
 	})
 }
 
+// WithAbsoluteTolerance sets the maximum absolute difference for measured
+// values to be considered equal.
+//
+// This applies to the values of DataPoints and Exemplars; the sum, min, and
+// max of HistogramDataPoints and ExponentialHistogramDataPoints; and the sum
+// and quantile values of SummaryDataPoints. Counts are always compared
+// exactly.
+//
+// If both an absolute and relative tolerance are set, values are equal if
+// they are within either.
+func WithAbsoluteTolerance(epsilon float64) Option {
+	return fnOption(func(cfg config) config {
+		cfg.absTolerance = epsilon
+		return cfg
+	})
+}
+
+// WithRelativeTolerance sets the maximum difference, relative to the larger
+// magnitude of the two, for measured values to be considered equal. For
+// example, an epsilon of 0.01 accepts values that differ by up to 1%.
+//
+// The tolerance applies to the same values as [WithAbsoluteTolerance]. If
+// both an absolute and relative tolerance are set, values are equal if they
+// are within either.
+func WithRelativeTolerance(epsilon float64) Option {
+	return fnOption(func(cfg config) config {
+		cfg.relTolerance = epsilon
+		return cfg
+	})
+}
+
 // AssertEqual asserts that the two concrete data-types from the metricdata
 // package are equal.
+//
+// Differences are reported with their path relative to the compared values,
+// e.g. ScopeMetrics["scope"].Metrics["name"].Data.DataPoints[{key=value}].Value.
 func AssertEqual[T Datatypes](t TestingT, expected, actual T, opts ...Option) bool {
 	t.Helper()
 
 	cfg := newConfig(opts)
+	if r := equal(expected, actual, cfg); len(r) > 0 {
+		t.Error(format(fmt.Sprintf("%T not equal:", expected), r))
+		return false
+	}
+	return true
+}
+
+// AssertContains asserts that actual contains the concrete data-type
+// expected. It differs from [AssertEqual] in that actual can contain
+// additional ScopeMetrics, Metrics, and data points. These are still
+// compared regardless of their order.
+func AssertContains[T Datatypes](t TestingT, expected, actual T, opts ...Option) bool {
+	t.Helper()
+
+	cfg := newConfig(opts)
+	cfg.contains = true
+	if r := equal(expected, actual, cfg); len(r) > 0 {
+		t.Error(format(fmt.Sprintf("%T does not contain expected values:", expected), r))
+		return false
+	}
+	return true
+}
 
+func equal[T Datatypes](expected, actual T, cfg config) (r []reason) {
 	// Generic types cannot be type asserted. Use an interface instead.
 	aIface := interface{}(actual)
 
-	var r []string
 	switch e := interface{}(expected).(type) {
 	case metricdata.Exemplar[int64]:
 		r = equalExemplars(e, aIface.(metricdata.Exemplar[int64]), cfg)
@@ -180,21 +243,44 @@ func AssertEqual[T Datatypes](t TestingT, expected, actual T, opts ...Option) bo
 		// early they changed things in an incompatible way.
 		panic(fmt.Sprintf("unknown types: %T", expected))
 	}
+	return r
+}
+
+// AssertAggregationsEqual asserts that two Aggregations are equal.
+func AssertAggregationsEqual(t TestingT, expected, actual metricdata.Aggregation, opts ...Option) bool {
+	t.Helper()
 
-	if len(r) > 0 {
-		t.Error(r)
+	cfg := newConfig(opts)
+	if r := equalAggregations(expected, actual, cfg); len(r) > 0 {
+		t.Error(format(fmt.Sprintf("%T not equal:", expected), r))
 		return false
 	}
 	return true
 }
 
-// AssertAggregationsEqual asserts that two Aggregations are equal.
-func AssertAggregationsEqual(t TestingT, expected, actual metricdata.Aggregation, opts ...Option) bool {
+// AssertAggregationsContain asserts that the Aggregation actual contains all
+// data points of expected. Additional data points in actual are ignored.
+func AssertAggregationsContain(t TestingT, expected, actual metricdata.Aggregation, opts ...Option) bool {
 	t.Helper()
 
 	cfg := newConfig(opts)
+	cfg.contains = true
 	if r := equalAggregations(expected, actual, cfg); len(r) > 0 {
-		t.Error(r)
+		t.Error(format(fmt.Sprintf("%T does not contain expected data points:", expected), r))
+		return false
+	}
+	return true
+}
+
+// AssertBucketsInRange asserts that all values recorded by the histogram data
+// point dp are within the range [lower, upper]. A value is considered within
+// the range if the bucket it is counted in overlaps the range. The min and max
+// of dp, if recorded, need to be within the range.
+func AssertBucketsInRange[N int64 | float64](t TestingT, dp metricdata.HistogramDataPoint[N], lower, upper float64) bool {
+	t.Helper()
+
+	if r := bucketsInRange(dp, lower, upper); len(r) > 0 {
+		t.Error(format(fmt.Sprintf("%T values not in range:", dp), r))
 		return false
 	}
 	return true
@@ -5,6 +5,7 @@ package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -621,7 +622,7 @@ var (
 	}
 )
 
-type equalFunc[T Datatypes] func(T, T, config) []string
+type equalFunc[T Datatypes] func(T, T, config) []reason
 
 func testDatatype[T Datatypes](a, b T, f equalFunc[T]) func(*testing.T) {
 	return func(t *testing.T) {
@@ -881,6 +882,125 @@ func TestAssertAggregationsEqual(t *testing.T) {
 	assert.Len(t, r, 0, "value should be ignored: %v == %v", summaryA, summaryD)
 }
 
+// recordT records the errors of an assertion.
+type recordT struct{ errs []string }
+
+func (*recordT) Helper() {}
+
+func (t *recordT) Error(args ...any) { t.errs = append(t.errs, fmt.Sprint(args...)) }
+
+func TestAssertEqualTolerance(t *testing.T) {
+	a := metricdata.Sum[float64]{
+		Temporality: metricdata.DeltaTemporality,
+		DataPoints:  []metricdata.DataPoint[float64]{{Attributes: attrA, Value: 100}},
+	}
+	b := metricdata.Sum[float64]{
+		Temporality: metricdata.DeltaTemporality,
+		DataPoints:  []metricdata.DataPoint[float64]{{Attributes: attrA, Value: 100.5}},
+	}
+
+	fakeT := new(recordT)
+	assert.False(t, AssertEqual(fakeT, a, b), "exact comparison")
+	assert.True(t, AssertEqual(t, a, b, WithAbsoluteTolerance(0.5)), "absolute tolerance")
+	assert.False(t, AssertEqual(fakeT, a, b, WithAbsoluteTolerance(0.4)), "absolute tolerance exceeded")
+	assert.True(t, AssertEqual(t, a, b, WithRelativeTolerance(0.01)), "relative tolerance")
+	assert.False(t, AssertEqual(fakeT, a, b, WithRelativeTolerance(0.001)), "relative tolerance exceeded")
+	assert.True(t, AssertEqual(t, a, b, WithAbsoluteTolerance(0.1), WithRelativeTolerance(0.01)), "either tolerance")
+
+	h := func(sum float64, count uint64, maximum float64) metricdata.HistogramDataPoint[float64] {
+		return metricdata.HistogramDataPoint[float64]{
+			Attributes:   attrA,
+			Count:        count,
+			Bounds:       []float64{1},
+			BucketCounts: []uint64{0, count},
+			Max:          metricdata.NewExtrema(maximum),
+			Sum:          sum,
+		}
+	}
+	assert.True(t, AssertEqual(t, h(10, 2, 6), h(10.01, 2, 6.01), WithAbsoluteTolerance(0.1)), "histogram sum and max")
+	assert.False(t, AssertEqual(fakeT, h(10, 2, 6), h(10, 3, 6), WithAbsoluteTolerance(1)), "counts compared with tolerance")
+}
+
+func TestAssertContains(t *testing.T) {
+	ab := metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints:  []metricdata.DataPoint[int64]{dataPointInt64B, dataPointInt64A},
+	}
+
+	assert.True(t, AssertAggregationsContain(t, sumInt64A, ab), "data point not contained")
+	assert.True(t, AssertContains(t, sumInt64A, ab), "data point not contained")
+
+	fakeT := new(recordT)
+	assert.False(t, AssertAggregationsEqual(fakeT, sumInt64A, ab), "additional data point equal")
+	assert.False(t, AssertAggregationsContain(fakeT, ab, sumInt64A), "missing data point contained")
+	assert.False(t, AssertAggregationsContain(fakeT, sumInt64A, gaugeInt64A), "different type contained")
+
+	rm := metricdata.ResourceMetrics{
+		Resource: resourceMetricsA.Resource,
+		ScopeMetrics: []metricdata.ScopeMetrics{
+			scopeMetricsB,
+			{Scope: scopeMetricsA.Scope, Metrics: []metricdata.Metrics{metricsB, metricsA}},
+		},
+	}
+	assert.True(t, AssertContains(t, resourceMetricsA, rm), "metrics not contained")
+}
+
+func TestAssertBucketsInRange(t *testing.T) {
+	dp := metricdata.HistogramDataPoint[float64]{
+		Count:        3,
+		Bounds:       []float64{0, 10, 25, 50},
+		BucketCounts: []uint64{0, 1, 2, 0, 0},
+		Min:          metricdata.NewExtrema(5.),
+		Max:          metricdata.NewExtrema(20.),
+	}
+
+	assert.True(t, AssertBucketsInRange(t, dp, 5, 20))
+	assert.True(t, AssertBucketsInRange(t, dp, 0, 100))
+
+	fakeT := new(recordT)
+	assert.False(t, AssertBucketsInRange(fakeT, dp, 11, 20), "bucket below range")
+	assert.False(t, AssertBucketsInRange(fakeT, dp, 5, 10), "bucket above range")
+
+	dp.Min = metricdata.Extrema[float64]{}
+	dp.Max = metricdata.NewExtrema(30.)
+	assert.False(t, AssertBucketsInRange(fakeT, dp, 5, 25), "max above range")
+
+	dp.BucketCounts = dp.BucketCounts[:2]
+	assert.False(t, AssertBucketsInRange(fakeT, dp, 0, 100), "invalid bucket counts")
+}
+
+func TestAssertEqualPathDiff(t *testing.T) {
+	expected := resourceMetricsA
+	actual := metricdata.ResourceMetrics{
+		Resource: resourceMetricsA.Resource,
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope: scopeMetricsA.Scope,
+			Metrics: []metricdata.Metrics{{
+				Name:        metricsA.Name,
+				Description: metricsA.Description,
+				Unit:        metricsA.Unit,
+				Data:        sumInt64D,
+			}},
+		}},
+	}
+
+	fakeT := new(recordT)
+	assert.False(t, AssertEqual(fakeT, expected, actual))
+	assert.Equal(t, []string{
+		`metricdata.ResourceMetrics not equal:
+	ScopeMetrics["A"].Metrics["A"].Data.DataPoints[{A=true}].Value: expected -1, actual 2`,
+	}, fakeT.errs)
+
+	fakeT = new(recordT)
+	assert.False(t, AssertAggregationsEqual(fakeT, sumInt64A, sumInt64B))
+	assert.Equal(t, []string{
+		`metricdata.Sum[int64] not equal:
+	DataPoints[{A=true}]: missing expected value
+	DataPoints[{B=true}]: unexpected value`,
+	}, fakeT.errs)
+}
+
 func TestAssertAttributes(t *testing.T) {
 	AssertHasAttributes(t, minFloat64A, attribute.Bool("A", true)) // No-op, always pass.
 	AssertHasAttributes(t, exemplarInt64A, attribute.Bool("filter A", true))
@@ -4,36 +4,74 @@
 package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 
 import (
-	"bytes"
 	"fmt"
+	"math"
 	"reflect"
 	"slices"
+	"strings"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
+// reason describes a difference between an expected and actual value.
+type reason struct {
+	// path is the location of the difference relative to the compared value,
+	// e.g. Data.DataPoints[{A=true}].Value. It is empty if the compared
+	// values themselves differ.
+	path string
+	msg  string
+}
+
+func (r reason) String() string {
+	if r.path == "" {
+		return r.msg
+	}
+	return r.path + ": " + r.msg
+}
+
+// notEqual returns a reason the expected and actual values at path differ.
+func notEqual(path string, expected, actual interface{}) reason {
+	return reason{path: path, msg: fmt.Sprintf("expected %v, actual %v", expected, actual)}
+}
+
+// within returns reasons with their paths nested under path.
+func within(path string, reasons []reason) []reason {
+	for i, r := range reasons {
+		switch {
+		case r.path == "":
+			reasons[i].path = path
+		case strings.HasPrefix(r.path, "["):
+			reasons[i].path = path + r.path
+		default:
+			reasons[i].path = path + "." + r.path
+		}
+	}
+	return reasons
+}
+
+// format returns a failure message describing reasons.
+func format(header string, reasons []reason) string {
+	var b strings.Builder
+	_, _ = b.WriteString(header)
+	for _, r := range reasons {
+		_, _ = b.WriteString("\n\t")
+		_, _ = b.WriteString(r.String())
+	}
+	return b.String()
+}
+
 // equalResourceMetrics returns reasons ResourceMetrics are not equal. If they
 // are equal, the returned reasons will be empty.
 //
 // The ScopeMetrics each ResourceMetrics contains are compared based on
 // containing the same ScopeMetrics, not the order they are stored in.
-func equalResourceMetrics(a, b metricdata.ResourceMetrics, cfg config) (reasons []string) {
+func equalResourceMetrics(a, b metricdata.ResourceMetrics, cfg config) (reasons []reason) {
 	if !a.Resource.Equal(b.Resource) {
-		reasons = append(reasons, notEqualStr("Resources", a.Resource, b.Resource))
+		reasons = append(reasons, notEqual("Resource", a.Resource, b.Resource))
 	}
 
-	r := compareDiff(diffSlices(
-		a.ScopeMetrics,
-		b.ScopeMetrics,
-		func(a, b metricdata.ScopeMetrics) bool {
-			r := equalScopeMetrics(a, b, cfg)
-			return len(r) == 0
-		},
-	))
-	if r != "" {
-		reasons = append(reasons, fmt.Sprintf("ResourceMetrics ScopeMetrics not equal:\n%s", r))
-	}
+	reasons = append(reasons, diffSlices("ScopeMetrics", a.ScopeMetrics, b.ScopeMetrics, scopeKey, equalScopeMetrics, cfg, cfg.contains)...)
 	return reasons
 }
 
@@ -42,117 +80,67 @@ func equalResourceMetrics(a, b metricdata.ResourceMetrics, cfg config) (reasons
 //
 // The Metrics each ScopeMetrics contains are compared based on containing the
 // same Metrics, not the order they are stored in.
-func equalScopeMetrics(a, b metricdata.ScopeMetrics, cfg config) (reasons []string) {
+func equalScopeMetrics(a, b metricdata.ScopeMetrics, cfg config) (reasons []reason) {
 	if a.Scope != b.Scope {
-		reasons = append(reasons, notEqualStr("Scope", a.Scope, b.Scope))
+		reasons = append(reasons, notEqual("Scope", a.Scope, b.Scope))
 	}
 
-	r := compareDiff(diffSlices(
-		a.Metrics,
-		b.Metrics,
-		func(a, b metricdata.Metrics) bool {
-			r := equalMetrics(a, b, cfg)
-			return len(r) == 0
-		},
-	))
-	if r != "" {
-		reasons = append(reasons, fmt.Sprintf("ScopeMetrics Metrics not equal:\n%s", r))
-	}
+	reasons = append(reasons, diffSlices("Metrics", a.Metrics, b.Metrics, metricsKey, equalMetrics, cfg, cfg.contains)...)
 	return reasons
 }
 
 // equalMetrics returns reasons Metrics are not equal. If they are equal, the
 // returned reasons will be empty.
-func equalMetrics(a, b metricdata.Metrics, cfg config) (reasons []string) {
+func equalMetrics(a, b metricdata.Metrics, cfg config) (reasons []reason) {
 	if a.Name != b.Name {
-		reasons = append(reasons, notEqualStr("Name", a.Name, b.Name))
+		reasons = append(reasons, notEqual("Name", a.Name, b.Name))
 	}
 	if a.Description != b.Description {
-		reasons = append(reasons, notEqualStr("Description", a.Description, b.Description))
+		reasons = append(reasons, notEqual("Description", a.Description, b.Description))
 	}
 	if a.Unit != b.Unit {
-		reasons = append(reasons, notEqualStr("Unit", a.Unit, b.Unit))
+		reasons = append(reasons, notEqual("Unit", a.Unit, b.Unit))
 	}
 
-	r := equalAggregations(a.Data, b.Data, cfg)
-	if len(r) > 0 {
-		reasons = append(reasons, "Metrics Data not equal:")
-		reasons = append(reasons, r...)
-	}
+	reasons = append(reasons, within("Data", equalAggregations(a.Data, b.Data, cfg))...)
 	return reasons
 }
 
 // equalAggregations returns reasons a and b are not equal. If they are equal,
 // the returned reasons will be empty.
-func equalAggregations(a, b metricdata.Aggregation, cfg config) (reasons []string) {
+func equalAggregations(a, b metricdata.Aggregation, cfg config) (reasons []reason) {
 	if a == nil || b == nil {
 		if a != b {
-			return []string{notEqualStr("Aggregation", a, b)}
+			return []reason{notEqual("", a, b)}
 		}
 		return reasons
 	}
 
 	if reflect.TypeOf(a) != reflect.TypeOf(b) {
-		return []string{fmt.Sprintf("Aggregation types not equal:\nexpected: %T\nactual: %T", a, b)}
+		return []reason{notEqual("", fmt.Sprintf("%T", a), fmt.Sprintf("%T", b))}
 	}
 
 	switch v := a.(type) {
 	case metricdata.Gauge[int64]:
-		r := equalGauges(v, b.(metricdata.Gauge[int64]), cfg)
-		if len(r) > 0 {
-			reasons = append(reasons, "Gauge[int64] not equal:")
-			reasons = append(reasons, r...)
-		}
+		reasons = equalGauges(v, b.(metricdata.Gauge[int64]), cfg)
 	case metricdata.Gauge[float64]:
-		r := equalGauges(v, b.(metricdata.Gauge[float64]), cfg)
-		if len(r) > 0 {
-			reasons = append(reasons, "Gauge[float64] not equal:")
-			reasons = append(reasons, r...)
-		}
+		reasons = equalGauges(v, b.(metricdata.Gauge[float64]), cfg)
 	case metricdata.Sum[int64]:
-		r := equalSums(v, b.(metricdata.Sum[int64]), cfg)
-		if len(r) > 0 {
-			reasons = append(reasons, "Sum[int64] not equal:")
-			reasons = append(reasons, r...)
-		}
+		reasons = equalSums(v, b.(metricdata.Sum[int64]), cfg)
 	case metricdata.Sum[float64]:
-		r := equalSums(v, b.(metricdata.Sum[float64]), cfg)
-		if len(r) > 0 {
-			reasons = append(reasons, "Sum[float64] not equal:")
-			reasons = append(reasons, r...)
-		}
+		reasons = equalSums(v, b.(metricdata.Sum[float64]), cfg)
 	case metricdata.Histogram[int64]:
-		r := equalHistograms(v, b.(metricdata.Histogram[int64]), cfg)
-		if len(r) > 0 {
-			reasons = append(reasons, "Histogram not equal:")
-			reasons = append(reasons, r...)
-		}
+		reasons = equalHistograms(v, b.(metricdata.Histogram[int64]), cfg)
 	case metricdata.Histogram[float64]:
-		r := equalHistograms(v, b.(metricdata.Histogram[float64]), cfg)
-		if len(r) > 0 {
-			reasons = append(reasons, "Histogram not equal:")
-			reasons = append(reasons, r...)
-		}
+		reasons = equalHistograms(v, b.(metricdata.Histogram[float64]), cfg)
 	case metricdata.ExponentialHistogram[int64]:
-		r := equalExponentialHistograms(v, b.(metricdata.ExponentialHistogram[int64]), cfg)
-		if len(r) > 0 {
-			reasons = append(reasons, "ExponentialHistogram not equal:")
-			reasons = append(reasons, r...)
-		}
+		reasons = equalExponentialHistograms(v, b.(metricdata.ExponentialHistogram[int64]), cfg)
 	case metricdata.ExponentialHistogram[float64]:
-		r := equalExponentialHistograms(v, b.(metricdata.ExponentialHistogram[float64]), cfg)
-		if len(r) > 0 {
-			reasons = append(reasons, "ExponentialHistogram not equal:")
-			reasons = append(reasons, r...)
-		}
+		reasons = equalExponentialHistograms(v, b.(metricdata.ExponentialHistogram[float64]), cfg)
 	case metricdata.Summary:
-		r := equalSummary(v, b.(metricdata.Summary), cfg)
-		if len(r) > 0 {
-			reasons = append(reasons, "Summary not equal:")
-			reasons = append(reasons, r...)
-		}
+		reasons = equalSummary(v, b.(metricdata.Summary), cfg)
 	default:
-		reasons = append(reasons, fmt.Sprintf("Aggregation of unknown types %T", a))
+		reasons = append(reasons, reason{msg: fmt.Sprintf("unknown aggregation type %T", a)})
 	}
 	return reasons
 }
@@ -162,19 +150,8 @@ func equalAggregations(a, b metricdata.Aggregation, cfg config) (reasons []strin
 //
 // The DataPoints each Gauge contains are compared based on containing the
 // same DataPoints, not the order they are stored in.
-func equalGauges[N int64 | float64](a, b metricdata.Gauge[N], cfg config) (reasons []string) {
-	r := compareDiff(diffSlices(
-		a.DataPoints,
-		b.DataPoints,
-		func(a, b metricdata.DataPoint[N]) bool {
-			r := equalDataPoints(a, b, cfg)
-			return len(r) == 0
-		},
-	))
-	if r != "" {
-		reasons = append(reasons, fmt.Sprintf("Gauge DataPoints not equal:\n%s", r))
-	}
-	return reasons
+func equalGauges[N int64 | float64](a, b metricdata.Gauge[N], cfg config) (reasons []reason) {
+	return diffSlices("DataPoints", a.DataPoints, b.DataPoints, dataPointKey[N], equalDataPoints[N], cfg, cfg.contains)
 }
 
 // equalSums returns reasons Sums are not equal. If they are equal, the
@@ -182,25 +159,15 @@ func equalGauges[N int64 | float64](a, b metricdata.Gauge[N], cfg config) (reaso
 //
 // The DataPoints each Sum contains are compared based on containing the same
 // DataPoints, not the order they are stored in.
-func equalSums[N int64 | float64](a, b metricdata.Sum[N], cfg config) (reasons []string) {
+func equalSums[N int64 | float64](a, b metricdata.Sum[N], cfg config) (reasons []reason) {
 	if a.Temporality != b.Temporality {
-		reasons = append(reasons, notEqualStr("Temporality", a.Temporality, b.Temporality))
+		reasons = append(reasons, notEqual("Temporality", a.Temporality, b.Temporality))
 	}
 	if a.IsMonotonic != b.IsMonotonic {
-		reasons = append(reasons, notEqualStr("IsMonotonic", a.IsMonotonic, b.IsMonotonic))
+		reasons = append(reasons, notEqual("IsMonotonic", a.IsMonotonic, b.IsMonotonic))
 	}
 
-	r := compareDiff(diffSlices(
-		a.DataPoints,
-		b.DataPoints,
-		func(a, b metricdata.DataPoint[N]) bool {
-			r := equalDataPoints(a, b, cfg)
-			return len(r) == 0
-		},
-	))
-	if r != "" {
-		reasons = append(reasons, fmt.Sprintf("Sum DataPoints not equal:\n%s", r))
-	}
+	reasons = append(reasons, diffSlices("DataPoints", a.DataPoints, b.DataPoints, dataPointKey[N], equalDataPoints[N], cfg, cfg.contains)...)
 	return reasons
 }
 
@@ -209,30 +176,20 @@ func equalSums[N int64 | float64](a, b metricdata.Sum[N], cfg config) (reasons [
 //
 // The DataPoints each Histogram contains are compared based on containing the
 // same HistogramDataPoint, not the order they are stored in.
-func equalHistograms[N int64 | float64](a, b metricdata.Histogram[N], cfg config) (reasons []string) {
+func equalHistograms[N int64 | float64](a, b metricdata.Histogram[N], cfg config) (reasons []reason) {
 	if a.Temporality != b.Temporality {
-		reasons = append(reasons, notEqualStr("Temporality", a.Temporality, b.Temporality))
+		reasons = append(reasons, notEqual("Temporality", a.Temporality, b.Temporality))
 	}
 
-	r := compareDiff(diffSlices(
-		a.DataPoints,
-		b.DataPoints,
-		func(a, b metricdata.HistogramDataPoint[N]) bool {
-			r := equalHistogramDataPoints(a, b, cfg)
-			return len(r) == 0
-		},
-	))
-	if r != "" {
-		reasons = append(reasons, fmt.Sprintf("Histogram DataPoints not equal:\n%s", r))
-	}
+	reasons = append(reasons, diffSlices("DataPoints", a.DataPoints, b.DataPoints, histogramDataPointKey[N], equalHistogramDataPoints[N], cfg, cfg.contains)...)
 	return reasons
 }
 
 // equalDataPoints returns reasons DataPoints are not equal. If they are
 // equal, the returned reasons will be empty.
-func equalDataPoints[N int64 | float64](a, b metricdata.DataPoint[N], cfg config) (reasons []string) { // nolint: revive // Intentional internal control flag
+func equalDataPoints[N int64 | float64](a, b metricdata.DataPoint[N], cfg config) (reasons []reason) { // nolint: revive // Intentional internal control flag
 	if !a.Attributes.Equals(&b.Attributes) {
-		reasons = append(reasons, notEqualStr(
+		reasons = append(reasons, notEqual(
 			"Attributes",
 			a.Attributes.Encoded(attribute.DefaultEncoder()),
 			b.Attributes.Encoded(attribute.DefaultEncoder()),
@@ -241,40 +198,30 @@ func equalDataPoints[N int64 | float64](a, b metricdata.DataPoint[N], cfg config
 
 	if !cfg.ignoreTimestamp {
 		if !a.StartTime.Equal(b.StartTime) {
-			reasons = append(reasons, notEqualStr("StartTime", a.StartTime.UnixNano(), b.StartTime.UnixNano()))
+			reasons = append(reasons, notEqual("StartTime", a.StartTime.UnixNano(), b.StartTime.UnixNano()))
 		}
 		if !a.Time.Equal(b.Time) {
-			reasons = append(reasons, notEqualStr("Time", a.Time.UnixNano(), b.Time.UnixNano()))
+			reasons = append(reasons, notEqual("Time", a.Time.UnixNano(), b.Time.UnixNano()))
 		}
 	}
 
 	if !cfg.ignoreValue {
-		if a.Value != b.Value {
-			reasons = append(reasons, notEqualStr("Value", a.Value, b.Value))
+		if !equalValues(a.Value, b.Value, cfg) {
+			reasons = append(reasons, notEqual("Value", a.Value, b.Value))
 		}
 	}
 
 	if !cfg.ignoreExemplars {
-		r := compareDiff(diffSlices(
-			a.Exemplars,
-			b.Exemplars,
-			func(a, b metricdata.Exemplar[N]) bool {
-				r := equalExemplars(a, b, cfg)
-				return len(r) == 0
-			},
-		))
-		if r != "" {
-			reasons = append(reasons, fmt.Sprintf("Exemplars not equal:\n%s", r))
-		}
+		reasons = append(reasons, diffSlices("Exemplars", a.Exemplars, b.Exemplars, nil, equalExemplars[N], cfg, false)...)
 	}
 	return reasons
 }
 
 // equalHistogramDataPoints returns reasons HistogramDataPoints are not equal.
 // If they are equal, the returned reasons will be empty.
-func equalHistogramDataPoints[N int64 | float64](a, b metricdata.HistogramDataPoint[N], cfg config) (reasons []string) { // nolint: revive // Intentional internal control flag
+func equalHistogramDataPoints[N int64 | float64](a, b metricdata.HistogramDataPoint[N], cfg config) (reasons []reason) { // nolint: revive // Intentional internal control flag
 	if !a.Attributes.Equals(&b.Attributes) {
-		reasons = append(reasons, notEqualStr(
+		reasons = append(reasons, notEqual(
 			"Attributes",
 			a.Attributes.Encoded(attribute.DefaultEncoder()),
 			b.Attributes.Encoded(attribute.DefaultEncoder()),
@@ -282,44 +229,34 @@ func equalHistogramDataPoints[N int64 | float64](a, b metricdata.HistogramDataPo
 	}
 	if !cfg.ignoreTimestamp {
 		if !a.StartTime.Equal(b.StartTime) {
-			reasons = append(reasons, notEqualStr("StartTime", a.StartTime.UnixNano(), b.StartTime.UnixNano()))
+			reasons = append(reasons, notEqual("StartTime", a.StartTime.UnixNano(), b.StartTime.UnixNano()))
 		}
 		if !a.Time.Equal(b.Time) {
-			reasons = append(reasons, notEqualStr("Time", a.Time.UnixNano(), b.Time.UnixNano()))
+			reasons = append(reasons, notEqual("Time", a.Time.UnixNano(), b.Time.UnixNano()))
 		}
 	}
 	if !cfg.ignoreValue {
 		if a.Count != b.Count {
-			reasons = append(reasons, notEqualStr("Count", a.Count, b.Count))
+			reasons = append(reasons, notEqual("Count", a.Count, b.Count))
 		}
 		if !slices.Equal(a.Bounds, b.Bounds) {
-			reasons = append(reasons, notEqualStr("Bounds", a.Bounds, b.Bounds))
+			reasons = append(reasons, notEqual("Bounds", a.Bounds, b.Bounds))
 		}
 		if !slices.Equal(a.BucketCounts, b.BucketCounts) {
-			reasons = append(reasons, notEqualStr("BucketCounts", a.BucketCounts, b.BucketCounts))
+			reasons = append(reasons, notEqual("BucketCounts", a.BucketCounts, b.BucketCounts))
 		}
-		if !eqExtrema(a.Min, b.Min) {
-			reasons = append(reasons, notEqualStr("Min", a.Min, b.Min))
+		if !eqExtrema(a.Min, b.Min, cfg) {
+			reasons = append(reasons, notEqual("Min", a.Min, b.Min))
 		}
-		if !eqExtrema(a.Max, b.Max) {
-			reasons = append(reasons, notEqualStr("Max", a.Max, b.Max))
+		if !eqExtrema(a.Max, b.Max, cfg) {
+			reasons = append(reasons, notEqual("Max", a.Max, b.Max))
 		}
-		if a.Sum != b.Sum {
-			reasons = append(reasons, notEqualStr("Sum", a.Sum, b.Sum))
+		if !equalValues(a.Sum, b.Sum, cfg) {
+			reasons = append(reasons, notEqual("Sum", a.Sum, b.Sum))
 		}
 	}
 	if !cfg.ignoreExemplars {
-		r := compareDiff(diffSlices(
-			a.Exemplars,
-			b.Exemplars,
-			func(a, b metricdata.Exemplar[N]) bool {
-				r := equalExemplars(a, b, cfg)
-				return len(r) == 0
-			},
-		))
-		if r != "" {
-			reasons = append(reasons, fmt.Sprintf("Exemplars not equal:\n%s", r))
-		}
+		reasons = append(reasons, diffSlices("Exemplars", a.Exemplars, b.Exemplars, nil, equalExemplars[N], cfg, false)...)
 	}
 	return reasons
 }
@@ -329,30 +266,20 @@ func equalHistogramDataPoints[N int64 | float64](a, b metricdata.HistogramDataPo
 //
 // The DataPoints each Histogram contains are compared based on containing the
 // same HistogramDataPoint, not the order they are stored in.
-func equalExponentialHistograms[N int64 | float64](a, b metricdata.ExponentialHistogram[N], cfg config) (reasons []string) {
+func equalExponentialHistograms[N int64 | float64](a, b metricdata.ExponentialHistogram[N], cfg config) (reasons []reason) {
 	if a.Temporality != b.Temporality {
-		reasons = append(reasons, notEqualStr("Temporality", a.Temporality, b.Temporality))
+		reasons = append(reasons, notEqual("Temporality", a.Temporality, b.Temporality))
 	}
 
-	r := compareDiff(diffSlices(
-		a.DataPoints,
-		b.DataPoints,
-		func(a, b metricdata.ExponentialHistogramDataPoint[N]) bool {
-			r := equalExponentialHistogramDataPoints(a, b, cfg)
-			return len(r) == 0
-		},
-	))
-	if r != "" {
-		reasons = append(reasons, fmt.Sprintf("Histogram DataPoints not equal:\n%s", r))
-	}
+	reasons = append(reasons, diffSlices("DataPoints", a.DataPoints, b.DataPoints, exponentialHistogramDataPointKey[N], equalExponentialHistogramDataPoints[N], cfg, cfg.contains)...)
 	return reasons
 }
 
 // equalExponentialHistogramDataPoints returns reasons HistogramDataPoints are not equal.
 // If they are equal, the returned reasons will be empty.
-func equalExponentialHistogramDataPoints[N int64 | float64](a, b metricdata.ExponentialHistogramDataPoint[N], cfg config) (reasons []string) { // nolint: revive // Intentional internal control flag
+func equalExponentialHistogramDataPoints[N int64 | float64](a, b metricdata.ExponentialHistogramDataPoint[N], cfg config) (reasons []reason) { // nolint: revive // Intentional internal control flag
 	if !a.Attributes.Equals(&b.Attributes) {
-		reasons = append(reasons, notEqualStr(
+		reasons = append(reasons, notEqual(
 			"Attributes",
 			a.Attributes.Encoded(attribute.DefaultEncoder()),
 			b.Attributes.Encoded(attribute.DefaultEncoder()),
@@ -360,86 +287,59 @@ func equalExponentialHistogramDataPoints[N int64 | float64](a, b metricdata.Expo
 	}
 	if !cfg.ignoreTimestamp {
 		if !a.StartTime.Equal(b.StartTime) {
-			reasons = append(reasons, notEqualStr("StartTime", a.StartTime.UnixNano(), b.StartTime.UnixNano()))
+			reasons = append(reasons, notEqual("StartTime", a.StartTime.UnixNano(), b.StartTime.UnixNano()))
 		}
 		if !a.Time.Equal(b.Time) {
-			reasons = append(reasons, notEqualStr("Time", a.Time.UnixNano(), b.Time.UnixNano()))
+			reasons = append(reasons, notEqual("Time", a.Time.UnixNano(), b.Time.UnixNano()))
 		}
 	}
 	if !cfg.ignoreValue {
 		if a.Count != b.Count {
-			reasons = append(reasons, notEqualStr("Count", a.Count, b.Count))
+			reasons = append(reasons, notEqual("Count", a.Count, b.Count))
 		}
-		if !eqExtrema(a.Min, b.Min) {
-			reasons = append(reasons, notEqualStr("Min", a.Min, b.Min))
+		if !eqExtrema(a.Min, b.Min, cfg) {
+			reasons = append(reasons, notEqual("Min", a.Min, b.Min))
 		}
-		if !eqExtrema(a.Max, b.Max) {
-			reasons = append(reasons, notEqualStr("Max", a.Max, b.Max))
+		if !eqExtrema(a.Max, b.Max, cfg) {
+			reasons = append(reasons, notEqual("Max", a.Max, b.Max))
 		}
-		if a.Sum != b.Sum {
-			reasons = append(reasons, notEqualStr("Sum", a.Sum, b.Sum))
+		if !equalValues(a.Sum, b.Sum, cfg) {
+			reasons = append(reasons, notEqual("Sum", a.Sum, b.Sum))
 		}
 
 		if a.Scale != b.Scale {
-			reasons = append(reasons, notEqualStr("Scale", a.Scale, b.Scale))
+			reasons = append(reasons, notEqual("Scale", a.Scale, b.Scale))
 		}
 		if a.ZeroCount != b.ZeroCount {
-			reasons = append(reasons, notEqualStr("ZeroCount", a.ZeroCount, b.ZeroCount))
+			reasons = append(reasons, notEqual("ZeroCount", a.ZeroCount, b.ZeroCount))
 		}
 
-		r := equalExponentialBuckets(a.PositiveBucket, b.PositiveBucket, cfg)
-		if len(r) > 0 {
-			reasons = append(reasons, r...)
-		}
-		r = equalExponentialBuckets(a.NegativeBucket, b.NegativeBucket, cfg)
-		if len(r) > 0 {
-			reasons = append(reasons, r...)
-		}
+		reasons = append(reasons, within("PositiveBucket", equalExponentialBuckets(a.PositiveBucket, b.PositiveBucket, cfg))...)
+		reasons = append(reasons, within("NegativeBucket", equalExponentialBuckets(a.NegativeBucket, b.NegativeBucket, cfg))...)
 	}
 	if !cfg.ignoreExemplars {
-		r := compareDiff(diffSlices(
-			a.Exemplars,
-			b.Exemplars,
-			func(a, b metricdata.Exemplar[N]) bool {
-				r := equalExemplars(a, b, cfg)
-				return len(r) == 0
-			},
-		))
-		if r != "" {
-			reasons = append(reasons, fmt.Sprintf("Exemplars not equal:\n%s", r))
-		}
+		reasons = append(reasons, diffSlices("Exemplars", a.Exemplars, b.Exemplars, nil, equalExemplars[N], cfg, false)...)
 	}
 	return reasons
 }
 
-func equalExponentialBuckets(a, b metricdata.ExponentialBucket, _ config) (reasons []string) {
+func equalExponentialBuckets(a, b metricdata.ExponentialBucket, _ config) (reasons []reason) {
 	if a.Offset != b.Offset {
-		reasons = append(reasons, notEqualStr("Offset", a.Offset, b.Offset))
+		reasons = append(reasons, notEqual("Offset", a.Offset, b.Offset))
 	}
 	if !slices.Equal(a.Counts, b.Counts) {
-		reasons = append(reasons, notEqualStr("Counts", a.Counts, b.Counts))
+		reasons = append(reasons, notEqual("Counts", a.Counts, b.Counts))
 	}
 	return reasons
 }
 
-func equalSummary(a, b metricdata.Summary, cfg config) (reasons []string) {
-	r := compareDiff(diffSlices(
-		a.DataPoints,
-		b.DataPoints,
-		func(a, b metricdata.SummaryDataPoint) bool {
-			r := equalSummaryDataPoint(a, b, cfg)
-			return len(r) == 0
-		},
-	))
-	if r != "" {
-		reasons = append(reasons, fmt.Sprintf("Summary DataPoints not equal:\n%s", r))
-	}
-	return reasons
+func equalSummary(a, b metricdata.Summary, cfg config) (reasons []reason) {
+	return diffSlices("DataPoints", a.DataPoints, b.DataPoints, summaryDataPointKey, equalSummaryDataPoint, cfg, cfg.contains)
 }
 
-func equalSummaryDataPoint(a, b metricdata.SummaryDataPoint, cfg config) (reasons []string) {
+func equalSummaryDataPoint(a, b metricdata.SummaryDataPoint, cfg config) (reasons []reason) {
 	if !a.Attributes.Equals(&b.Attributes) {
-		reasons = append(reasons, notEqualStr(
+		reasons = append(reasons, notEqual(
 			"Attributes",
 			a.Attributes.Encoded(attribute.DefaultEncoder()),
 			b.Attributes.Encoded(attribute.DefaultEncoder()),
@@ -447,40 +347,30 @@ func equalSummaryDataPoint(a, b metricdata.SummaryDataPoint, cfg config) (reason
 	}
 	if !cfg.ignoreTimestamp {
 		if !a.StartTime.Equal(b.StartTime) {
-			reasons = append(reasons, notEqualStr("StartTime", a.StartTime.UnixNano(), b.StartTime.UnixNano()))
+			reasons = append(reasons, notEqual("StartTime", a.StartTime.UnixNano(), b.StartTime.UnixNano()))
 		}
 		if !a.Time.Equal(b.Time) {
-			reasons = append(reasons, notEqualStr("Time", a.Time.UnixNano(), b.Time.UnixNano()))
+			reasons = append(reasons, notEqual("Time", a.Time.UnixNano(), b.Time.UnixNano()))
 		}
 	}
 	if !cfg.ignoreValue {
 		if a.Count != b.Count {
-			reasons = append(reasons, notEqualStr("Count", a.Count, b.Count))
-		}
-		if a.Sum != b.Sum {
-			reasons = append(reasons, notEqualStr("Sum", a.Sum, b.Sum))
-		}
-		r := compareDiff(diffSlices(
-			a.QuantileValues,
-			b.QuantileValues,
-			func(a, b metricdata.QuantileValue) bool {
-				r := equalQuantileValue(a, b, cfg)
-				return len(r) == 0
-			},
-		))
-		if r != "" {
-			reasons = append(reasons, r)
+			reasons = append(reasons, notEqual("Count", a.Count, b.Count))
 		}
+		if !equalValues(a.Sum, b.Sum, cfg) {
+			reasons = append(reasons, notEqual("Sum", a.Sum, b.Sum))
+		}
+		reasons = append(reasons, diffSlices("QuantileValues", a.QuantileValues, b.QuantileValues, quantileKey, equalQuantileValue, cfg, false)...)
 	}
 	return reasons
 }
 
-func equalQuantileValue(a, b metricdata.QuantileValue, _ config) (reasons []string) {
+func equalQuantileValue(a, b metricdata.QuantileValue, cfg config) (reasons []reason) {
 	if a.Quantile != b.Quantile {
-		reasons = append(reasons, notEqualStr("Quantile", a.Quantile, b.Quantile))
+		reasons = append(reasons, notEqual("Quantile", a.Quantile, b.Quantile))
 	}
-	if a.Value != b.Value {
-		reasons = append(reasons, notEqualStr("Value", a.Value, b.Value))
+	if !equalValues(a.Value, b.Value, cfg) {
+		reasons = append(reasons, notEqual("Value", a.Value, b.Value))
 	}
 	return reasons
 }
@@ -489,21 +379,36 @@ func notEqualStr(prefix string, expected, actual interface{}) string {
 	return fmt.Sprintf("%s not equal:\nexpected: %v\nactual: %v", prefix, expected, actual)
 }
 
-func equalExtrema[N int64 | float64](a, b metricdata.Extrema[N], _ config) (reasons []string) {
-	if !eqExtrema(a, b) {
-		reasons = append(reasons, notEqualStr("Extrema", a, b))
+func equalExtrema[N int64 | float64](a, b metricdata.Extrema[N], cfg config) (reasons []reason) {
+	if !eqExtrema(a, b, cfg) {
+		reasons = append(reasons, notEqual("", a, b))
 	}
 	return reasons
 }
 
-func eqExtrema[N int64 | float64](a, b metricdata.Extrema[N]) bool {
+func eqExtrema[N int64 | float64](a, b metricdata.Extrema[N], cfg config) bool {
 	aV, aOk := a.Value()
 	bV, bOk := b.Value()
 
 	if !aOk || !bOk {
 		return aOk == bOk
 	}
-	return aV == bV
+	return equalValues(aV, bV, cfg)
+}
+
+// equalValues returns if the measured values a and b are equal within the
+// tolerances of cfg.
+func equalValues[N int64 | float64](a, b N, cfg config) bool {
+	if a == b {
+		return true
+	}
+	if cfg.absTolerance <= 0 && cfg.relTolerance <= 0 {
+		return false
+	}
+
+	x, y := float64(a), float64(b)
+	diff := math.Abs(x - y)
+	return diff <= cfg.absTolerance || diff <= cfg.relTolerance*math.Max(math.Abs(x), math.Abs(y))
 }
 
 func equalKeyValue(a, b attribute.KeyValue) bool {
@@ -554,83 +459,163 @@ func equalKeyValue(a, b attribute.KeyValue) bool {
 	return true
 }
 
-func equalExemplars[N int64 | float64](a, b metricdata.Exemplar[N], cfg config) (reasons []string) {
+func equalExemplars[N int64 | float64](a, b metricdata.Exemplar[N], cfg config) (reasons []reason) {
 	if !slices.EqualFunc(a.FilteredAttributes, b.FilteredAttributes, equalKeyValue) {
-		reasons = append(reasons, notEqualStr("FilteredAttributes", a.FilteredAttributes, b.FilteredAttributes))
+		reasons = append(reasons, notEqual("FilteredAttributes", a.FilteredAttributes, b.FilteredAttributes))
 	}
 	if !cfg.ignoreTimestamp {
 		if !a.Time.Equal(b.Time) {
-			reasons = append(reasons, notEqualStr("Time", a.Time.UnixNano(), b.Time.UnixNano()))
+			reasons = append(reasons, notEqual("Time", a.Time.UnixNano(), b.Time.UnixNano()))
 		}
 	}
 	if !cfg.ignoreValue {
-		if a.Value != b.Value {
-			reasons = append(reasons, notEqualStr("Value", a.Value, b.Value))
+		if !equalValues(a.Value, b.Value, cfg) {
+			reasons = append(reasons, notEqual("Value", a.Value, b.Value))
 		}
 	}
 	if !slices.Equal(a.SpanID, b.SpanID) {
-		reasons = append(reasons, notEqualStr("SpanID", a.SpanID, b.SpanID))
+		reasons = append(reasons, notEqual("SpanID", a.SpanID, b.SpanID))
 	}
 	if !slices.Equal(a.TraceID, b.TraceID) {
-		reasons = append(reasons, notEqualStr("TraceID", a.TraceID, b.TraceID))
+		reasons = append(reasons, notEqual("TraceID", a.TraceID, b.TraceID))
 	}
 	return reasons
 }
 
-func diffSlices[T any](a, b []T, equal func(T, T) bool) (extraA, extraB []T) {
-	visited := make([]bool, len(b))
-	for i := 0; i < len(a); i++ {
+func scopeKey(sm metricdata.ScopeMetrics) string { return fmt.Sprintf("%q", sm.Scope.Name) }
+
+func metricsKey(m metricdata.Metrics) string { return fmt.Sprintf("%q", m.Name) }
+
+func attributesKey(s attribute.Set) string {
+	return "{" + s.Encoded(attribute.DefaultEncoder()) + "}"
+}
+
+func dataPointKey[N int64 | float64](dp metricdata.DataPoint[N]) string {
+	return attributesKey(dp.Attributes)
+}
+
+func histogramDataPointKey[N int64 | float64](dp metricdata.HistogramDataPoint[N]) string {
+	return attributesKey(dp.Attributes)
+}
+
+func exponentialHistogramDataPointKey[N int64 | float64](dp metricdata.ExponentialHistogramDataPoint[N]) string {
+	return attributesKey(dp.Attributes)
+}
+
+func summaryDataPointKey(dp metricdata.SummaryDataPoint) string { return attributesKey(dp.Attributes) }
+
+func quantileKey(qv metricdata.QuantileValue) string { return fmt.Sprint(qv.Quantile) }
+
+// diffSlices returns reasons the expected and actual slices, found at path,
+// do not contain the same values. The order of the values is not compared.
+//
+// Values are identified by key. Expected and actual values with the same key
+// that are not equal have their differences reported at path[key]. If key is
+// nil, values are identified by their index and are reported as a whole.
+//
+// If allowExtra is true, actual values without an expected counterpart are
+// not reported.
+func diffSlices[T any](path string, expected, actual []T, key func(T) string, equal func(T, T, config) []reason, cfg config, allowExtra bool) (reasons []reason) {
+	label := func(values []T, i int) string {
+		if key == nil {
+			return fmt.Sprintf("%s[%d]", path, i)
+		}
+		return fmt.Sprintf("%s[%s]", path, key(values[i]))
+	}
+	sameKey := func(i, j int) bool {
+		return key == nil || key(expected[i]) == key(actual[j])
+	}
+
+	matched := make([]bool, len(actual))
+	var unmatched []int
+	for i := range expected {
 		found := false
-		for j := 0; j < len(b); j++ {
-			if visited[j] {
-				continue
-			}
-			if equal(a[i], b[j]) {
-				visited[j] = true
-				found = true
+		for j := range actual {
+			if !matched[j] && sameKey(i, j) && len(equal(expected[i], actual[j], cfg)) == 0 {
+				matched[j], found = true, true
 				break
 			}
 		}
 		if !found {
-			extraA = append(extraA, a[i])
+			unmatched = append(unmatched, i)
 		}
 	}
 
-	for j := 0; j < len(b); j++ {
-		if visited[j] {
+	for _, i := range unmatched {
+		j := -1
+		if key != nil {
+			// Pair with an actual value of the same key to report how it
+			// differs.
+			for k := range actual {
+				if !matched[k] && sameKey(i, k) {
+					j = k
+					break
+				}
+			}
+		}
+		if j < 0 {
+			msg := "missing expected value"
+			if key == nil {
+				msg = fmt.Sprintf("missing expected value %+v", expected[i])
+			}
+			reasons = append(reasons, reason{path: label(expected, i), msg: msg})
 			continue
 		}
-		extraB = append(extraB, b[j])
+		matched[j] = true
+		reasons = append(reasons, within(label(expected, i), equal(expected[i], actual[j], cfg))...)
 	}
 
-	return extraA, extraB
-}
-
-func compareDiff[T any](extraExpected, extraActual []T) string {
-	if len(extraExpected) == 0 && len(extraActual) == 0 {
-		return ""
+	if !allowExtra {
+		for j, ok := range matched {
+			if ok {
+				continue
+			}
+			msg := "unexpected value"
+			if key == nil {
+				msg = fmt.Sprintf("unexpected value %+v", actual[j])
+			}
+			reasons = append(reasons, reason{path: label(actual, j), msg: msg})
+		}
 	}
+	return reasons
+}
 
-	formatter := func(v T) string {
-		return fmt.Sprintf("%#v", v)
+// bucketsInRange returns reasons the values of dp are not within the range
+// [lower, upper].
+func bucketsInRange[N int64 | float64](dp metricdata.HistogramDataPoint[N], lower, upper float64) (reasons []reason) {
+	if len(dp.BucketCounts) != len(dp.Bounds)+1 {
+		return []reason{{
+			path: "BucketCounts",
+			msg:  fmt.Sprintf("%d bucket counts for %d bounds", len(dp.BucketCounts), len(dp.Bounds)),
+		}}
 	}
 
-	var msg bytes.Buffer
-	if len(extraExpected) > 0 {
-		_, _ = msg.WriteString("missing expected values:\n")
-		for _, v := range extraExpected {
-			_, _ = msg.WriteString(formatter(v) + "\n")
+	for i, n := range dp.BucketCounts {
+		if n == 0 {
+			continue
 		}
-	}
-
-	if len(extraActual) > 0 {
-		_, _ = msg.WriteString("unexpected additional values:\n")
-		for _, v := range extraActual {
-			_, _ = msg.WriteString(formatter(v) + "\n")
+		// Bucket i contains the values in (Bounds[i-1], Bounds[i]].
+		lo, hi := math.Inf(-1), math.Inf(1)
+		if i > 0 {
+			lo = dp.Bounds[i-1]
+		}
+		if i < len(dp.Bounds) {
+			hi = dp.Bounds[i]
+		}
+		if hi < lower || lo >= upper {
+			reasons = append(reasons, reason{
+				path: fmt.Sprintf("BucketCounts[%d]", i),
+				msg:  fmt.Sprintf("%d values in bucket (%g, %g] outside of range [%g, %g]", n, lo, hi, lower, upper),
+			})
 		}
 	}
-
-	return msg.String()
+	if v, ok := dp.Min.Value(); ok && float64(v) < lower {
+		reasons = append(reasons, reason{path: "Min", msg: fmt.Sprintf("%v below range [%g, %g]", v, lower, upper)})
+	}
+	if v, ok := dp.Max.Value(); ok && float64(v) > upper {
+		reasons = append(reasons, reason{path: "Max", msg: fmt.Sprintf("%v above range [%g, %g]", v, lower, upper)})
+	}
+	return reasons
 }
 
 func missingAttrStr(name string) string {
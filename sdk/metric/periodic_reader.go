@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -27,6 +28,10 @@ type periodicReaderConfig struct {
 	interval  time.Duration
 	timeout   time.Duration
 	producers []Producer
+
+	align  bool
+	jitter time.Duration
+	missed MissedTickPolicy
 }
 
 // newPeriodicReaderConfig returns a periodicReaderConfig configured with
@@ -94,11 +99,82 @@ func WithInterval(d time.Duration) PeriodicReaderOption {
 	})
 }
 
+// WithAlignment configures a PeriodicReader to align its collections to
+// wall-clock multiples of the interval. For example, with an interval of 15
+// seconds collections are made at :00, :15, :30, and :45 seconds past every
+// minute. Instances of the reader running on different hosts with
+// synchronized clocks will therefore collect at the same time.
+//
+// By default, collections are made at the interval starting from when the
+// PeriodicReader was created.
+func WithAlignment() PeriodicReaderOption {
+	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
+		conf.align = true
+		return conf
+	})
+}
+
+// WithJitter configures a PeriodicReader to delay each scheduled collection
+// by a random duration in the range [0, d). This spreads the collection and
+// export load of many readers sharing a schedule. The jitter does not
+// accumulate, each collection is delayed from its scheduled time.
+//
+// If d is greater than the interval, the interval is used instead.
+//
+// If this option is not used or d is less than or equal to zero, no jitter is
+// applied.
+func WithJitter(d time.Duration) PeriodicReaderOption {
+	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
+		if d <= 0 {
+			return conf
+		}
+		conf.jitter = d
+		return conf
+	})
+}
+
+// MissedTickPolicy defines how a PeriodicReader handles scheduled
+// collections that were missed because a previous collection and export did
+// not complete before they were due.
+type MissedTickPolicy int
+
+const (
+	// SkipMissedTicks skips all missed collections. The next collection is
+	// made at the next scheduled time that has not yet passed.
+	SkipMissedTicks MissedTickPolicy = iota
+	// CatchUpMissedTicks makes one collection, without jitter, for every
+	// missed scheduled collection immediately after the previous collection
+	// completes. The reader then resumes its schedule.
+	//
+	// At most 3 missed collections are caught up, the earlier ones are
+	// skipped. This avoids a burst of collections after the process was
+	// stalled or suspended for many intervals.
+	CatchUpMissedTicks
+)
+
+// maxCatchUpTicks is the maximum number of missed collections caught up with
+// CatchUpMissedTicks.
+const maxCatchUpTicks = 3
+
+// WithMissedTickPolicy configures how a PeriodicReader handles scheduled
+// collections that were missed because a previous collection and export took
+// longer than the interval.
+//
+// If this option is not used, SkipMissedTicks is used.
+func WithMissedTickPolicy(p MissedTickPolicy) PeriodicReaderOption {
+	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
+		conf.missed = p
+		return conf
+	})
+}
+
 // NewPeriodicReader returns a Reader that collects and exports metric data to
 // the exporter at a defined interval. By default, the returned Reader will
 // collect and export data every 60 seconds, and will cancel any attempts that
 // exceed 30 seconds, collect and export combined. The collect and export time
-// are not counted towards the interval between attempts.
+// are not counted towards the interval between attempts. Use the
+// WithAlignment, WithJitter, and WithMissedTickPolicy options to change when
+// collections are scheduled.
 //
 // The Collect method of the returned Reader continues to gather and return
 // metric data to the user. It will not automatically send that data to the
@@ -121,9 +197,14 @@ func NewPeriodicReader(exporter Exporter, options ...PeriodicReaderOption) *Peri
 	}
 	r.externalProducers.Store(conf.producers)
 
+	sched := newSchedule(conf)
 	go func() {
 		defer func() { close(r.done) }()
-		r.run(ctx, conf.interval)
+		if sched == nil {
+			r.run(ctx, conf.interval)
+		} else {
+			r.runScheduled(ctx, sched)
+		}
 	}()
 
 	return r
@@ -178,6 +259,123 @@ func (r *PeriodicReader) run(ctx context.Context, interval time.Duration) {
 	}
 }
 
+// newTimer allows testing override.
+var newTimer = time.NewTimer
+
+// now allows testing override.
+var now = time.Now
+
+// runScheduled continuously collects and exports metric data according to
+// sched. This will run until ctx is canceled or times out.
+func (r *PeriodicReader) runScheduled(ctx context.Context, sched *schedule) {
+	sched.start(now())
+	timer := newTimer(sched.wait(now()))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			err := r.collectAndExport(ctx)
+			if err != nil {
+				otel.Handle(err)
+			}
+			sched.advance(now())
+			timer.Reset(sched.wait(now()))
+		case errCh := <-r.flushCh:
+			errCh <- r.collectAndExport(ctx)
+			if sched.align {
+				// Keep aligned to the wall-clock.
+				continue
+			}
+			sched.start(now())
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(sched.wait(now()))
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// schedule tracks the times a PeriodicReader collects at when it is
+// configured with alignment, jitter, or a missed tick policy.
+type schedule struct {
+	interval time.Duration
+	align    bool
+	jitter   time.Duration
+	missed   MissedTickPolicy
+
+	// randInt63n returns a random number in the range [0, n).
+	randInt63n func(n int64) int64
+
+	// target is the next scheduled collection time, without jitter.
+	target time.Time
+}
+
+// newSchedule returns the schedule conf configures. If conf uses the default
+// schedule, nil is returned.
+func newSchedule(conf periodicReaderConfig) *schedule {
+	if !conf.align && conf.jitter <= 0 && conf.missed == SkipMissedTicks {
+		return nil
+	}
+	s := &schedule{
+		interval: conf.interval,
+		align:    conf.align,
+		jitter:   conf.jitter,
+		missed:   conf.missed,
+		// The jitter does not need to be cryptographically secure.
+		randInt63n: rand.New(rand.NewSource(time.Now().UnixNano())).Int63n, //nolint:gosec // G404: Use of weak random number generator.
+	}
+	if s.jitter > s.interval {
+		s.jitter = s.interval
+	}
+	return s
+}
+
+// start (re)starts the schedule at t.
+func (s *schedule) start(t time.Time) {
+	if s.align {
+		s.target = t.Truncate(s.interval).Add(s.interval)
+		return
+	}
+	s.target = t.Add(s.interval)
+}
+
+// wait returns the duration to wait from t until the next collection.
+func (s *schedule) wait(t time.Time) time.Duration {
+	d := s.target.Sub(t)
+	if d <= 0 {
+		// Collection is overdue, collect immediately.
+		return 0
+	}
+	if s.jitter > 0 {
+		d += time.Duration(s.randInt63n(int64(s.jitter)))
+	}
+	return d
+}
+
+// advance moves the schedule to the collection after the one completed at t.
+func (s *schedule) advance(t time.Time) {
+	s.target = s.target.Add(s.interval)
+	if s.target.After(t) {
+		return
+	}
+
+	missed := t.Sub(s.target)/s.interval + 1
+	switch s.missed {
+	case SkipMissedTicks:
+		s.target = s.target.Add(missed * s.interval)
+	case CatchUpMissedTicks:
+		if missed > maxCatchUpTicks {
+			s.target = s.target.Add((missed - maxCatchUpTicks) * s.interval)
+		}
+	}
+}
+
 // register registers p as the producer of this reader.
 func (r *PeriodicReader) register(p sdkProducer) {
 	// Only register once. If producer is already set, do nothing.
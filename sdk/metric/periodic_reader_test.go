@@ -139,6 +139,118 @@ func TestIntervalEnvAndOption(t *testing.T) {
 	assert.Equal(t, want, got, "option should have precedence over env var")
 }
 
+func TestWithAlignment(t *testing.T) {
+	assert.False(t, newPeriodicReaderConfig(nil).align)
+	assert.True(t, newPeriodicReaderConfig([]PeriodicReaderOption{WithAlignment()}).align)
+}
+
+func TestWithJitter(t *testing.T) {
+	test := func(d time.Duration) time.Duration {
+		opts := []PeriodicReaderOption{WithJitter(d)}
+		return newPeriodicReaderConfig(opts).jitter
+	}
+
+	assert.Equal(t, testDur, test(testDur))
+	assert.Equal(t, time.Duration(0), newPeriodicReaderConfig(nil).jitter)
+	assert.Equal(t, time.Duration(0), test(time.Duration(0)), "invalid jitter should not be used")
+	assert.Equal(t, time.Duration(0), test(time.Duration(-1)), "invalid jitter should not be used")
+}
+
+func TestWithMissedTickPolicy(t *testing.T) {
+	assert.Equal(t, SkipMissedTicks, newPeriodicReaderConfig(nil).missed)
+	opts := []PeriodicReaderOption{WithMissedTickPolicy(CatchUpMissedTicks)}
+	assert.Equal(t, CatchUpMissedTicks, newPeriodicReaderConfig(opts).missed)
+}
+
+func TestNewSchedule(t *testing.T) {
+	assert.Nil(t, newSchedule(newPeriodicReaderConfig(nil)), "default schedule")
+
+	conf := newPeriodicReaderConfig([]PeriodicReaderOption{
+		WithInterval(time.Second),
+		WithJitter(time.Minute),
+	})
+	s := newSchedule(conf)
+	require.NotNil(t, s)
+	assert.Equal(t, time.Second, s.jitter, "jitter not limited to interval")
+}
+
+func TestSchedule(t *testing.T) {
+	const interval = 15 * time.Second
+	start := time.Date(2024, time.January, 1, 12, 0, 7, 0, time.UTC)
+
+	t.Run("Unaligned", func(t *testing.T) {
+		s := &schedule{interval: interval}
+		s.start(start)
+		assert.Equal(t, interval, s.wait(start))
+
+		s.advance(start.Add(interval))
+		assert.Equal(t, start.Add(2*interval), s.target)
+	})
+
+	t.Run("Aligned", func(t *testing.T) {
+		s := &schedule{interval: interval, align: true}
+		s.start(start)
+		want := time.Date(2024, time.January, 1, 12, 0, 15, 0, time.UTC)
+		assert.Equal(t, want, s.target)
+		assert.Equal(t, 8*time.Second, s.wait(start))
+
+		s.advance(want)
+		assert.Equal(t, want.Add(interval), s.target)
+	})
+
+	t.Run("Jitter", func(t *testing.T) {
+		s := &schedule{
+			interval:   interval,
+			jitter:     time.Second,
+			randInt63n: func(n int64) int64 { return n - 1 },
+		}
+		s.start(start)
+		assert.Equal(t, interval+time.Second-1, s.wait(start))
+		assert.Equal(t, time.Duration(0), s.wait(start.Add(2*interval)), "overdue collection jittered")
+
+		s.advance(start.Add(interval + time.Second))
+		assert.Equal(t, start.Add(2*interval), s.target, "jitter accumulated")
+	})
+
+	t.Run("SkipMissedTicks", func(t *testing.T) {
+		s := &schedule{interval: interval, missed: SkipMissedTicks}
+		s.start(start)
+		// Collection took 2.5 intervals to complete.
+		done := start.Add(interval * 7 / 2)
+		s.advance(done)
+		assert.Equal(t, start.Add(4*interval), s.target)
+		assert.Equal(t, interval/2, s.wait(done))
+	})
+
+	t.Run("CatchUpMissedTicks", func(t *testing.T) {
+		s := &schedule{interval: interval, missed: CatchUpMissedTicks}
+		s.start(start)
+		// Collection took 2.5 intervals to complete.
+		done := start.Add(interval * 7 / 2)
+		s.advance(done)
+		assert.Equal(t, start.Add(2*interval), s.target)
+		assert.Equal(t, time.Duration(0), s.wait(done))
+		s.advance(done)
+		assert.Equal(t, time.Duration(0), s.wait(done))
+		s.advance(done)
+		assert.Equal(t, start.Add(4*interval), s.target)
+		assert.Equal(t, interval/2, s.wait(done))
+	})
+
+	t.Run("CatchUpMissedTicksLimit", func(t *testing.T) {
+		s := &schedule{interval: interval, missed: CatchUpMissedTicks}
+		s.start(start)
+		// The process was suspended for an hour.
+		done := start.Add(time.Hour)
+		var collections int
+		for s.advance(done); s.wait(done) == 0; s.advance(done) {
+			collections++
+		}
+		assert.Equal(t, maxCatchUpTicks, collections, "missed collections not limited")
+		assert.Equal(t, start.Add(time.Hour+interval), s.target)
+	})
+}
+
 type fnExporter struct {
 	temporalityFunc TemporalitySelector
 	aggregationFunc AggregationSelector
@@ -289,6 +401,56 @@ func TestPeriodicReaderRun(t *testing.T) {
 	_ = r.Shutdown(context.Background())
 }
 
+func triggerTimer(t *testing.T) chan time.Time {
+	t.Helper()
+
+	// Override the timer C chan so tests are not flaky and rely on timing.
+	orig := newTimer
+	t.Cleanup(func() { newTimer = orig })
+
+	trigger := make(chan time.Time)
+	newTimer = func(d time.Duration) *time.Timer {
+		timer := time.NewTimer(d)
+		timer.C = trigger
+		return timer
+	}
+	return trigger
+}
+
+func TestPeriodicReaderRunScheduled(t *testing.T) {
+	trigger := triggerTimer(t)
+
+	defer func(orig otel.ErrorHandler) {
+		otel.SetErrorHandler(orig)
+	}(otel.GetErrorHandler())
+	eh := newChErrorHandler()
+	otel.SetErrorHandler(eh)
+
+	exp := &fnExporter{
+		exportFunc: func(_ context.Context, m *metricdata.ResourceMetrics) error {
+			// The testSDKProducer produces testResourceMetricsAB.
+			assert.Equal(t, testResourceMetricsAB, *m)
+			return assert.AnError
+		},
+	}
+
+	r := NewPeriodicReader(
+		exp,
+		WithProducer(testExternalProducer{}),
+		WithAlignment(),
+		WithJitter(time.Second),
+		WithMissedTickPolicy(CatchUpMissedTicks),
+	)
+	r.register(testSDKProducer{})
+	trigger <- time.Now()
+	assert.Equal(t, assert.AnError, <-eh.Err)
+	trigger <- time.Now()
+	assert.Equal(t, assert.AnError, <-eh.Err)
+
+	// Ensure Reader is allowed clean up attempt.
+	_ = r.Shutdown(context.Background())
+}
+
 func TestPeriodicReaderFlushesPending(t *testing.T) {
 	// Override the ticker so tests are not flaky and rely on timing.
 	trigger := triggerTicker(t)
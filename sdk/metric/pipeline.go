@@ -11,7 +11,9 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
@@ -62,9 +64,14 @@ type pipeline struct {
 	reader Reader
 	views  []View
 
+	// callbackTimeout and callbackConcurrency configure how callbacks are
+	// run. See WithCallbackTimeout and WithCallbackConcurrency.
+	callbackTimeout     time.Duration
+	callbackConcurrency int
+
 	sync.Mutex
 	aggregations   map[instrumentation.Scope][]instrumentSync
-	callbacks      []func(context.Context) error
+	callbacks      []callback
 	multiCallbacks list.List
 }
 
@@ -83,11 +90,13 @@ func (p *pipeline) addSync(scope instrumentation.Scope, iSync instrumentSync) {
 	p.aggregations[scope] = append(p.aggregations[scope], iSync)
 }
 
-type multiCallback func(context.Context) error
-
 // addMultiCallback registers a multi-instrument callback to be run when
 // `produce()` is called.
-func (p *pipeline) addMultiCallback(c multiCallback) (unregister func()) {
+func (p *pipeline) addMultiCallback(c callback) (unregister func()) {
+	// The same callback is registered with every pipeline. Each pipeline
+	// tracks its own invocations of it.
+	c.running = new(atomic.Bool)
+
 	p.Lock()
 	defer p.Unlock()
 	e := p.multiCallbacks.PushBack(c)
@@ -106,29 +115,11 @@ func (p *pipeline) produce(ctx context.Context, rm *metricdata.ResourceMetrics)
 	defer p.Unlock()
 
 	var errs multierror
-	for _, c := range p.callbacks {
-		// TODO make the callbacks parallel. ( #3034 )
-		if err := c(ctx); err != nil {
-			errs.append(err)
-		}
-		if err := ctx.Err(); err != nil {
-			rm.Resource = nil
-			rm.ScopeMetrics = rm.ScopeMetrics[:0]
-			return err
-		}
-	}
-	for e := p.multiCallbacks.Front(); e != nil; e = e.Next() {
-		// TODO make the callbacks parallel. ( #3034 )
-		f := e.Value.(multiCallback)
-		if err := f(ctx); err != nil {
-			errs.append(err)
-		}
-		if err := ctx.Err(); err != nil {
-			// This means the context expired before we finished running callbacks.
-			rm.Resource = nil
-			rm.ScopeMetrics = rm.ScopeMetrics[:0]
-			return err
-		}
+	if err := p.runCallbacks(ctx, &errs); err != nil {
+		// This means the context expired before we finished running callbacks.
+		rm.Resource = nil
+		rm.ScopeMetrics = rm.ScopeMetrics[:0]
+		return err
 	}
 
 	rm.Resource = p.resource
@@ -194,7 +185,8 @@ func newInserter[N int64 | float64](p *pipeline, vc *cache[string, instID]) *ins
 // Instrument inserts the instrument inst with instUnit into a pipeline. All
 // views the pipeline contains are matched against, and any matching view that
 // creates a unique aggregate function will have its output inserted into the
-// pipeline and its input included in the returned slice.
+// pipeline and its input included in the returned slice. The Bind for each
+// input is returned at the same index of the second returned slice.
 //
 // The returned aggregate function inputs are ensured to be deduplicated and
 // unique. If another view in another pipeline that is cached by this
@@ -213,10 +205,16 @@ func newInserter[N int64 | float64](p *pipeline, vc *cache[string, instID]) *ins
 //
 // If an instrument is determined to use a Drop aggregation, that instrument is
 // not inserted nor returned.
-func (i *inserter[N]) Instrument(inst Instrument, readerAggregation Aggregation) ([]aggregate.Measure[N], error) {
+//
+// The advisoryFilter is the attribute filter advised by the instrumentation
+// when the instrument was created. It is used for any stream that does not
+// define its own AttributeFilter. A nil advisoryFilter means no filter was
+// advised.
+func (i *inserter[N]) Instrument(inst Instrument, readerAggregation Aggregation, advisoryFilter attribute.Filter) ([]aggregate.Measure[N], []aggregate.Bind[N], error) {
 	var (
 		matched  bool
 		measures []aggregate.Measure[N]
+		binds    []aggregate.Bind[N]
 	)
 
 	errs := &multierror{wrapped: errCreatingAggregators}
@@ -227,7 +225,10 @@ func (i *inserter[N]) Instrument(inst Instrument, readerAggregation Aggregation)
 			continue
 		}
 		matched = true
-		in, id, err := i.cachedAggregator(inst.Scope, inst.Kind, stream, readerAggregation)
+		if stream.AttributeFilter == nil {
+			stream.AttributeFilter = advisoryFilter
+		}
+		in, bind, id, err := i.cachedAggregator(inst.Scope, inst.Kind, stream, readerAggregation)
 		if err != nil {
 			errs.append(err)
 		}
@@ -240,32 +241,35 @@ func (i *inserter[N]) Instrument(inst Instrument, readerAggregation Aggregation)
 		}
 		seen[id] = struct{}{}
 		measures = append(measures, in)
+		binds = append(binds, bind)
 	}
 
 	if matched {
-		return measures, errs.errorOrNil()
+		return measures, binds, errs.errorOrNil()
 	}
 
 	// Apply implicit default view if no explicit matched.
 	stream := Stream{
-		Name:        inst.Name,
-		Description: inst.Description,
-		Unit:        inst.Unit,
+		Name:            inst.Name,
+		Description:     inst.Description,
+		Unit:            inst.Unit,
+		AttributeFilter: advisoryFilter,
 	}
-	in, _, err := i.cachedAggregator(inst.Scope, inst.Kind, stream, readerAggregation)
+	in, bind, _, err := i.cachedAggregator(inst.Scope, inst.Kind, stream, readerAggregation)
 	if err != nil {
 		errs.append(err)
 	}
 	if in != nil {
 		// Ensured to have not seen given matched was false.
 		measures = append(measures, in)
+		binds = append(binds, bind)
 	}
-	return measures, errs.errorOrNil()
+	return measures, binds, errs.errorOrNil()
 }
 
 // addCallback registers a single instrument callback to be run when
 // `produce()` is called.
-func (i *inserter[N]) addCallback(cback func(context.Context) error) {
+func (i *inserter[N]) addCallback(cback callback) {
 	i.pipeline.Lock()
 	defer i.pipeline.Unlock()
 	i.pipeline.callbacks = append(i.pipeline.callbacks, cback)
@@ -277,6 +281,7 @@ var aggIDCount uint64
 type aggVal[N int64 | float64] struct {
 	ID      uint64
 	Measure aggregate.Measure[N]
+	Bind    aggregate.Bind[N]
 	Err     error
 }
 
@@ -319,7 +324,7 @@ func (i *inserter[N]) readerDefaultAggregation(kind InstrumentKind) Aggregation
 //
 // If the instrument defines an unknown or incompatible aggregation, an error
 // is returned.
-func (i *inserter[N]) cachedAggregator(scope instrumentation.Scope, kind InstrumentKind, stream Stream, readerAggregation Aggregation) (meas aggregate.Measure[N], aggID uint64, err error) {
+func (i *inserter[N]) cachedAggregator(scope instrumentation.Scope, kind InstrumentKind, stream Stream, readerAggregation Aggregation) (meas aggregate.Measure[N], bind aggregate.Bind[N], aggID uint64, err error) {
 	switch stream.Aggregation.(type) {
 	case nil:
 		// The aggregation was not overridden with a view. Use the aggregation
@@ -331,7 +336,7 @@ func (i *inserter[N]) cachedAggregator(scope instrumentation.Scope, kind Instrum
 	}
 
 	if err := isAggregatorCompatible(kind, stream.Aggregation); err != nil {
-		return nil, 0, fmt.Errorf(
+		return nil, nil, 0, fmt.Errorf(
 			"creating aggregator with instrumentKind: %d, aggregation %v: %w",
 			kind, stream.Aggregation, err,
 		)
@@ -358,12 +363,12 @@ func (i *inserter[N]) cachedAggregator(scope instrumentation.Scope, kind Instrum
 		// unrecognized input). Use that value directly.
 		b.AggregationLimit, _ = x.CardinalityLimit.Lookup()
 
-		in, out, err := i.aggregateFunc(b, stream.Aggregation, kind)
+		in, bind, out, err := i.aggregateFunc(b, stream.Aggregation, kind)
 		if err != nil {
-			return aggVal[N]{0, nil, err}
+			return aggVal[N]{0, nil, nil, err}
 		}
 		if in == nil { // Drop aggregator.
-			return aggVal[N]{0, nil, nil}
+			return aggVal[N]{0, nil, nil, nil}
 		}
 		i.pipeline.addSync(scope, instrumentSync{
 			// Use the first-seen name casing for this and all subsequent
@@ -374,9 +379,9 @@ func (i *inserter[N]) cachedAggregator(scope instrumentation.Scope, kind Instrum
 			compAgg:     out,
 		})
 		id := atomic.AddUint64(&aggIDCount, 1)
-		return aggVal[N]{id, in, err}
+		return aggVal[N]{id, in, bind, err}
 	})
-	return cv.Measure, cv.ID, cv.Err
+	return cv.Measure, cv.Bind, cv.ID, cv.Err
 }
 
 // logConflict validates if an instrument with the same case-insensitive name
@@ -440,7 +445,7 @@ func (i *inserter[N]) instID(kind InstrumentKind, stream Stream) instID {
 // aggregateFunc returns new aggregate functions matching agg, kind, and
 // monotonic. If the agg is unknown or temporality is invalid, an error is
 // returned.
-func (i *inserter[N]) aggregateFunc(b aggregate.Builder[N], agg Aggregation, kind InstrumentKind) (meas aggregate.Measure[N], comp aggregate.ComputeAggregation, err error) {
+func (i *inserter[N]) aggregateFunc(b aggregate.Builder[N], agg Aggregation, kind InstrumentKind) (meas aggregate.Measure[N], bind aggregate.Bind[N], comp aggregate.ComputeAggregation, err error) {
 	switch a := agg.(type) {
 	case AggregationDefault:
 		return i.aggregateFunc(b, DefaultAggregationSelector(kind), kind)
@@ -449,22 +454,22 @@ func (i *inserter[N]) aggregateFunc(b aggregate.Builder[N], agg Aggregation, kin
 	case AggregationLastValue:
 		switch kind {
 		case InstrumentKindGauge:
-			meas, comp = b.LastValue()
+			meas, bind, comp = b.LastValue()
 		case InstrumentKindObservableGauge:
-			meas, comp = b.PrecomputedLastValue()
+			meas, bind, comp = b.PrecomputedLastValue()
 		}
 	case AggregationSum:
 		switch kind {
 		case InstrumentKindObservableCounter:
-			meas, comp = b.PrecomputedSum(true)
+			meas, bind, comp = b.PrecomputedSum(true)
 		case InstrumentKindObservableUpDownCounter:
-			meas, comp = b.PrecomputedSum(false)
+			meas, bind, comp = b.PrecomputedSum(false)
 		case InstrumentKindCounter, InstrumentKindHistogram:
-			meas, comp = b.Sum(true)
+			meas, bind, comp = b.Sum(true)
 		default:
 			// InstrumentKindUpDownCounter, InstrumentKindObservableGauge, and
 			// instrumentKindUndefined or other invalid instrument kinds.
-			meas, comp = b.Sum(false)
+			meas, bind, comp = b.Sum(false)
 		}
 	case AggregationExplicitBucketHistogram:
 		var noSum bool
@@ -475,7 +480,7 @@ func (i *inserter[N]) aggregateFunc(b aggregate.Builder[N], agg Aggregation, kin
 			// https://github.com/open-telemetry/opentelemetry-specification/blob/v1.21.0/specification/metrics/sdk.md#histogram-aggregations
 			noSum = true
 		}
-		meas, comp = b.ExplicitBucketHistogram(a.Boundaries, a.NoMinMax, noSum)
+		meas, bind, comp = b.ExplicitBucketHistogram(a.Boundaries, a.NoMinMax, noSum)
 	case AggregationBase2ExponentialHistogram:
 		var noSum bool
 		switch kind {
@@ -485,13 +490,13 @@ func (i *inserter[N]) aggregateFunc(b aggregate.Builder[N], agg Aggregation, kin
 			// https://github.com/open-telemetry/opentelemetry-specification/blob/v1.21.0/specification/metrics/sdk.md#histogram-aggregations
 			noSum = true
 		}
-		meas, comp = b.ExponentialBucketHistogram(a.MaxSize, a.MaxScale, a.NoMinMax, noSum)
+		meas, bind, comp = b.ExponentialBucketHistogram(a.MaxSize, a.MaxScale, a.NoMinMax, noSum)
 
 	default:
 		err = errUnknownAggregation
 	}
 
-	return meas, comp, err
+	return meas, bind, comp, err
 }
 
 // isAggregatorCompatible checks if the aggregation can be used by the instrument.
@@ -552,17 +557,22 @@ func isAggregatorCompatible(kind InstrumentKind, agg Aggregation) error {
 // measurement.
 type pipelines []*pipeline
 
-func newPipelines(res *resource.Resource, readers []Reader, views []View) pipelines {
+// newPipelines returns pipelines for each reader. The callbacks of each
+// pipeline are run with cbTimeout and cbConcurrency (see WithCallbackTimeout
+// and WithCallbackConcurrency).
+func newPipelines(res *resource.Resource, readers []Reader, views []View, cbTimeout time.Duration, cbConcurrency int) pipelines {
 	pipes := make([]*pipeline, 0, len(readers))
 	for _, r := range readers {
 		p := newPipeline(res, r, views)
+		p.callbackTimeout = cbTimeout
+		p.callbackConcurrency = cbConcurrency
 		r.register(p)
 		pipes = append(pipes, p)
 	}
 	return pipes
 }
 
-func (p pipelines) registerMultiCallback(c multiCallback) metric.Registration {
+func (p pipelines) registerMultiCallback(c callback) metric.Registration {
 	unregs := make([]func(), len(p))
 	for i, pipe := range p {
 		unregs[i] = pipe.addMultiCallback(c)
@@ -598,27 +608,39 @@ func newResolver[N int64 | float64](p pipelines, vc *cache[string, instID]) reso
 }
 
 // Aggregators returns the Aggregators that must be updated by the instrument
-// defined by key.
-func (r resolver[N]) Aggregators(id Instrument) ([]aggregate.Measure[N], error) {
-	var measures []aggregate.Measure[N]
+// defined by key. If attribute keys were advised on instrument instantiation,
+// they are used to filter attributes unless a view defines its own filter.
+func (r resolver[N]) Aggregators(id Instrument, attrKeys []attribute.Key) ([]aggregate.Measure[N], []aggregate.Bind[N], error) {
+	var (
+		measures []aggregate.Measure[N]
+		binds    []aggregate.Bind[N]
+	)
 
+	filter := advisoryAttributeFilter(attrKeys)
 	errs := &multierror{}
 	for _, i := range r.inserters {
-		in, err := i.Instrument(id, i.readerDefaultAggregation(id.Kind))
+		in, bind, err := i.Instrument(id, i.readerDefaultAggregation(id.Kind), filter)
 		if err != nil {
 			errs.append(err)
 		}
 		measures = append(measures, in...)
+		binds = append(binds, bind...)
 	}
-	return measures, errs.errorOrNil()
+	return measures, binds, errs.errorOrNil()
 }
 
 // HistogramAggregators returns the histogram Aggregators that must be updated by the instrument
 // defined by key. If boundaries were provided on instrument instantiation, those take precedence
-// over boundaries provided by the reader.
-func (r resolver[N]) HistogramAggregators(id Instrument, boundaries []float64) ([]aggregate.Measure[N], error) {
-	var measures []aggregate.Measure[N]
+// over boundaries provided by the reader. If attribute keys were advised on
+// instrument instantiation, they are used to filter attributes unless a view
+// defines its own filter.
+func (r resolver[N]) HistogramAggregators(id Instrument, boundaries []float64, attrKeys []attribute.Key) ([]aggregate.Measure[N], []aggregate.Bind[N], error) {
+	var (
+		measures []aggregate.Measure[N]
+		binds    []aggregate.Bind[N]
+	)
 
+	filter := advisoryAttributeFilter(attrKeys)
 	errs := &multierror{}
 	for _, i := range r.inserters {
 		agg := i.readerDefaultAggregation(id.Kind)
@@ -626,13 +648,24 @@ func (r resolver[N]) HistogramAggregators(id Instrument, boundaries []float64) (
 			histAgg.Boundaries = boundaries
 			agg = histAgg
 		}
-		in, err := i.Instrument(id, agg)
+		in, bind, err := i.Instrument(id, agg, filter)
 		if err != nil {
 			errs.append(err)
 		}
 		measures = append(measures, in...)
+		binds = append(binds, bind...)
+	}
+	return measures, binds, errs.errorOrNil()
+}
+
+// advisoryAttributeFilter returns an attribute filter that only keeps
+// attributes with keys. If keys is nil, no attribute keys were advised and nil
+// is returned.
+func advisoryAttributeFilter(keys []attribute.Key) attribute.Filter {
+	if keys == nil {
+		return nil
 	}
-	return measures, errs.errorOrNil()
+	return attribute.NewAllowKeysFilter(keys...)
 }
 
 type multierror struct {
@@ -0,0 +1,18 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package runtime provides a [go.opentelemetry.io/otel/sdk/metric.Producer]
+// that reports Go runtime metrics.
+//
+// The metrics are read from the [runtime/metrics] package. Unlike
+// [runtime.ReadMemStats], reading these metrics does not stop the world. The
+// runtime metrics are translated into metrics named according to the
+// OpenTelemetry semantic conventions for the Go runtime.
+//
+// The Producer is registered with a Reader using the
+// [go.opentelemetry.io/otel/sdk/metric.WithProducer] option.
+//
+// This package is currently in a pre-GA phase. Backwards incompatible changes
+// may be introduced in subsequent minor version releases as we work to track
+// the evolving OpenTelemetry specification and user feedback.
+package runtime // import "go.opentelemetry.io/otel/sdk/metric/runtime"
@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package runtime_test
+
+import (
+	"context"
+	"fmt"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/runtime"
+)
+
+func Example() {
+	// Register the Go runtime metric producer with a Reader. The Reader will
+	// include the runtime metrics in every collection.
+	reader := sdkmetric.NewManualReader(
+		sdkmetric.WithProducer(runtime.NewProducer()),
+	)
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer func() {
+		if err := provider.Shutdown(context.Background()); err != nil {
+			panic(err)
+		}
+	}()
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		panic(err)
+	}
+	for _, sm := range rm.ScopeMetrics {
+		fmt.Println(sm.Scope.Name)
+	}
+	// Output: go.opentelemetry.io/otel/sdk/metric/runtime
+}
@@ -0,0 +1,394 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package runtime // import "go.opentelemetry.io/otel/sdk/metric/runtime"
+
+import (
+	"context"
+	"math"
+	"runtime/metrics"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// ScopeName is the instrumentation scope name of the metrics produced by a
+// Producer.
+const ScopeName = "go.opentelemetry.io/otel/sdk/metric/runtime"
+
+// Names of the runtime/metrics values read by a Producer.
+const (
+	rtMemoryTotal       = "/memory/classes/total:bytes"
+	rtMemoryReleased    = "/memory/classes/heap/released:bytes"
+	rtMemoryHeapStacks  = "/memory/classes/heap/stacks:bytes"
+	rtMemoryOSStacks    = "/memory/classes/os-stacks:bytes"
+	rtMemoryLimit       = "/gc/gomemlimit:bytes"
+	rtHeapAllocsBytes   = "/gc/heap/allocs:bytes"
+	rtHeapAllocsObjects = "/gc/heap/allocs:objects"
+	rtHeapGoal          = "/gc/heap/goal:bytes"
+	rtGoroutines        = "/sched/goroutines:goroutines"
+	rtGOMAXPROCS        = "/sched/gomaxprocs:threads"
+	rtGOGC              = "/gc/gogc:percent"
+	rtSchedLatencies    = "/sched/latencies:seconds"
+	rtGCPauses          = "/sched/pauses/total/gc:seconds"
+	// rtGCPausesLegacy is the name of the GC pause histogram prior to Go
+	// 1.22. It is only used if rtGCPauses is not supported.
+	rtGCPausesLegacy = "/gc/pauses:seconds"
+)
+
+// memoryTypeKey is the "go.memory.type" semantic convention attribute key.
+const memoryTypeKey = attribute.Key("go.memory.type")
+
+var (
+	memoryTypeStack = attribute.NewSet(memoryTypeKey.String("stack"))
+	memoryTypeOther = attribute.NewSet(memoryTypeKey.String("other"))
+)
+
+// values provides access to the runtime/metrics values read during a
+// collection.
+type values struct {
+	samples []metrics.Sample
+	index   map[string]int
+}
+
+func (v values) get(name string) metrics.Value {
+	return v.samples[v.index[name]].Value
+}
+
+func (v values) uint64(name string) int64 {
+	return clampInt64(v.get(name).Uint64())
+}
+
+// instrument describes how a single OpenTelemetry metric is computed from
+// runtime/metrics values.
+type instrument struct {
+	name        string
+	description string
+	unit        string
+	// inputs are the runtime/metrics names the instrument is computed from.
+	// The instrument is only produced if all of these are supported by the
+	// running Go runtime.
+	inputs []string
+	// collect computes the metric data from v. If false is returned, the
+	// instrument has no data to report for this collection.
+	collect func(v values, start, now time.Time) (metricdata.Aggregation, bool)
+}
+
+// instruments are all the metrics a Producer can report.
+var instruments = []instrument{
+	{
+		name:        "go.memory.used",
+		description: "Memory used by the Go runtime.",
+		unit:        "By",
+		inputs:      []string{rtMemoryTotal, rtMemoryReleased, rtMemoryHeapStacks, rtMemoryOSStacks},
+		collect: func(v values, start, now time.Time) (metricdata.Aggregation, bool) {
+			stack := v.uint64(rtMemoryHeapStacks) + v.uint64(rtMemoryOSStacks)
+			other := v.uint64(rtMemoryTotal) - v.uint64(rtMemoryReleased) - stack
+			return metricdata.Sum[int64]{
+				DataPoints: []metricdata.DataPoint[int64]{
+					{Attributes: memoryTypeStack, StartTime: start, Time: now, Value: stack},
+					{Attributes: memoryTypeOther, StartTime: start, Time: now, Value: other},
+				},
+				Temporality: metricdata.CumulativeTemporality,
+			}, true
+		},
+	},
+	{
+		name:        "go.memory.limit",
+		description: "Go runtime memory limit configured by the user, if a limit exists.",
+		unit:        "By",
+		inputs:      []string{rtMemoryLimit},
+		collect: func(v values, start, now time.Time) (metricdata.Aggregation, bool) {
+			limit := v.uint64(rtMemoryLimit)
+			if limit == math.MaxInt64 {
+				// No limit is configured.
+				return nil, false
+			}
+			return upDownCounter(limit, start, now), true
+		},
+	},
+	{
+		name:        "go.memory.allocated",
+		description: "Memory allocated to the heap by the application.",
+		unit:        "By",
+		inputs:      []string{rtHeapAllocsBytes},
+		collect: func(v values, start, now time.Time) (metricdata.Aggregation, bool) {
+			return counter(v.uint64(rtHeapAllocsBytes), start, now), true
+		},
+	},
+	{
+		name:        "go.memory.allocations",
+		description: "Count of allocations to the heap by the application.",
+		unit:        "{allocation}",
+		inputs:      []string{rtHeapAllocsObjects},
+		collect: func(v values, start, now time.Time) (metricdata.Aggregation, bool) {
+			return counter(v.uint64(rtHeapAllocsObjects), start, now), true
+		},
+	},
+	{
+		name:        "go.memory.gc.goal",
+		description: "Heap size target for the end of the GC cycle.",
+		unit:        "By",
+		inputs:      []string{rtHeapGoal},
+		collect: func(v values, start, now time.Time) (metricdata.Aggregation, bool) {
+			return upDownCounter(v.uint64(rtHeapGoal), start, now), true
+		},
+	},
+	{
+		name:        "go.goroutine.count",
+		description: "Count of live goroutines.",
+		unit:        "{goroutine}",
+		inputs:      []string{rtGoroutines},
+		collect: func(v values, start, now time.Time) (metricdata.Aggregation, bool) {
+			return upDownCounter(v.uint64(rtGoroutines), start, now), true
+		},
+	},
+	{
+		name:        "go.processor.limit",
+		description: "The number of OS threads that can execute user-level Go code simultaneously.",
+		unit:        "{thread}",
+		inputs:      []string{rtGOMAXPROCS},
+		collect: func(v values, start, now time.Time) (metricdata.Aggregation, bool) {
+			return upDownCounter(v.uint64(rtGOMAXPROCS), start, now), true
+		},
+	},
+	{
+		name:        "go.config.gogc",
+		description: "Heap size target percentage configured by the user, otherwise 100.",
+		unit:        "%",
+		inputs:      []string{rtGOGC},
+		collect: func(v values, start, now time.Time) (metricdata.Aggregation, bool) {
+			return upDownCounter(v.uint64(rtGOGC), start, now), true
+		},
+	},
+	{
+		name:        "go.schedule.duration",
+		description: "The time goroutines have spent in the scheduler in a runnable state before actually running.",
+		unit:        "s",
+		inputs:      []string{rtSchedLatencies},
+		collect: func(v values, start, now time.Time) (metricdata.Aggregation, bool) {
+			return histogram(v.get(rtSchedLatencies).Float64Histogram(), start, now), true
+		},
+	},
+	{
+		name:        "go.gc.pause.duration",
+		description: "Distribution of individual stop-the-world pause latencies due to garbage collection.",
+		unit:        "s",
+		inputs:      []string{rtGCPauses},
+		collect: func(v values, start, now time.Time) (metricdata.Aggregation, bool) {
+			return histogram(v.get(rtGCPauses).Float64Histogram(), start, now), true
+		},
+	},
+	{
+		name:        "go.gc.pause.duration",
+		description: "Distribution of individual stop-the-world pause latencies due to garbage collection.",
+		unit:        "s",
+		inputs:      []string{rtGCPausesLegacy},
+		collect: func(v values, start, now time.Time) (metricdata.Aggregation, bool) {
+			return histogram(v.get(rtGCPausesLegacy).Float64Histogram(), start, now), true
+		},
+	},
+}
+
+// Producer is a [sdkmetric.Producer] that produces Go runtime metrics read
+// from the [runtime/metrics] package.
+//
+// The following metrics are produced if the running Go runtime supports
+// them:
+//
+//   - go.memory.used
+//   - go.memory.limit (only when a memory limit is set)
+//   - go.memory.allocated
+//   - go.memory.allocations
+//   - go.memory.gc.goal
+//   - go.goroutine.count
+//   - go.processor.limit
+//   - go.config.gogc
+//   - go.schedule.duration
+//   - go.gc.pause.duration
+//
+// All sums are cumulative and use the time the Producer was created as their
+// start time.
+type Producer struct {
+	start       time.Time
+	instruments []instrument
+
+	// mu guards samples from concurrent reads.
+	mu      sync.Mutex
+	samples []metrics.Sample
+	index   map[string]int
+}
+
+// Compile-time check Producer implements sdkmetric.Producer.
+var _ sdkmetric.Producer = (*Producer)(nil)
+
+// NewProducer returns a Producer of Go runtime metrics. Use it with the
+// [sdkmetric.WithProducer] option to register it with a Reader.
+func NewProducer() *Producer {
+	supported := make(map[string]metrics.ValueKind)
+	for _, d := range metrics.All() {
+		supported[d.Name] = d.Kind
+	}
+
+	p := &Producer{start: time.Now(), index: make(map[string]int)}
+	produced := make(map[string]bool)
+	for _, inst := range instruments {
+		if produced[inst.name] || !allSupported(supported, inst.inputs) {
+			continue
+		}
+		produced[inst.name] = true
+		p.instruments = append(p.instruments, inst)
+		for _, name := range inst.inputs {
+			if _, ok := p.index[name]; ok {
+				continue
+			}
+			p.index[name] = len(p.samples)
+			p.samples = append(p.samples, metrics.Sample{Name: name})
+		}
+	}
+	return p
+}
+
+// allSupported returns if all names are runtime/metrics values supported by
+// the running Go runtime.
+func allSupported(supported map[string]metrics.ValueKind, names []string) bool {
+	for _, name := range names {
+		if _, ok := supported[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Produce returns the current Go runtime metrics.
+//
+// This method is safe to call concurrently.
+func (p *Producer) Produce(context.Context) ([]metricdata.ScopeMetrics, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	metrics.Read(p.samples)
+	now := time.Now()
+	v := values{samples: p.samples, index: p.index}
+
+	m := make([]metricdata.Metrics, 0, len(p.instruments))
+	for _, inst := range p.instruments {
+		data, ok := inst.collect(v, p.start, now)
+		if !ok {
+			continue
+		}
+		m = append(m, metricdata.Metrics{
+			Name:        inst.name,
+			Description: inst.description,
+			Unit:        inst.unit,
+			Data:        data,
+		})
+	}
+
+	return []metricdata.ScopeMetrics{{
+		Scope: instrumentation.Scope{
+			Name:    ScopeName,
+			Version: Version(),
+		},
+		Metrics: m,
+	}}, nil
+}
+
+func counter(value int64, start, now time.Time) metricdata.Sum[int64] {
+	return metricdata.Sum[int64]{
+		DataPoints: []metricdata.DataPoint[int64]{
+			{StartTime: start, Time: now, Value: value},
+		},
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+	}
+}
+
+func upDownCounter(value int64, start, now time.Time) metricdata.Sum[int64] {
+	return metricdata.Sum[int64]{
+		DataPoints: []metricdata.DataPoint[int64]{
+			{StartTime: start, Time: now, Value: value},
+		},
+		Temporality: metricdata.CumulativeTemporality,
+	}
+}
+
+func histogram(h *metrics.Float64Histogram, start, now time.Time) metricdata.Histogram[float64] {
+	dp := convertHistogram(h)
+	dp.StartTime, dp.Time = start, now
+	return metricdata.Histogram[float64]{
+		DataPoints:  []metricdata.HistogramDataPoint[float64]{dp},
+		Temporality: metricdata.CumulativeTemporality,
+	}
+}
+
+// convertHistogram returns the explicit bucket histogram data point
+// equivalent of h.
+//
+// Runtime histogram buckets are inclusive of their lower bound while
+// OpenTelemetry explicit buckets are inclusive of their upper bound. Values
+// exactly equal to a boundary are therefore attributed to the bucket below
+// the boundary. Infinite boundaries of h are not included in the returned
+// bounds, and empty buckets are added if h is not bounded by infinities.
+//
+// The runtime does not record the sum of observed values. The returned sum
+// is an estimate using the finite bound of each bucket closest to zero.
+func convertHistogram(h *metrics.Float64Histogram) metricdata.HistogramDataPoint[float64] {
+	var dp metricdata.HistogramDataPoint[float64]
+	if h == nil || len(h.Buckets) < 2 {
+		// A histogram with no buckets is a single unbounded bucket.
+		dp.BucketCounts = []uint64{0}
+		return dp
+	}
+
+	n := len(h.Buckets)
+	dp.Bounds = make([]float64, 0, n)
+	dp.BucketCounts = make([]uint64, 0, n+1)
+	if !math.IsInf(h.Buckets[0], -1) {
+		dp.Bounds = append(dp.Bounds, h.Buckets[0])
+		dp.BucketCounts = append(dp.BucketCounts, 0)
+	}
+	for i, c := range h.Counts {
+		if upper := h.Buckets[i+1]; !math.IsInf(upper, 1) {
+			dp.Bounds = append(dp.Bounds, upper)
+		}
+		dp.BucketCounts = append(dp.BucketCounts, c)
+		dp.Count += c
+		if c > 0 {
+			dp.Sum += float64(c) * representative(h.Buckets[i], h.Buckets[i+1])
+		}
+	}
+	if !math.IsInf(h.Buckets[n-1], 1) {
+		dp.BucketCounts = append(dp.BucketCounts, 0)
+	}
+	return dp
+}
+
+// representative returns the value used to represent all measurements in the
+// bucket [lower, upper) when estimating a histogram sum.
+func representative(lower, upper float64) float64 {
+	switch {
+	case math.IsInf(lower, -1):
+		return upper
+	case math.IsInf(upper, 1):
+		return lower
+	case lower >= 0:
+		return lower
+	case upper <= 0:
+		return upper
+	default:
+		return 0
+	}
+}
+
+// clampInt64 returns v as an int64, limited to math.MaxInt64.
+func clampInt64(v uint64) int64 {
+	if v > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return int64(v) //nolint:gosec // Overflow checked above.
+}
@@ -0,0 +1,169 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package runtime
+
+import (
+	"context"
+	"math"
+	"runtime/debug"
+	"runtime/metrics"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+func TestProducer(t *testing.T) {
+	reader := sdkmetric.NewManualReader(sdkmetric.WithProducer(NewProducer()))
+	_ = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	sm := rm.ScopeMetrics[0]
+	assert.Equal(t, ScopeName, sm.Scope.Name)
+	assert.Equal(t, Version(), sm.Scope.Version)
+
+	got := make(map[string]metricdata.Metrics)
+	for _, m := range sm.Metrics {
+		_, dup := got[m.Name]
+		assert.Falsef(t, dup, "duplicate metric %q", m.Name)
+		got[m.Name] = m
+	}
+
+	for _, name := range []string{
+		"go.memory.used",
+		"go.memory.allocated",
+		"go.memory.allocations",
+		"go.memory.gc.goal",
+		"go.goroutine.count",
+		"go.processor.limit",
+		"go.config.gogc",
+		"go.schedule.duration",
+		"go.gc.pause.duration",
+	} {
+		assert.Containsf(t, got, name, "missing metric %q", name)
+	}
+
+	used := got["go.memory.used"].Data.(metricdata.Sum[int64])
+	assert.False(t, used.IsMonotonic)
+	assert.Equal(t, metricdata.CumulativeTemporality, used.Temporality)
+	require.Len(t, used.DataPoints, 2)
+	for _, dp := range used.DataPoints {
+		assert.Positive(t, dp.Value, dp.Attributes.Encoded(nil))
+	}
+
+	allocs := got["go.memory.allocations"].Data.(metricdata.Sum[int64])
+	assert.True(t, allocs.IsMonotonic)
+
+	sched := got["go.schedule.duration"].Data.(metricdata.Histogram[float64])
+	require.Len(t, sched.DataPoints, 1)
+	dp := sched.DataPoints[0]
+	assert.Len(t, dp.BucketCounts, len(dp.Bounds)+1)
+}
+
+func TestProducerMemoryLimit(t *testing.T) {
+	orig := debug.SetMemoryLimit(-1)
+	t.Cleanup(func() { debug.SetMemoryLimit(orig) })
+
+	find := func(t *testing.T) (metricdata.Metrics, bool) {
+		sm, err := NewProducer().Produce(context.Background())
+		require.NoError(t, err)
+		require.Len(t, sm, 1)
+		for _, m := range sm[0].Metrics {
+			if m.Name == "go.memory.limit" {
+				return m, true
+			}
+		}
+		return metricdata.Metrics{}, false
+	}
+
+	debug.SetMemoryLimit(math.MaxInt64)
+	_, ok := find(t)
+	assert.False(t, ok, "memory limit reported when unset")
+
+	const limit = 1 << 40
+	debug.SetMemoryLimit(limit)
+	m, ok := find(t)
+	require.True(t, ok, "memory limit not reported")
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[int64]{
+		DataPoints:  []metricdata.DataPoint[int64]{{Value: limit}},
+		Temporality: metricdata.CumulativeTemporality,
+	}, m.Data, metricdatatest.IgnoreTimestamp())
+}
+
+func TestConvertHistogram(t *testing.T) {
+	inf := math.Inf(1)
+	tests := []struct {
+		name string
+		in   *metrics.Float64Histogram
+		want metricdata.HistogramDataPoint[float64]
+	}{
+		{
+			name: "Nil",
+			want: metricdata.HistogramDataPoint[float64]{BucketCounts: []uint64{0}},
+		},
+		{
+			name: "InfiniteBounds",
+			in: &metrics.Float64Histogram{
+				Counts:  []uint64{1, 2, 3},
+				Buckets: []float64{-inf, 1, 2, inf},
+			},
+			want: metricdata.HistogramDataPoint[float64]{
+				Count:        6,
+				Bounds:       []float64{1, 2},
+				BucketCounts: []uint64{1, 2, 3},
+				Sum:          1 + 2*1 + 3*2,
+			},
+		},
+		{
+			name: "FiniteBounds",
+			in: &metrics.Float64Histogram{
+				Counts:  []uint64{1, 2},
+				Buckets: []float64{0, 1, 2},
+			},
+			want: metricdata.HistogramDataPoint[float64]{
+				Count:        3,
+				Bounds:       []float64{0, 1, 2},
+				BucketCounts: []uint64{0, 1, 2, 0},
+				Sum:          2,
+			},
+		},
+		{
+			name: "Negative",
+			in: &metrics.Float64Histogram{
+				Counts:  []uint64{1, 2, 4},
+				Buckets: []float64{-2, -1, 1, inf},
+			},
+			want: metricdata.HistogramDataPoint[float64]{
+				Count:        7,
+				Bounds:       []float64{-2, -1, 1},
+				BucketCounts: []uint64{0, 1, 2, 4},
+				Sum:          -1 + 0 + 4,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := convertHistogram(tt.in)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func BenchmarkProducer(b *testing.B) {
+	p := NewProducer()
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_, _ = p.Produce(ctx)
+	}
+}
@@ -0,0 +1,10 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package runtime // import "go.opentelemetry.io/otel/sdk/metric/runtime"
+
+// Version is the current release version of the Go runtime metric producer
+// in use.
+func Version() string {
+	return "0.50.0"
+}
@@ -0,0 +1,186 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// OversizePolicy defines how a span that exceeds the maximum export batch
+// size in bytes on its own is exported by a BatchSpanProcessor.
+type OversizePolicy int
+
+const (
+	// OversizeSendAlone exports a span exceeding the maximum export batch
+	// size in bytes in a batch of its own.
+	OversizeSendAlone OversizePolicy = iota
+	// OversizeTruncate truncates the string attribute values of a span, its
+	// events, and its links exceeding the maximum export batch size in bytes
+	// so that it fits within it, and exports it in a batch of its own.
+	OversizeTruncate
+	// OversizeDrop drops a span exceeding the maximum export batch size in
+	// bytes.
+	OversizeDrop
+)
+
+const (
+	// spanOverhead is the estimated size of the fixed-size fields of an OTLP
+	// encoded span: the trace ID, span ID, parent span ID, flags, kind,
+	// timestamps, status code, dropped counts, and their field tags.
+	spanOverhead = 96
+	// eventOverhead is the estimated size of the fixed-size fields of an
+	// OTLP encoded span event: the timestamp, dropped count, and their
+	// field tags.
+	eventOverhead = 16
+	// linkOverhead is the estimated size of the fixed-size fields of an
+	// OTLP encoded span link: the trace ID, span ID, flags, dropped count,
+	// and their field tags.
+	linkOverhead = 48
+)
+
+// spanSize returns an estimate of the size in bytes of s when encoded as an
+// OTLP protobuf Span. The resource and instrumentation scope are shared by
+// the spans of a batch and are not included.
+func spanSize(s ReadOnlySpan) int {
+	n := spanOverhead + sizedLen(len(s.Name()))
+	n += sizedLen(len(s.SpanContext().TraceState().String()))
+	n += sizedLen(len(s.Status().Description))
+	n += attrsSize(s.Attributes())
+	for _, e := range s.Events() {
+		n += sizedLen(eventOverhead + sizedLen(len(e.Name)) + attrsSize(e.Attributes))
+	}
+	for _, l := range s.Links() {
+		n += sizedLen(linkOverhead + sizedLen(len(l.SpanContext.TraceState().String())) + attrsSize(l.Attributes))
+	}
+	return n
+}
+
+func attrsSize(attrs []attribute.KeyValue) int {
+	var n int
+	for _, kv := range attrs {
+		n += sizedLen(sizedLen(len(kv.Key)) + sizedLen(attrValueSize(kv.Value)))
+	}
+	return n
+}
+
+func attrValueSize(v attribute.Value) int {
+	switch v.Type() {
+	case attribute.STRING:
+		return sizedLen(len(v.AsString()))
+	case attribute.BOOL, attribute.INT64, attribute.FLOAT64:
+		// Tag and an up to 8 bytes value.
+		return 9
+	case attribute.BOOLSLICE:
+		return sizedLen(11 * len(v.AsBoolSlice()))
+	case attribute.INT64SLICE:
+		return sizedLen(11 * len(v.AsInt64Slice()))
+	case attribute.FLOAT64SLICE:
+		return sizedLen(11 * len(v.AsFloat64Slice()))
+	case attribute.STRINGSLICE:
+		var n int
+		for _, s := range v.AsStringSlice() {
+			n += sizedLen(sizedLen(len(s)))
+		}
+		return sizedLen(n)
+	}
+	return 0
+}
+
+// sizedLen returns the size of a length-delimited protobuf field holding n
+// bytes: its tag, its length, and the bytes.
+func sizedLen(n int) int {
+	l := 1
+	for v := n; v >= 0x80; v >>= 7 {
+		l++
+	}
+	return 1 + l + n
+}
+
+// truncateSpan returns a copy of s with the string attribute values of s, its
+// events, and its links truncated so its estimated size does not exceed
+// maxBytes. The returned span may still exceed maxBytes if its other fields
+// do.
+func truncateSpan(s ReadOnlySpan, maxBytes int) ReadOnlySpan {
+	longest := maxStringLen(s.Attributes())
+	for _, e := range s.Events() {
+		longest = max(longest, maxStringLen(e.Attributes))
+	}
+	for _, l := range s.Links() {
+		longest = max(longest, maxStringLen(l.Attributes))
+	}
+
+	// Search the longest string length the span fits with.
+	lo, hi := 0, longest
+	for lo < hi {
+		mid := lo + (hi-lo+1)/2
+		if spanSize(truncateSpanValues(s, mid)) <= maxBytes {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return truncateSpanValues(s, lo)
+}
+
+// truncateSpanValues returns a snapshot of s with the string attribute values
+// of s, its events, and its links truncated to be at most limit bytes long.
+func truncateSpanValues(s ReadOnlySpan, limit int) ReadOnlySpan {
+	out := snapshot{
+		name:                  s.Name(),
+		spanContext:           s.SpanContext(),
+		parent:                s.Parent(),
+		spanKind:              s.SpanKind(),
+		startTime:             s.StartTime(),
+		endTime:               s.EndTime(),
+		attributes:            truncateAttrs(limit, s.Attributes()),
+		status:                s.Status(),
+		childSpanCount:        s.ChildSpanCount(),
+		droppedAttributeCount: s.DroppedAttributes(),
+		droppedEventCount:     s.DroppedEvents(),
+		droppedLinkCount:      s.DroppedLinks(),
+		resource:              s.Resource(),
+		instrumentationScope:  s.InstrumentationScope(),
+	}
+	if events := s.Events(); len(events) > 0 {
+		out.events = make([]Event, len(events))
+		for i, e := range events {
+			e.Attributes = truncateAttrs(limit, e.Attributes)
+			out.events[i] = e
+		}
+	}
+	if links := s.Links(); len(links) > 0 {
+		out.links = make([]Link, len(links))
+		for i, l := range links {
+			l.Attributes = truncateAttrs(limit, l.Attributes)
+			out.links[i] = l
+		}
+	}
+	return &out
+}
+
+func truncateAttrs(limit int, attrs []attribute.KeyValue) []attribute.KeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+	out := make([]attribute.KeyValue, len(attrs))
+	for i, kv := range attrs {
+		out[i] = truncateAttr(limit, kv)
+	}
+	return out
+}
+
+func maxStringLen(attrs []attribute.KeyValue) int {
+	var n int
+	for _, kv := range attrs {
+		switch kv.Value.Type() {
+		case attribute.STRING:
+			n = max(n, len(kv.Value.AsString()))
+		case attribute.STRINGSLICE:
+			for _, s := range kv.Value.AsStringSlice() {
+				n = max(n, len(s))
+			}
+		}
+	}
+	return n
+}
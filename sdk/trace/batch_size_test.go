@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func sizedSpan(name string, attrs ...attribute.KeyValue) *snapshot {
+	return &snapshot{
+		name:        name,
+		spanContext: trace.NewSpanContext(trace.SpanContextConfig{TraceFlags: trace.FlagsSampled}),
+		attributes:  attrs,
+	}
+}
+
+func TestSpanSize(t *testing.T) {
+	base := spanSize(sizedSpan("span"))
+	assert.Greater(t, base, spanOverhead)
+
+	long := spanSize(sizedSpan("span", attribute.String("key", strings.Repeat("a", 100))))
+	assert.Greater(t, long, base+100, "string attribute")
+
+	withEvent := &snapshot{name: "span", events: []Event{{Name: "event"}}}
+	assert.Greater(t, spanSize(withEvent), base+eventOverhead, "event")
+
+	withLink := &snapshot{name: "span", links: []Link{{}}}
+	assert.Greater(t, spanSize(withLink), base+linkOverhead, "link")
+}
+
+func TestTruncateSpan(t *testing.T) {
+	s := &snapshot{
+		name: "span",
+		attributes: []attribute.KeyValue{
+			attribute.String("long", strings.Repeat("a", 1000)),
+			attribute.Int("int", 1),
+			attribute.StringSlice("slice", []string{"short", strings.Repeat("b", 1000)}),
+		},
+		events: []Event{{
+			Name:       "event",
+			Attributes: []attribute.KeyValue{attribute.String("long", strings.Repeat("c", 1000))},
+		}},
+		droppedAttributeCount: 2,
+	}
+
+	const maxBytes = 512
+	got := truncateSpan(s, maxBytes)
+	assert.LessOrEqual(t, spanSize(got), maxBytes)
+	assert.Equal(t, s.Name(), got.Name())
+	assert.Equal(t, 2, got.DroppedAttributes(), "dropped attributes")
+
+	attrs := got.Attributes()
+	require.Len(t, attrs, 3)
+	assert.Less(t, len(attrs[0].Value.AsString()), 1000)
+	assert.Equal(t, attribute.Int("int", 1), attrs[1])
+	assert.Equal(t, "short", attrs[2].Value.AsStringSlice()[0])
+
+	require.Len(t, got.Events(), 1)
+	assert.Less(t, len(got.Events()[0].Attributes[0].Value.AsString()), 1000)
+
+	// The original span is not modified.
+	assert.Len(t, s.attributes[0].Value.AsString(), 1000)
+	assert.Len(t, s.events[0].Attributes[0].Value.AsString(), 1000)
+}
+
+type batchRecorder struct {
+	mu      sync.Mutex
+	batches [][]ReadOnlySpan
+}
+
+func (e *batchRecorder) ExportSpans(_ context.Context, spans []ReadOnlySpan) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.batches = append(e.batches, append([]ReadOnlySpan(nil), spans...))
+	return nil
+}
+
+func (e *batchRecorder) Shutdown(context.Context) error { return nil }
+
+func (e *batchRecorder) Batches() [][]ReadOnlySpan {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.batches
+}
+
+func TestBatchSpanProcessorMaxExportBatchBytes(t *testing.T) {
+	small := sizedSpan("small")
+	large := sizedSpan("large", attribute.String("key", strings.Repeat("a", 1000)))
+	maxBytes := 3 * spanSize(small)
+	require.Less(t, maxBytes, spanSize(large))
+
+	run := func(t *testing.T, policy OversizePolicy, spans ...ReadOnlySpan) [][]ReadOnlySpan {
+		t.Helper()
+		exp := &batchRecorder{}
+		bsp := NewBatchSpanProcessor(
+			exp,
+			WithBatchTimeout(time.Hour),
+			WithMaxExportBatchBytes(maxBytes),
+			WithOversizePolicy(policy),
+		)
+		for _, s := range spans {
+			bsp.OnEnd(s)
+		}
+		require.NoError(t, bsp.Shutdown(context.Background()))
+		return exp.Batches()
+	}
+
+	t.Run("Split", func(t *testing.T) {
+		batches := run(t, OversizeSendAlone, small, small, small, small, small)
+		require.Len(t, batches, 2)
+		assert.Len(t, batches[0], 3)
+		assert.Len(t, batches[1], 2)
+	})
+
+	t.Run("SendAlone", func(t *testing.T) {
+		batches := run(t, OversizeSendAlone, small, large, small)
+		require.Len(t, batches, 3)
+		assert.Equal(t, []ReadOnlySpan{small}, batches[0])
+		assert.Equal(t, []ReadOnlySpan{large}, batches[1])
+		assert.Equal(t, []ReadOnlySpan{small}, batches[2])
+	})
+
+	t.Run("Truncate", func(t *testing.T) {
+		batches := run(t, OversizeTruncate, large)
+		require.Len(t, batches, 1)
+		require.Len(t, batches[0], 1)
+		assert.LessOrEqual(t, spanSize(batches[0][0]), maxBytes)
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		batches := run(t, OversizeDrop, small, large, small)
+		require.Len(t, batches, 1)
+		assert.Equal(t, []ReadOnlySpan{small, small}, batches[0])
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		exp := &batchRecorder{}
+		bsp := NewBatchSpanProcessor(exp, WithBatchTimeout(time.Hour))
+		bsp.OnEnd(small)
+		bsp.OnEnd(large)
+		require.NoError(t, bsp.Shutdown(context.Background()))
+		batches := exp.Batches()
+		require.Len(t, batches, 1)
+		assert.Len(t, batches[0], 2)
+	})
+}
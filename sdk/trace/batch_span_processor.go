@@ -50,6 +50,18 @@ type BatchSpanProcessorOptions struct {
 	// The default value of MaxExportBatchSize is 512.
 	MaxExportBatchSize int
 
+	// MaxExportBatchBytes is the maximum size in bytes of a single batch. The
+	// size of a span is an estimate of its OTLP protobuf encoded size. If
+	// adding a span to a batch would exceed this size, the batch is exported
+	// first. A value less than one means batches are not limited in size.
+	// The default value of MaxExportBatchBytes is 0.
+	MaxExportBatchBytes int
+
+	// OversizePolicy defines how a span that exceeds MaxExportBatchBytes on
+	// its own is exported.
+	// The default value of OversizePolicy is OversizeSendAlone.
+	OversizePolicy OversizePolicy
+
 	// BlockOnQueueFull blocks onEnd() and onStart() method if the queue is full
 	// AND if BlockOnQueueFull is set to true.
 	// Blocking option should be used carefully as it can severely affect the performance of an
@@ -67,6 +79,7 @@ type batchSpanProcessor struct {
 	dropped uint32
 
 	batch      []ReadOnlySpan
+	batchBytes int
 	batchMutex sync.Mutex
 	timer      *time.Timer
 	stopWait   sync.WaitGroup
@@ -232,6 +245,23 @@ func WithMaxExportBatchSize(size int) BatchSpanProcessorOption {
 	}
 }
 
+// WithMaxExportBatchBytes returns a BatchSpanProcessorOption that configures
+// the maximum export batch size in bytes allowed for a BatchSpanProcessor.
+func WithMaxExportBatchBytes(size int) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.MaxExportBatchBytes = size
+	}
+}
+
+// WithOversizePolicy returns a BatchSpanProcessorOption that configures how a
+// BatchSpanProcessor exports a span that exceeds the maximum export batch
+// size in bytes on its own.
+func WithOversizePolicy(policy OversizePolicy) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.OversizePolicy = policy
+	}
+}
+
 // WithBatchTimeout returns a BatchSpanProcessorOption that configures the
 // maximum delay allowed for a BatchSpanProcessor before it will export any
 // held span (whether the queue is full or not).
@@ -281,6 +311,7 @@ func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 		// It is up to the exporter to implement any type of retry logic if a batch is failing
 		// to be exported, since it is specific to the protocol and backend being sent to.
 		bsp.batch = bsp.batch[:0]
+		bsp.batchBytes = 0
 
 		if err != nil {
 			return err
@@ -310,11 +341,19 @@ func (bsp *batchSpanProcessor) processQueue() {
 				close(ffs.flushed)
 				continue
 			}
-			bsp.batchMutex.Lock()
-			bsp.batch = append(bsp.batch, sd)
-			shouldExport := len(bsp.batch) >= bsp.o.MaxExportBatchSize
-			bsp.batchMutex.Unlock()
-			if shouldExport {
+			s, size, ok := bsp.sizeSpan(sd)
+			if !ok {
+				continue
+			}
+			if bsp.exceedsBatchBytes(size) {
+				if !bsp.timer.Stop() {
+					<-bsp.timer.C
+				}
+				if err := bsp.exportSpans(ctx); err != nil {
+					otel.Handle(err)
+				}
+			}
+			if shouldExport := bsp.addSpan(s, size); shouldExport {
 				if !bsp.timer.Stop() {
 					<-bsp.timer.C
 				}
@@ -339,12 +378,16 @@ func (bsp *batchSpanProcessor) drainQueue() {
 				continue
 			}
 
-			bsp.batchMutex.Lock()
-			bsp.batch = append(bsp.batch, sd)
-			shouldExport := len(bsp.batch) == bsp.o.MaxExportBatchSize
-			bsp.batchMutex.Unlock()
-
-			if shouldExport {
+			s, size, ok := bsp.sizeSpan(sd)
+			if !ok {
+				continue
+			}
+			if bsp.exceedsBatchBytes(size) {
+				if err := bsp.exportSpans(ctx); err != nil {
+					otel.Handle(err)
+				}
+			}
+			if shouldExport := bsp.addSpan(s, size); shouldExport {
 				if err := bsp.exportSpans(ctx); err != nil {
 					otel.Handle(err)
 				}
@@ -359,6 +402,58 @@ func (bsp *batchSpanProcessor) drainQueue() {
 	}
 }
 
+// sizeSpan returns s, truncated according to the OversizePolicy if it
+// exceeds MaxExportBatchBytes, and its estimated size. It returns false if s
+// is dropped.
+//
+// A span exceeding MaxExportBatchBytes is sized as a full batch so that it is
+// exported alone.
+func (bsp *batchSpanProcessor) sizeSpan(s ReadOnlySpan) (ReadOnlySpan, int, bool) {
+	maxBytes := bsp.o.MaxExportBatchBytes
+	if maxBytes <= 0 {
+		return s, 0, true
+	}
+
+	size := spanSize(s)
+	if size <= maxBytes {
+		return s, size, true
+	}
+	switch bsp.o.OversizePolicy {
+	case OversizeDrop:
+		global.Debug("dropping oversize span", "size", size, "max_bytes", maxBytes)
+		atomic.AddUint32(&bsp.dropped, 1)
+		return nil, 0, false
+	case OversizeTruncate:
+		s = truncateSpan(s, maxBytes)
+	}
+	return s, maxBytes, true
+}
+
+// exceedsBatchBytes returns if adding a span of size bytes to the batch would
+// exceed MaxExportBatchBytes.
+func (bsp *batchSpanProcessor) exceedsBatchBytes(size int) bool {
+	if bsp.o.MaxExportBatchBytes <= 0 {
+		return false
+	}
+	bsp.batchMutex.Lock()
+	defer bsp.batchMutex.Unlock()
+	return len(bsp.batch) > 0 && bsp.batchBytes+size > bsp.o.MaxExportBatchBytes
+}
+
+// addSpan adds s of size bytes to the batch. It returns if the batch is full
+// and needs to be exported.
+func (bsp *batchSpanProcessor) addSpan(s ReadOnlySpan, size int) bool {
+	bsp.batchMutex.Lock()
+	defer bsp.batchMutex.Unlock()
+
+	bsp.batch = append(bsp.batch, s)
+	bsp.batchBytes += size
+	if len(bsp.batch) >= bsp.o.MaxExportBatchSize {
+		return true
+	}
+	return bsp.o.MaxExportBatchBytes > 0 && bsp.batchBytes >= bsp.o.MaxExportBatchBytes
+}
+
 func (bsp *batchSpanProcessor) enqueue(sd ReadOnlySpan) {
 	ctx := context.TODO()
 	if bsp.o.BlockOnQueueFull {